@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// listQuery holds the paging and sort parameters shared by the list
+// endpoints: page_size, page_token, sort_by and order (asc or desc).
+type listQuery struct {
+	PageSize   int32
+	PageToken  string
+	SortBy     string
+	Descending bool
+}
+
+// parseListQuery reads the list parameters, answering 400 itself when they
+// are malformed.
+func parseListQuery(c *gin.Context) (listQuery, bool) {
+	q := listQuery{PageToken: c.Query("page_token"), SortBy: c.Query("sort_by")}
+	pageSize, ok := queryInt32(c, "page_size")
+	if !ok {
+		return q, false
+	}
+	q.PageSize = pageSize
+	switch strings.ToLower(c.DefaultQuery("order", "asc")) {
+	case "asc":
+	case "desc":
+		q.Descending = true
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order, must be asc or desc"})
+		return q, false
+	}
+	return q, true
+}
+
+// queryInt32 reads an optional integer from the query string, zero when
+// absent.
+func queryInt32(c *gin.Context, key string) (int32, bool) {
+	v := c.Query(key)
+	if v == "" {
+		return 0, true
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s, must be an integer", key)})
+		return 0, false
+	}
+	return int32(n), true
+}
+
+// queryFloat32 reads an optional number from the query string, zero when
+// absent.
+func queryFloat32(c *gin.Context, key string) (float32, bool) {
+	v := c.Query(key)
+	if v == "" {
+		return 0, true
+	}
+	f, err := strconv.ParseFloat(v, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s, must be a number", key)})
+		return 0, false
+	}
+	return float32(f), true
+}
+
+// queryFloats reads a required comma separated list of n numbers from the
+// query string.
+func queryFloats(c *gin.Context, key string, n int) ([]float64, bool) {
+	parts := strings.Split(c.Query(key), ",")
+	if len(parts) != n {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s must be %d comma separated numbers", key, n)})
+		return nil, false
+	}
+	values := make([]float64, n)
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s must be %d comma separated numbers", key, n)})
+			return nil, false
+		}
+		values[i] = f
+	}
+	return values, true
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -39,6 +42,10 @@ func handleGrpcError(c *gin.Context, err error) {
 		c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
 	case codes.Unauthenticated:
 		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": st.Message()})
+	case codes.AlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
 	default:
 		c.JSON(http.StatusInternalServerError, gin.H{"error": st.Message()})
 	}
@@ -57,6 +64,34 @@ func respondProto(c *gin.Context, code int, msg proto.Message) {
 	c.Data(code, "application/json", b)
 }
 
+// bindProto decodes a JSON request body with protojson, which unlike
+// ShouldBindJSON accepts RFC 3339 strings for timestamp fields.
+func bindProto(c *gin.Context, msg proto.Message) bool {
+	body, err := io.ReadAll(c.Request.Body)
+	if err == nil {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, msg)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+func respondDocument(c *gin.Context, doc *pb.Document) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", doc.FileName))
+	c.Data(http.StatusOK, doc.ContentType, doc.Content)
+}
+
+func parseDocumentFormat(c *gin.Context) (pb.DocumentFormat, bool) {
+	format, ok := pb.DocumentFormat_value[strings.ToUpper(c.DefaultQuery("format", "pdf"))]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format, must be pdf or csv"})
+		return 0, false
+	}
+	return pb.DocumentFormat(format), true
+}
+
 func main() {
 	if err := godotenv.Load(); err != nil {
 		log.Println("No .env file found, reading from environment variables")
@@ -142,6 +177,7 @@ func main() {
 			Role        string `json:"role" binding:"required"` // "ADMIN" or "SPONSOR"
 			FullName    string `json:"full_name"`
 			DateOfBirth string `json:"date_of_birth"`
+			Currency    string `json:"currency"` // wallet currency, IDR if empty
 		}
 
 		if err := c.ShouldBindJSON(&body); err != nil {
@@ -163,10 +199,11 @@ func main() {
 			RoleType:    strings.ToUpper(body.Role),
 			FullName:    body.FullName,
 			DateOfBirth: body.DateOfBirth,
+			Currency:    body.Currency,
 		})
 
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			handleGrpcError(c, err)
 			return
 		}
 
@@ -189,9 +226,35 @@ func main() {
 		respondProto(c, http.StatusOK, res)
 	})
 	r.GET("/species", func(c *gin.Context) {
-		res, err := treeClient.ListSpecies(c.Request.Context(), &emptypb.Empty{})
+		list, ok := parseListQuery(c)
+		if !ok {
+			return
+		}
+		minPrice, ok := queryInt32(c, "min_price")
+		if !ok {
+			return
+		}
+		maxPrice, ok := queryInt32(c, "max_price")
+		if !ok {
+			return
+		}
+		maxSpace, ok := queryFloat32(c, "max_space_m2")
+		if !ok {
+			return
+		}
+
+		res, err := treeClient.ListSpecies(c.Request.Context(), &pb.ListSpeciesRequest{
+			PageSize:           list.PageSize,
+			PageToken:          list.PageToken,
+			MinPrice:           minPrice,
+			MaxPrice:           maxPrice,
+			Currency:           c.Query("currency"),
+			MaxSpaceRequiredM2: maxSpace,
+			SortBy:             list.SortBy,
+			Descending:         list.Descending,
+		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			handleGrpcError(c, err)
 			return
 		}
 		respondProto(c, http.StatusOK, res)
@@ -208,7 +271,63 @@ func main() {
 	})
 
 	r.GET("/plots", func(c *gin.Context) {
-		res, err := treeClient.ListPlots(c.Request.Context(), &emptypb.Empty{})
+		list, ok := parseListQuery(c)
+		if !ok {
+			return
+		}
+		minSpace, ok := queryFloat32(c, "min_space_m2")
+		if !ok {
+			return
+		}
+
+		res, err := treeClient.ListPlots(c.Request.Context(), &pb.ListPlotsRequest{
+			PageSize:            list.PageSize,
+			PageToken:           list.PageToken,
+			Location:            c.Query("location"),
+			MinAvailableSpaceM2: minSpace,
+			SortBy:              list.SortBy,
+			Descending:          list.Descending,
+		})
+		if err != nil {
+			handleGrpcError(c, err)
+			return
+		}
+		respondProto(c, http.StatusOK, res)
+	})
+
+	r.GET("/plots/near", func(c *gin.Context) {
+		point, ok := queryFloats(c, "point", 2)
+		if !ok {
+			return
+		}
+		radius, err := strconv.ParseFloat(c.Query("radius_m"), 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "radius_m is required"})
+			return
+		}
+		res, err := treeClient.FindPlotsNear(c.Request.Context(), &pb.FindPlotsNearRequest{
+			Latitude:     point[0],
+			Longitude:    point[1],
+			RadiusMeters: radius,
+		})
+		if err != nil {
+			handleGrpcError(c, err)
+			return
+		}
+		respondProto(c, http.StatusOK, res)
+	})
+
+	r.GET("/plots/within", func(c *gin.Context) {
+		box, ok := queryFloats(c, "bbox", 4)
+		if !ok {
+			return
+		}
+		res, err := treeClient.FindPlotsWithin(c.Request.Context(), &pb.FindPlotsWithinRequest{
+			MinLongitude: box[0],
+			MinLatitude:  box[1],
+			MaxLongitude: box[2],
+			MaxLatitude:  box[3],
+		})
 		if err != nil {
 			handleGrpcError(c, err)
 			return
@@ -227,7 +346,30 @@ func main() {
 	})
 
 	r.GET("/trees", func(c *gin.Context) {
-		res, err := treeClient.ListTrees(c.Request.Context(), &emptypb.Empty{})
+		list, ok := parseListQuery(c)
+		if !ok {
+			return
+		}
+		adoptedFrom, ok := parseReportTime(c, "adopted_from")
+		if !ok {
+			return
+		}
+		adoptedTo, ok := parseReportTime(c, "adopted_to")
+		if !ok {
+			return
+		}
+
+		res, err := treeClient.ListTrees(c.Request.Context(), &pb.ListTreesRequest{
+			PageSize:    list.PageSize,
+			PageToken:   list.PageToken,
+			SponsorId:   c.Query("sponsor_id"),
+			SpeciesId:   c.Query("species_id"),
+			PlotId:      c.Query("plot_id"),
+			AdoptedFrom: adoptedFrom,
+			AdoptedTo:   adoptedTo,
+			SortBy:      list.SortBy,
+			Descending:  list.Descending,
+		})
 		if err != nil {
 			handleGrpcError(c, err)
 			return
@@ -255,6 +397,26 @@ func main() {
 		respondProto(c, http.StatusOK, res)
 	})
 
+	r.GET("/certificates/verify/:code", func(c *gin.Context) {
+		res, err := financeClient.VerifyDonationCertificate(c.Request.Context(), &pb.VerifyCertificateRequest{
+			VerificationCode: c.Param("code"),
+		})
+		if err != nil {
+			handleGrpcError(c, err)
+			return
+		}
+		respondProto(c, http.StatusOK, res)
+	})
+
+	r.GET("/exchange-rates", func(c *gin.Context) {
+		res, err := financeClient.GetExchangeRates(c.Request.Context(), &emptypb.Empty{})
+		if err != nil {
+			handleGrpcError(c, err)
+			return
+		}
+		respondProto(c, http.StatusOK, res)
+	})
+
 	authRoutes := r.Group("/", authForwarder)
 	{
 		authRoutes.POST("/trees", func(c *gin.Context) {
@@ -271,8 +433,73 @@ func main() {
 			respondProto(c, http.StatusCreated, res)
 		})
 
+		authRoutes.GET("/me/trees", func(c *gin.Context) {
+			list, ok := parseListQuery(c)
+			if !ok {
+				return
+			}
+			res, err := treeClient.ListMyTrees(c.Request.Context(), &pb.ListMyTreesRequest{
+				PageSize:   list.PageSize,
+				PageToken:  list.PageToken,
+				SortBy:     list.SortBy,
+				Descending: list.Descending,
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		authRoutes.POST("/gifts/claim", func(c *gin.Context) {
+			var req pb.ClaimGiftRequest
+			if err := c.ShouldBindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			res, err := treeClient.ClaimGift(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
 		authRoutes.GET("/wallet/balance", func(c *gin.Context) {
-			res, err := financeClient.GetBalance(c.Request.Context(), &emptypb.Empty{})
+			res, err := financeClient.GetBalance(c.Request.Context(), &pb.BalanceRequest{
+				DisplayCurrency: c.Query("display_currency"),
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		authRoutes.GET("/wallet/holds", func(c *gin.Context) {
+			res, err := financeClient.ListBalanceHolds(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		authRoutes.POST("/wallet/holds", func(c *gin.Context) {
+			var req pb.CreateBalanceHoldRequest
+			if !bindProto(c, &req) {
+				return
+			}
+			res, err := financeClient.CreateBalanceHold(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusCreated, res)
+		})
+
+		authRoutes.POST("/wallet/holds/:id/release", func(c *gin.Context) {
+			res, err := financeClient.ReleaseBalanceHold(c.Request.Context(), &pb.BalanceHoldRequest{HoldId: c.Param("id")})
 			if err != nil {
 				handleGrpcError(c, err)
 				return
@@ -294,6 +521,20 @@ func main() {
 			respondProto(c, http.StatusCreated, res)
 		})
 
+		authRoutes.POST("/wallet/transfers", func(c *gin.Context) {
+			var req pb.TransferRequest
+			if err := c.ShouldBindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			res, err := financeClient.TransferBalance(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusCreated, res)
+		})
+
 		authRoutes.GET("/wallet/transactions", func(c *gin.Context) {
 			res, err := financeClient.GetTransactionHistory(c.Request.Context(), &emptypb.Empty{})
 			if err != nil {
@@ -302,6 +543,75 @@ func main() {
 			}
 			respondProto(c, http.StatusOK, res)
 		})
+
+		authRoutes.POST("/wallet/transactions/:id/cancel", func(c *gin.Context) {
+			res, err := financeClient.CancelPayment(c.Request.Context(), &pb.CancelPaymentRequest{TransactionId: c.Param("id")})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		authRoutes.GET("/wallet/transactions/:id/receipt", func(c *gin.Context) {
+			format, ok := parseDocumentFormat(c)
+			if !ok {
+				return
+			}
+			res, err := financeClient.GetReceipt(c.Request.Context(), &pb.ReceiptRequest{
+				TransactionId: c.Param("id"),
+				Format:        format,
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondDocument(c, res)
+		})
+
+		authRoutes.GET("/wallet/statements", func(c *gin.Context) {
+			format, ok := parseDocumentFormat(c)
+			if !ok {
+				return
+			}
+			period, ok := pb.StatementPeriod_value[strings.ToUpper(c.DefaultQuery("period", "monthly"))]
+			if !ok {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid period, must be monthly or annual"})
+				return
+			}
+			year, err := strconv.Atoi(c.Query("year"))
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "year is required"})
+				return
+			}
+			month, _ := strconv.Atoi(c.Query("month"))
+
+			res, err := financeClient.GetStatement(c.Request.Context(), &pb.StatementRequest{
+				Period: pb.StatementPeriod(period),
+				Year:   int32(year),
+				Month:  int32(month),
+				Format: format,
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondDocument(c, res)
+		})
+
+		authRoutes.POST("/wallet/certificates", func(c *gin.Context) {
+			var req pb.CertificateRequest
+			if err := c.ShouldBindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			res, err := financeClient.IssueDonationCertificate(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondDocument(c, res)
+		})
 	}
 
 	r.POST("/webhooks/finance", func(c *gin.Context) {
@@ -475,6 +785,449 @@ func main() {
 			}
 			c.Status(http.StatusNoContent)
 		})
+
+		adminRoutes.POST("/reconciliation", func(c *gin.Context) {
+			res, err := financeClient.ReconcilePayments(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/reconciliation/reports", func(c *gin.Context) {
+			limit, _ := strconv.Atoi(c.Query("limit"))
+			res, err := financeClient.ListReconciliationReports(c.Request.Context(), &pb.ListReconciliationReportsRequest{Limit: int32(limit)})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/vouchers", func(c *gin.Context) {
+			var req pb.Voucher
+			if !bindProto(c, &req) {
+				return
+			}
+			res, err := financeClient.CreateVoucher(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusCreated, res)
+		})
+
+		adminRoutes.GET("/vouchers", func(c *gin.Context) {
+			res, err := financeClient.ListVouchers(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/holds/:id/capture", func(c *gin.Context) {
+			res, err := financeClient.CaptureBalanceHold(c.Request.Context(), &pb.BalanceHoldRequest{HoldId: c.Param("id")})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/holds/:id/release", func(c *gin.Context) {
+			res, err := financeClient.ReleaseBalanceHold(c.Request.Context(), &pb.BalanceHoldRequest{HoldId: c.Param("id")})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/topup-reviews", func(c *gin.Context) {
+			res, err := financeClient.ListTopUpReviews(c.Request.Context(), &pb.ListTopUpReviewsRequest{Status: c.Query("status")})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/topup-reviews/:id/approve", func(c *gin.Context) {
+			var req pb.DecideTopUpReviewRequest
+			if c.Request.ContentLength != 0 && !bindProto(c, &req) {
+				return
+			}
+			req.ReviewId = c.Param("id")
+			res, err := financeClient.ApproveTopUpReview(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/topup-reviews/:id/reject", func(c *gin.Context) {
+			var req pb.DecideTopUpReviewRequest
+			if c.Request.ContentLength != 0 && !bindProto(c, &req) {
+				return
+			}
+			req.ReviewId = c.Param("id")
+			res, err := financeClient.RejectTopUpReview(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/adjustments", func(c *gin.Context) {
+			res, err := financeClient.ListBalanceAdjustments(c.Request.Context(), &pb.ListBalanceAdjustmentsRequest{Status: c.Query("status")})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/adjustments", func(c *gin.Context) {
+			var req pb.BalanceAdjustmentRequest
+			if !bindProto(c, &req) {
+				return
+			}
+			res, err := financeClient.RequestBalanceAdjustment(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusCreated, res)
+		})
+
+		adminRoutes.POST("/adjustments/:id/approve", func(c *gin.Context) {
+			var req pb.DecideBalanceAdjustmentRequest
+			if c.Request.ContentLength != 0 && !bindProto(c, &req) {
+				return
+			}
+			req.AdjustmentId = c.Param("id")
+			res, err := financeClient.ApproveBalanceAdjustment(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/adjustments/:id/reject", func(c *gin.Context) {
+			var req pb.DecideBalanceAdjustmentRequest
+			if c.Request.ContentLength != 0 && !bindProto(c, &req) {
+				return
+			}
+			req.AdjustmentId = c.Param("id")
+			res, err := financeClient.RejectBalanceAdjustment(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/partners", func(c *gin.Context) {
+			res, err := financeClient.ListPartners(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/partners", func(c *gin.Context) {
+			var req pb.Partner
+			if !bindProto(c, &req) {
+				return
+			}
+			res, err := financeClient.CreatePartner(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusCreated, res)
+		})
+
+		adminRoutes.PUT("/partners/:id", func(c *gin.Context) {
+			var req pb.Partner
+			if !bindProto(c, &req) {
+				return
+			}
+			req.Id = c.Param("id")
+			res, err := financeClient.UpdatePartner(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/payouts", func(c *gin.Context) {
+			res, err := financeClient.ListPayouts(c.Request.Context(), &pb.ListPayoutsRequest{
+				PartnerId: c.Query("partner_id"),
+				Status:    c.Query("status"),
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/payouts/calculate", func(c *gin.Context) {
+			var req pb.CalculatePayoutsRequest
+			if !bindProto(c, &req) {
+				return
+			}
+			res, err := financeClient.CalculatePayouts(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/payouts/:id/paid", func(c *gin.Context) {
+			var req pb.PayoutStatusRequest
+			if !bindProto(c, &req) {
+				return
+			}
+			req.PayoutId, req.Status = c.Param("id"), "PAID"
+			res, err := financeClient.UpdatePayoutStatus(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/payouts/:id/cancel", func(c *gin.Context) {
+			var req pb.PayoutStatusRequest
+			if c.Request.ContentLength != 0 && !bindProto(c, &req) {
+				return
+			}
+			req.PayoutId, req.Status = c.Param("id"), "CANCELLED"
+			res, err := financeClient.UpdatePayoutStatus(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/payouts/:id/statement", func(c *gin.Context) {
+			format, ok := parseDocumentFormat(c)
+			if !ok {
+				return
+			}
+			res, err := financeClient.GetPayoutStatement(c.Request.Context(), &pb.PayoutStatementRequest{
+				PayoutId: c.Param("id"),
+				Format:   format,
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondDocument(c, res)
+		})
+
+		adminRoutes.POST("/matching-campaigns", func(c *gin.Context) {
+			var req pb.MatchingCampaign
+			if !bindProto(c, &req) {
+				return
+			}
+			res, err := financeClient.CreateMatchingCampaign(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusCreated, res)
+		})
+
+		adminRoutes.GET("/matching-campaigns", func(c *gin.Context) {
+			res, err := financeClient.ListMatchingCampaigns(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/matching-campaigns/:id/status", func(c *gin.Context) {
+			var req pb.MatchingCampaignStatusRequest
+			if !bindProto(c, &req) {
+				return
+			}
+			req.CampaignId = c.Param("id")
+			res, err := financeClient.UpdateMatchingCampaignStatus(c.Request.Context(), &req)
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/matching-campaigns/:id/matches", func(c *gin.Context) {
+			res, err := financeClient.ListCampaignMatches(c.Request.Context(), &pb.ListCampaignMatchesRequest{CampaignId: c.Param("id")})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/jobs", func(c *gin.Context) {
+			res, err := financeClient.ListJobs(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.POST("/jobs/:name/run", func(c *gin.Context) {
+			res, err := financeClient.TriggerJob(c.Request.Context(), &pb.TriggerJobRequest{Name: c.Param("name")})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/reports/revenue", func(c *gin.Context) {
+			asCSV, ok := wantsCSV(c)
+			if !ok {
+				return
+			}
+			groupBy, ok := pb.RevenueGrouping_value[strings.ToUpper(c.DefaultQuery("group_by", "day"))]
+			if !ok {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid group_by, must be day, month, type, species or plot"})
+				return
+			}
+			from, ok := parseReportTime(c, "from")
+			if !ok {
+				return
+			}
+			to, ok := parseReportTime(c, "to")
+			if !ok {
+				return
+			}
+
+			res, err := financeClient.GetRevenueReport(c.Request.Context(), &pb.RevenueReportRequest{
+				GroupBy: pb.RevenueGrouping(groupBy),
+				From:    from,
+				To:      to,
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			if asCSV {
+				respondCSV(c, fmt.Sprintf("revenue-by-%s.csv", strings.ToLower(res.GroupBy.String())), revenueCSV(res))
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/reports/payment-methods", func(c *gin.Context) {
+			asCSV, ok := wantsCSV(c)
+			if !ok {
+				return
+			}
+			from, ok := parseReportTime(c, "from")
+			if !ok {
+				return
+			}
+			to, ok := parseReportTime(c, "to")
+			if !ok {
+				return
+			}
+
+			res, err := financeClient.GetPaymentMethodReport(c.Request.Context(), &pb.PaymentMethodReportRequest{
+				From: from,
+				To:   to,
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			if asCSV {
+				respondCSV(c, "payment-methods.csv", paymentMethodsCSV(res))
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/reports/journal", func(c *gin.Context) {
+			format := pb.DocumentFormat_CSV
+			switch strings.ToLower(c.DefaultQuery("format", "csv")) {
+			case "csv":
+			case "iif":
+				format = pb.DocumentFormat_IIF
+			default:
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format, must be csv or iif"})
+				return
+			}
+			from, ok := parseReportTime(c, "from")
+			if !ok {
+				return
+			}
+			to, ok := parseReportTime(c, "to")
+			if !ok {
+				return
+			}
+
+			res, err := financeClient.ExportJournal(c.Request.Context(), &pb.JournalExportRequest{
+				From:   from,
+				To:     to,
+				Format: format,
+			})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			respondDocument(c, res)
+		})
+
+		adminRoutes.GET("/reports/pending-invoices", func(c *gin.Context) {
+			asCSV, ok := wantsCSV(c)
+			if !ok {
+				return
+			}
+			res, err := financeClient.GetPendingInvoiceReport(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			if asCSV {
+				respondCSV(c, "pending-invoices.csv", pendingInvoicesCSV(res))
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
+
+		adminRoutes.GET("/reports/wallet-liabilities", func(c *gin.Context) {
+			asCSV, ok := wantsCSV(c)
+			if !ok {
+				return
+			}
+			res, err := financeClient.GetWalletLiabilityReport(c.Request.Context(), &emptypb.Empty{})
+			if err != nil {
+				handleGrpcError(c, err)
+				return
+			}
+			if asCSV {
+				respondCSV(c, "wallet-liabilities.csv", currencyTotalsCSV(res.Totals))
+				return
+			}
+			respondProto(c, http.StatusOK, res)
+		})
 	}
 
 	log.Println("API Gateway running on :8080")
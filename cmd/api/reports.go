@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"reforest/pkg/pb"
+)
+
+// wantsCSV reports whether the admin asked for a report as CSV rather than
+// JSON, answering 400 itself for an unknown format.
+func wantsCSV(c *gin.Context) (asCSV bool, ok bool) {
+	switch strings.ToLower(c.DefaultQuery("format", "json")) {
+	case "json":
+		return false, true
+	case "csv":
+		return true, true
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format, must be json or csv"})
+	return false, false
+}
+
+// parseReportTime reads an optional RFC 3339 timestamp or YYYY-MM-DD date
+// from the query string. Dates are taken as midnight UTC.
+func parseReportTime(c *gin.Context, key string) (*timestamppb.Timestamp, bool) {
+	v := c.Query(key)
+	if v == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		t, err = time.Parse(time.DateOnly, v)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s, must be a date or RFC 3339 timestamp", key)})
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+func respondCSV(c *gin.Context, fileName string, records [][]string) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(records); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to write csv"})
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Data(http.StatusOK, "text/csv", buf.Bytes())
+}
+
+func revenueCSV(report *pb.RevenueReport) [][]string {
+	records := [][]string{{"key", "label", "currency", "transaction_count", "amount", "discount_amount"}}
+	for _, row := range report.Rows {
+		records = append(records, []string{
+			row.Key,
+			row.Label,
+			row.Currency,
+			strconv.FormatInt(row.TransactionCount, 10),
+			strconv.FormatInt(row.Amount, 10),
+			strconv.FormatInt(row.DiscountAmount, 10),
+		})
+	}
+	return records
+}
+
+func pendingInvoicesCSV(report *pb.PendingInvoiceReport) [][]string {
+	records := [][]string{{"transaction_id", "user_id", "type", "reference_id", "amount", "currency", "expires_at", "payment_url"}}
+	for _, tx := range report.Invoices {
+		records = append(records, []string{
+			tx.Id,
+			tx.UserId,
+			tx.Type,
+			tx.ReferenceId,
+			strconv.FormatInt(tx.Amount, 10),
+			tx.Currency,
+			tx.ExpiresAt.AsTime().Format(time.RFC3339),
+			tx.PaymentUrl,
+		})
+	}
+	return records
+}
+
+func paymentMethodsCSV(report *pb.PaymentMethodReport) [][]string {
+	records := [][]string{{"method", "channel", "currency", "count", "amount", "fee_amount"}}
+	for _, row := range report.Rows {
+		records = append(records, []string{
+			row.Method,
+			row.Channel,
+			row.Currency,
+			strconv.FormatInt(row.Count, 10),
+			strconv.FormatInt(row.Amount, 10),
+			strconv.FormatInt(row.FeeAmount, 10),
+		})
+	}
+	return records
+}
+
+func currencyTotalsCSV(totals []*pb.CurrencyTotal) [][]string {
+	records := [][]string{{"currency", "count", "amount"}}
+	for _, t := range totals {
+		records = append(records, []string{t.Currency, strconv.FormatInt(t.Count, 10), strconv.FormatInt(t.Amount, 10)})
+	}
+	return records
+}
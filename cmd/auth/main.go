@@ -32,7 +32,14 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s := googleGrpc.NewServer()
+	publicMethods := map[string]bool{
+		"/auth.AuthService/Login":    true,
+		"/auth.AuthService/Register": true,
+	}
+
+	s := googleGrpc.NewServer(
+		googleGrpc.UnaryInterceptor(grpc.AuthInterceptor(jwtProvider, publicMethods, map[string]bool{}, map[string]bool{})),
+	)
 	pb.RegisterAuthServiceServer(s, authHandler)
 
 	log.Printf("Auth Service listening on %v", lis.Addr())
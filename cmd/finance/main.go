@@ -12,20 +12,43 @@ import (
 	"reforest/internal/repository"
 	"reforest/internal/service"
 	"reforest/pkg/database"
+	"reforest/pkg/money"
 	"reforest/pkg/mq"
+	"reforest/pkg/outbox"
 	"reforest/pkg/pb"
+	"reforest/pkg/scheduler"
 	"reforest/pkg/utils"
 
+	"github.com/google/uuid"
 	googleGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
+// metadataForwardingInterceptor passes the caller's credentials on to the
+// tree service, whose intent lookups are admin-only as well. Scheduled jobs
+// have no caller, so they call as an admin with a token of their own.
+func metadataForwardingInterceptor(jwtProvider *utils.JWTProvider) googleGrpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *googleGrpc.ClientConn, invoker googleGrpc.UnaryInvoker, opts ...googleGrpc.CallOption) error {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			token, err := jwtProvider.GenerateToken(uuid.Nil, "ADMIN")
+			if err != nil {
+				return err
+			}
+			md = metadata.Pairs("authorization", "Bearer "+token)
+		}
+		return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+	}
+}
+
 func main() {
 	cfg := config.Load()
 
 	db := database.NewConnection(cfg.DBDSN)
 
 	log.Println("Running finance migrations...")
-	if err := db.AutoMigrate(&models.Transaction{}, &models.Payment{}); err != nil {
+	if err := db.AutoMigrate(&models.Transaction{}, &models.Payment{}, &models.DonationCertificate{}, &models.ReconciliationReport{}, &models.ReconciliationDiscrepancy{}, &models.OutboxEvent{}, &models.Voucher{}, &models.VoucherRedemption{}, &models.BalanceHold{}, &models.TopUpReview{}, &models.BalanceAdjustment{}, &models.Partner{}, &models.PartnerPlot{}, &models.Payout{}, &models.PayoutLine{}, &models.MatchingCampaign{}, &models.CampaignMatch{}, &models.PaymentReminder{}, &models.JobRun{}); err != nil {
 		log.Fatalf("failed to migrate finance database: %v", err)
 	}
 
@@ -37,21 +60,102 @@ func main() {
 	}
 	defer mqClient.Close()
 
+	rates, err := money.ParseRates(money.DefaultCurrency, cfg.ExchangeRates)
+	if err != nil {
+		log.Fatalf("invalid EXCHANGE_RATES: %v", err)
+	}
+
 	financeRepo := repository.NewFinanceRepository(db)
 	emailSender := service.NewMailtrapSender(cfg)
-	financeSvc := service.NewFinanceService(financeRepo, cfg.XenditAPIKey, mqClient, emailSender)
-	financeHandler := grpc.NewFinanceHandler(financeSvc)
-
-	go func() {
-		ticker := time.NewTicker(24 * time.Hour)
-		defer ticker.Stop()
-		for range ticker.C {
-			log.Println("Running scheduled payment expiry check...")
-			if err := financeSvc.CheckPaymentExpiry(context.Background()); err != nil {
-				log.Printf("Error running payment expiry check: %v", err)
-			}
+	financeSvc := service.NewFinanceService(financeRepo, cfg.XenditAPIKey, emailSender, rates)
+	certificateRepo := repository.NewCertificateRepository(db)
+	certificateSvc := service.NewCertificateService(certificateRepo, cfg.CertificateSigningKey, cfg.PublicAPIURL)
+	treeConn, err := googleGrpc.NewClient(cfg.TreeServiceURL,
+		googleGrpc.WithTransportCredentials(insecure.NewCredentials()),
+		googleGrpc.WithUnaryInterceptor(metadataForwardingInterceptor(jwtProvider)),
+	)
+	if err != nil {
+		log.Fatalf("failed to connect to tree service: %v", err)
+	}
+	defer treeConn.Close()
+	treeClient := pb.NewTreeServiceClient(treeConn)
+	reportSvc := service.NewReportService(repository.NewReportRepository(db), treeClient, emailSender)
+	payoutSvc := service.NewPayoutService(repository.NewPartnerRepository(db), reportSvc, treeClient)
+
+	relay := outbox.NewRelay(repository.NewFinanceOutboxStore(db), mqClient)
+	go relay.Run(context.Background(), 2*time.Second)
+
+	jobs := scheduler.New(repository.NewJobRunStore(db), repository.NewAdvisoryLocker(db))
+	if err := jobs.Register(scheduler.Job{
+		Name:        "payment-expiry",
+		Description: "Expires PENDING invoices past their expiry time.",
+		Spec:        cfg.PaymentExpirySchedule,
+		Timeout:     5 * time.Minute,
+		Run:         financeSvc.CheckPaymentExpiry,
+	}); err != nil {
+		log.Fatalf("invalid PAYMENT_EXPIRY_SCHEDULE: %v", err)
+	}
+	reminderOffsets, err := service.ParseReminderOffsets(cfg.InvoiceReminderOffsets)
+	if err != nil {
+		log.Fatalf("invalid INVOICE_REMINDER_OFFSETS: %v", err)
+	}
+	if err := jobs.Register(scheduler.Job{
+		Name:        "invoice-reminders",
+		Description: "Emails the payment link of adoption invoices nearing expiry at INVOICE_REMINDER_OFFSETS.",
+		Spec:        cfg.ReminderSchedule,
+		Timeout:     5 * time.Minute,
+		Run: func(ctx context.Context) error {
+			return financeSvc.SendInvoiceReminders(ctx, reminderOffsets)
+		},
+	}); err != nil {
+		log.Fatalf("invalid INVOICE_REMINDER_SCHEDULE: %v", err)
+	}
+	if err := jobs.Register(scheduler.Job{
+		Name:        "payment-reconciliation",
+		Description: "Compares recent invoices with Xendit and settles missed payments.",
+		Spec:        cfg.ReconciliationSchedule,
+		Timeout:     15 * time.Minute,
+		Run: func(ctx context.Context) error {
+			_, err := financeSvc.ReconcilePayments(ctx)
+			return err
+		},
+	}); err != nil {
+		log.Fatalf("invalid RECONCILIATION_SCHEDULE: %v", err)
+	}
+	if err := jobs.Register(scheduler.Job{
+		Name:        "balance-hold-expiry",
+		Description: "Returns the funds of balance holds past their expiry to the wallet.",
+		Spec:        cfg.HoldExpirySchedule,
+		Timeout:     5 * time.Minute,
+		Run:         financeSvc.ExpireBalanceHolds,
+	}); err != nil {
+		log.Fatalf("invalid HOLD_EXPIRY_SCHEDULE: %v", err)
+	}
+	if err := jobs.Register(scheduler.Job{
+		Name:        "partner-payouts",
+		Description: "Calculates last month's landowner payouts from settled ADOPT and CARE revenue per plot.",
+		Spec:        cfg.PayoutSchedule,
+		Timeout:     10 * time.Minute,
+		Run:         payoutSvc.CalculateLastMonth,
+	}); err != nil {
+		log.Fatalf("invalid PARTNER_PAYOUT_SCHEDULE: %v", err)
+	}
+	if cfg.AccountingEmail != "" {
+		if err := jobs.Register(scheduler.Job{
+			Name:        "accounting-export",
+			Description: "Mails last month's accounting journal as CSV and IIF to ACCOUNTING_EMAIL.",
+			Spec:        cfg.AccountingSchedule,
+			Timeout:     10 * time.Minute,
+			Run: func(ctx context.Context) error {
+				return reportSvc.MailJournal(ctx, cfg.AccountingEmail)
+			},
+		}); err != nil {
+			log.Fatalf("invalid ACCOUNTING_EXPORT_SCHEDULE: %v", err)
 		}
-	}()
+	}
+	jobs.Start(context.Background())
+
+	financeHandler := grpc.NewFinanceHandler(financeSvc, certificateSvc, reportSvc, payoutSvc, jobs)
 
 	lis, err := net.Listen("tcp", cfg.FinanceGRPCPort)
 	if err != nil {
@@ -59,19 +163,64 @@ func main() {
 	}
 
 	publicMethods := map[string]bool{
-		"/finance.FinanceService/HandleWalletWebhook": true,
-		"/finance.FinanceService/CheckPaymentExpiry":  true,
+		"/finance.FinanceService/HandleWalletWebhook":       true,
+		"/finance.FinanceService/CheckPaymentExpiry":        true,
+		"/finance.FinanceService/VerifyDonationCertificate": true,
+		"/finance.FinanceService/GetExchangeRates":          true,
 	}
 
-	adminMethods := map[string]bool{}
+	adminMethods := map[string]bool{
+		"/finance.FinanceService/ReconcilePayments":            true,
+		"/finance.FinanceService/CaptureBalanceHold":           true,
+		"/finance.FinanceService/ListReconciliationReports":    true,
+		"/finance.FinanceService/CreateVoucher":                true,
+		"/finance.FinanceService/ListVouchers":                 true,
+		"/finance.FinanceService/ListTopUpReviews":             true,
+		"/finance.FinanceService/ApproveTopUpReview":           true,
+		"/finance.FinanceService/RejectTopUpReview":            true,
+		"/finance.FinanceService/RequestBalanceAdjustment":     true,
+		"/finance.FinanceService/ApproveBalanceAdjustment":     true,
+		"/finance.FinanceService/RejectBalanceAdjustment":      true,
+		"/finance.FinanceService/ListBalanceAdjustments":       true,
+		"/finance.FinanceService/CreatePartner":                true,
+		"/finance.FinanceService/UpdatePartner":                true,
+		"/finance.FinanceService/ListPartners":                 true,
+		"/finance.FinanceService/CalculatePayouts":             true,
+		"/finance.FinanceService/ListPayouts":                  true,
+		"/finance.FinanceService/UpdatePayoutStatus":           true,
+		"/finance.FinanceService/GetPayoutStatement":           true,
+		"/finance.FinanceService/CreateMatchingCampaign":       true,
+		"/finance.FinanceService/ListMatchingCampaigns":        true,
+		"/finance.FinanceService/UpdateMatchingCampaignStatus": true,
+		"/finance.FinanceService/ListCampaignMatches":          true,
+		"/finance.FinanceService/GetRevenueReport":             true,
+		"/finance.FinanceService/GetPendingInvoiceReport":      true,
+		"/finance.FinanceService/GetWalletLiabilityReport":     true,
+		"/finance.FinanceService/GetPaymentMethodReport":       true,
+		"/finance.FinanceService/ExportJournal":                true,
+		"/finance.FinanceService/ListJobs":                     true,
+		"/finance.FinanceService/TriggerJob":                   true,
+	}
 
 	sponsorMethods := map[string]bool{
-		"/finance.FinanceService/CreateTransaction":     true,
-		"/finance.FinanceService/TopUpWallet":           true,
-		"/finance.FinanceService/GetBalance":            true,
-		"/finance.FinanceService/GetTransactionHistory": true,
+		"/finance.FinanceService/CreateTransaction":        true,
+		"/finance.FinanceService/TopUpWallet":              true,
+		"/finance.FinanceService/TransferBalance":          true,
+		"/finance.FinanceService/GetBalance":               true,
+		"/finance.FinanceService/GetTransactionHistory":    true,
+		"/finance.FinanceService/CancelPayment":            true,
+		"/finance.FinanceService/CreateBalanceHold":        true,
+		"/finance.FinanceService/ListBalanceHolds":         true,
+		"/finance.FinanceService/GetReceipt":               true,
+		"/finance.FinanceService/GetStatement":             true,
+		"/finance.FinanceService/IssueDonationCertificate": true,
 	}
 
+	// ReleaseBalanceHold is in neither list, as both sponsors, cancelling
+	// their own holds, and admins, rejecting the purchase a hold was placed
+	// for, call it. CaptureBalanceHold records the purchase as delivered, so
+	// it is admin only.
+
 	s := googleGrpc.NewServer(
 		googleGrpc.UnaryInterceptor(grpc.AuthInterceptor(jwtProvider, publicMethods, adminMethods, sponsorMethods)),
 	)
@@ -11,6 +11,7 @@ import (
 	"reforest/internal/repository"
 	"reforest/internal/service"
 	"reforest/pkg/mq"
+	"reforest/pkg/outbox"
 	"reforest/pkg/pb"
 	"reforest/pkg/utils"
 
@@ -50,16 +51,35 @@ func main() {
 	defer financeConn.Close()
 	financeClient := pb.NewFinanceServiceClient(financeConn)
 
+	authConn, err := googleGrpc.NewClient(cfg.AuthServiceURL,
+		googleGrpc.WithTransportCredentials(insecure.NewCredentials()),
+		googleGrpc.WithUnaryInterceptor(metadataForwardingInterceptor),
+	)
+	if err != nil {
+		log.Fatalf("failed to connect to auth service: %v", err)
+	}
+	defer authConn.Close()
+	authClient := pb.NewAuthServiceClient(authConn)
+
 	mqClient, err := mq.NewClient(cfg.RabbitMQURL)
 	if err != nil {
 		log.Fatalf("failed to connect to rabbitmq: %v", err)
 	}
 	defer mqClient.Close()
 
+	if err := repository.EnsureTreeIndexes(ctx, db); err != nil {
+		log.Fatalf("failed to create tree indexes: %v", err)
+	}
+
 	jwtProvider := utils.NewJWTProvider(cfg.JWTSecret)
 	treeRepo := repository.NewTreeManagementRepository(db)
-	treeSvc := service.NewTreeManagementService(treeRepo, financeClient, mqClient)
+	emailSender := service.NewMailtrapSender(cfg)
+	treeSvc := service.NewTreeManagementService(treeRepo, financeClient, authClient, mqClient, emailSender)
 	treeSvc.StartConsumers()
+
+	relay := outbox.NewRelay(repository.NewTreeOutboxStore(db), mqClient)
+	go relay.Run(context.Background(), 2*time.Second)
+
 	treeHandler := grpc.NewTreeManagementHandler(treeSvc)
 
 	lis, err := net.Listen("tcp", cfg.TreeGRPCPort)
@@ -68,13 +88,15 @@ func main() {
 	}
 
 	publicMethods := map[string]bool{
-		"/tree.TreeService/ListSpecies": true,
-		"/tree.TreeService/GetSpecies":  true,
-		"/tree.TreeService/ListPlots":   true,
-		"/tree.TreeService/GetPlot":     true,
-		"/tree.TreeService/ListTrees":   true,
-		"/tree.TreeService/GetTree":     true,
-		"/tree.TreeService/GetTreeLogs": true,
+		"/tree.TreeService/ListSpecies":     true,
+		"/tree.TreeService/GetSpecies":      true,
+		"/tree.TreeService/ListPlots":       true,
+		"/tree.TreeService/FindPlotsNear":   true,
+		"/tree.TreeService/FindPlotsWithin": true,
+		"/tree.TreeService/GetPlot":         true,
+		"/tree.TreeService/ListTrees":       true,
+		"/tree.TreeService/GetTree":         true,
+		"/tree.TreeService/GetTreeLogs":     true,
 	}
 
 	adminMethods := map[string]bool{
@@ -90,10 +112,12 @@ func main() {
 		"/tree.TreeService/UpdateLog":                  true,
 		"/tree.TreeService/DeleteLog":                  true,
 		"/tree.TreeService/TriggerBiweeklyMaintenance": true,
+		"/tree.TreeService/ListAdoptionIntents":        true,
 	}
 
 	sponsorMethods := map[string]bool{
 		"/tree.TreeService/AdoptTree": true,
+		"/tree.TreeService/ClaimGift": true,
 	}
 
 	s := googleGrpc.NewServer(
@@ -7,8 +7,10 @@ import (
 	"reforest/internal/service"
 	"reforest/pkg/pb"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type AuthHandler struct {
@@ -43,6 +45,9 @@ func (h *AuthHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		if errors.Is(err, models.ErrAlreadyExists) {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
 		}
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "registration failed: %v", err)
 	}
 
@@ -50,4 +55,28 @@ func (h *AuthHandler) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		Message: "successfully registered",
 		UserId:  user.ID.String(),
 	}, nil
-}
\ No newline at end of file
+}
+
+// GetCurrentUser returns the account of the caller, so other services can
+// address emails to them.
+func (h *AuthHandler) GetCurrentUser(ctx context.Context, _ *emptypb.Empty) (*pb.User, error) {
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user id not found in context")
+	}
+
+	user, err := h.authService.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.User{
+		Id:       user.ID.String(),
+		Email:    user.Email,
+		RoleType: user.RoleType,
+		FullName: user.Profile.FullName,
+	}, nil
+}
@@ -2,9 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"reforest/internal/models"
+	"reforest/internal/repository"
 	"reforest/internal/service"
+	"reforest/pkg/money"
 	"reforest/pkg/pb"
+	"reforest/pkg/scheduler"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -16,18 +22,29 @@ import (
 
 type FinanceHandler struct {
 	pb.UnimplementedFinanceServiceServer
-	financeService service.FinanceService
+	financeService     service.FinanceService
+	certificateService service.CertificateService
+	reportService      service.ReportService
+	payoutService      service.PayoutService
+	jobs               *scheduler.Scheduler
 }
 
-func NewFinanceHandler(financeService service.FinanceService) *FinanceHandler {
+func NewFinanceHandler(financeService service.FinanceService, certificateService service.CertificateService, reportService service.ReportService, payoutService service.PayoutService, jobs *scheduler.Scheduler) *FinanceHandler {
 	return &FinanceHandler{
-		financeService: financeService,
+		financeService:     financeService,
+		certificateService: certificateService,
+		reportService:      reportService,
+		payoutService:      payoutService,
+		jobs:               jobs,
 	}
 }
 
+// getUserID returns the caller the interceptor verified from the token. The
+// x-user-id header is only consulted when there are no claims, so a caller
+// cannot act as someone else by sending it.
 func (h *FinanceHandler) getUserID(ctx context.Context) (uuid.UUID, error) {
-	if userIDStr, ok := ctx.Value(userIDKey).(string); ok {
-		return uuid.Parse(userIDStr)
+	if userID, ok := ctx.Value(userIDKey).(uuid.UUID); ok {
+		return userID, nil
 	}
 
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
@@ -39,10 +56,16 @@ func (h *FinanceHandler) getUserID(ctx context.Context) (uuid.UUID, error) {
 	return uuid.Nil, status.Error(codes.Unauthenticated, "user id not found in context")
 }
 
+// isAdmin reports whether the caller authenticated as an admin.
+func (h *FinanceHandler) isAdmin(ctx context.Context) bool {
+	role, _ := ctx.Value(userRoleKey).(string)
+	return role == "ADMIN"
+}
+
 func (h *FinanceHandler) CreateTransaction(ctx context.Context, req *pb.TransactionRequest) (*pb.Transaction, error) {
 	tx, err := h.financeService.CreateTransaction(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapFinanceError(err)
 	}
 	return mapTransactionToProto(tx), nil
 }
@@ -53,14 +76,27 @@ func (h *FinanceHandler) TopUpWallet(ctx context.Context, req *pb.TopUpRequest)
 		return nil, err
 	}
 
-	tx, err := h.financeService.TopUpWallet(ctx, userID, req.Amount, req.DurationSeconds)
+	tx, err := h.financeService.TopUpWallet(ctx, userID, req.Amount, req.Currency, req.DurationSeconds, req.VoucherCode, models.PaymentMethod{Method: req.PaymentMethod, Channel: req.PaymentChannel})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapFinanceError(err)
 	}
 
 	return mapTransactionToProto(tx), nil
 }
 
+func (h *FinanceHandler) TransferBalance(ctx context.Context, req *pb.TransferRequest) (*pb.Transaction, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := h.financeService.TransferBalance(ctx, userID, req.RecipientEmail, req.Amount)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapTransactionToProto(tx), nil
+}
+
 func (h *FinanceHandler) HandleWalletWebhook(ctx context.Context, req *pb.WebhookRequest) (*emptypb.Empty, error) {
 	err := h.financeService.HandleWalletWebhook(ctx, req.Event, req.Data)
 	if err != nil {
@@ -69,18 +105,102 @@ func (h *FinanceHandler) HandleWalletWebhook(ctx context.Context, req *pb.Webhoo
 	return &emptypb.Empty{}, nil
 }
 
-func (h *FinanceHandler) GetBalance(ctx context.Context, _ *emptypb.Empty) (*pb.BalanceResponse, error) {
+func (h *FinanceHandler) GetBalance(ctx context.Context, req *pb.BalanceRequest) (*pb.BalanceResponse, error) {
 	userID, err := h.getUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	balance, err := h.financeService.GetBalance(ctx, userID)
+	wallet, err := h.financeService.GetBalance(ctx, userID, req.DisplayCurrency)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapFinanceError(err)
+	}
+
+	return &pb.BalanceResponse{
+		Balance:                 wallet.Balance,
+		Currency:                wallet.Currency,
+		DisplayBalance:          wallet.DisplayBalance,
+		DisplayCurrency:         wallet.DisplayCurrency,
+		AvailableBalance:        wallet.Available,
+		DisplayAvailableBalance: wallet.DisplayAvailable,
+	}, nil
+}
+
+func (h *FinanceHandler) CreateBalanceHold(ctx context.Context, req *pb.CreateBalanceHoldRequest) (*pb.BalanceHold, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hold, err := h.financeService.CreateBalanceHold(ctx, userID, req)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapBalanceHoldToProto(hold), nil
+}
+
+func (h *FinanceHandler) CaptureBalanceHold(ctx context.Context, req *pb.BalanceHoldRequest) (*pb.BalanceHold, error) {
+	holdID, err := uuid.Parse(req.HoldId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid hold id")
+	}
+
+	hold, err := h.financeService.CaptureBalanceHold(ctx, holdID)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapBalanceHoldToProto(hold), nil
+}
+
+func (h *FinanceHandler) ReleaseBalanceHold(ctx context.Context, req *pb.BalanceHoldRequest) (*pb.BalanceHold, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	holdID, err := uuid.Parse(req.HoldId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid hold id")
+	}
+
+	hold, err := h.financeService.ReleaseBalanceHold(ctx, userID, h.isAdmin(ctx), holdID)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapBalanceHoldToProto(hold), nil
+}
+
+func (h *FinanceHandler) ListBalanceHolds(ctx context.Context, _ *emptypb.Empty) (*pb.BalanceHoldList, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.BalanceResponse{Balance: balance}, nil
+	holds, err := h.financeService.ListBalanceHolds(ctx, userID)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+
+	res := &pb.BalanceHoldList{}
+	for i := range holds {
+		res.Holds = append(res.Holds, mapBalanceHoldToProto(&holds[i]))
+	}
+	return res, nil
+}
+
+func (h *FinanceHandler) GetExchangeRates(ctx context.Context, _ *emptypb.Empty) (*pb.ExchangeRateList, error) {
+	base, rates := h.financeService.GetExchangeRates(ctx)
+
+	pbRates := make([]*pb.ExchangeRate, len(rates))
+	for i, rate := range rates {
+		pbRates[i] = &pb.ExchangeRate{
+			Currency:          rate.Currency,
+			Rate:              rate.Value,
+			MinorUnitExponent: int32(money.Exponent(rate.Currency)),
+		}
+	}
+
+	return &pb.ExchangeRateList{BaseCurrency: base, Rates: pbRates}, nil
 }
 
 func (h *FinanceHandler) GetTransactionHistory(ctx context.Context, _ *emptypb.Empty) (*pb.TransactionList, error) {
@@ -102,6 +222,24 @@ func (h *FinanceHandler) GetTransactionHistory(ctx context.Context, _ *emptypb.E
 	return &pb.TransactionList{Transactions: pbTxs}, nil
 }
 
+func (h *FinanceHandler) CancelPayment(ctx context.Context, req *pb.CancelPaymentRequest) (*pb.Transaction, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := uuid.Parse(req.TransactionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+	}
+
+	tx, err := h.financeService.CancelPayment(ctx, userID, txID)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapTransactionToProto(tx), nil
+}
+
 func (h *FinanceHandler) CheckPaymentExpiry(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
 	err := h.financeService.CheckPaymentExpiry(ctx)
 	if err != nil {
@@ -110,8 +248,609 @@ func (h *FinanceHandler) CheckPaymentExpiry(ctx context.Context, _ *emptypb.Empt
 	return &emptypb.Empty{}, nil
 }
 
+func (h *FinanceHandler) ReconcilePayments(ctx context.Context, _ *emptypb.Empty) (*pb.ReconciliationReport, error) {
+	report, err := h.financeService.ReconcilePayments(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return mapReconciliationReportToProto(report), nil
+}
+
+func (h *FinanceHandler) ListReconciliationReports(ctx context.Context, req *pb.ListReconciliationReportsRequest) (*pb.ReconciliationReportList, error) {
+	reports, err := h.financeService.ListReconciliationReports(ctx, int(req.Limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbReports := make([]*pb.ReconciliationReport, len(reports))
+	for i := range reports {
+		pbReports[i] = mapReconciliationReportToProto(&reports[i])
+	}
+	return &pb.ReconciliationReportList{Reports: pbReports}, nil
+}
+
+func (h *FinanceHandler) CreateVoucher(ctx context.Context, req *pb.Voucher) (*pb.Voucher, error) {
+	voucher := &models.Voucher{
+		Code:            req.Code,
+		Description:     req.Description,
+		DiscountType:    req.DiscountType,
+		Value:           req.Value,
+		Currency:        req.Currency,
+		TransactionType: req.TransactionType,
+		SpeciesID:       req.SpeciesId,
+		PlotID:          req.PlotId,
+		MaxRedemptions:  int(req.MaxRedemptions),
+		MaxPerUser:      int(req.MaxPerUser),
+	}
+	if req.ValidFrom != nil {
+		t := req.ValidFrom.AsTime()
+		voucher.ValidFrom = &t
+	}
+	if req.ValidUntil != nil {
+		t := req.ValidUntil.AsTime()
+		voucher.ValidUntil = &t
+	}
+
+	created, err := h.financeService.CreateVoucher(ctx, voucher)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapVoucherToProto(created), nil
+}
+
+func (h *FinanceHandler) ListVouchers(ctx context.Context, _ *emptypb.Empty) (*pb.VoucherList, error) {
+	vouchers, err := h.financeService.ListVouchers(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbVouchers := make([]*pb.Voucher, len(vouchers))
+	for i := range vouchers {
+		pbVouchers[i] = mapVoucherToProto(&vouchers[i])
+	}
+	return &pb.VoucherList{Vouchers: pbVouchers}, nil
+}
+
+func (h *FinanceHandler) ListTopUpReviews(ctx context.Context, req *pb.ListTopUpReviewsRequest) (*pb.TopUpReviewList, error) {
+	reviews, err := h.financeService.ListTopUpReviews(ctx, req.Status)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbReviews := make([]*pb.TopUpReview, len(reviews))
+	for i := range reviews {
+		pbReviews[i] = mapTopUpReviewToProto(&reviews[i])
+	}
+	return &pb.TopUpReviewList{Reviews: pbReviews}, nil
+}
+
+func (h *FinanceHandler) ApproveTopUpReview(ctx context.Context, req *pb.DecideTopUpReviewRequest) (*pb.TopUpReview, error) {
+	reviewerID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid review id")
+	}
+
+	review, err := h.financeService.ApproveTopUpReview(ctx, reviewerID, reviewID, req.Note)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapTopUpReviewToProto(review), nil
+}
+
+func (h *FinanceHandler) RejectTopUpReview(ctx context.Context, req *pb.DecideTopUpReviewRequest) (*pb.TopUpReview, error) {
+	reviewerID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid review id")
+	}
+
+	review, err := h.financeService.RejectTopUpReview(ctx, reviewerID, reviewID, req.Note)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapTopUpReviewToProto(review), nil
+}
+
+func (h *FinanceHandler) RequestBalanceAdjustment(ctx context.Context, req *pb.BalanceAdjustmentRequest) (*pb.BalanceAdjustment, error) {
+	requesterID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustment, err := h.financeService.RequestBalanceAdjustment(ctx, requesterID, req)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapBalanceAdjustmentToProto(adjustment), nil
+}
+
+func (h *FinanceHandler) ApproveBalanceAdjustment(ctx context.Context, req *pb.DecideBalanceAdjustmentRequest) (*pb.BalanceAdjustment, error) {
+	approverID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustmentID, err := uuid.Parse(req.AdjustmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid adjustment id")
+	}
+
+	adjustment, err := h.financeService.ApproveBalanceAdjustment(ctx, approverID, adjustmentID, req.Note)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapBalanceAdjustmentToProto(adjustment), nil
+}
+
+func (h *FinanceHandler) RejectBalanceAdjustment(ctx context.Context, req *pb.DecideBalanceAdjustmentRequest) (*pb.BalanceAdjustment, error) {
+	deciderID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustmentID, err := uuid.Parse(req.AdjustmentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid adjustment id")
+	}
+
+	adjustment, err := h.financeService.RejectBalanceAdjustment(ctx, deciderID, adjustmentID, req.Note)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapBalanceAdjustmentToProto(adjustment), nil
+}
+
+func (h *FinanceHandler) ListBalanceAdjustments(ctx context.Context, req *pb.ListBalanceAdjustmentsRequest) (*pb.BalanceAdjustmentList, error) {
+	adjustments, err := h.financeService.ListBalanceAdjustments(ctx, req.Status)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbAdjustments := make([]*pb.BalanceAdjustment, len(adjustments))
+	for i := range adjustments {
+		pbAdjustments[i] = mapBalanceAdjustmentToProto(&adjustments[i])
+	}
+	return &pb.BalanceAdjustmentList{Adjustments: pbAdjustments}, nil
+}
+
+func (h *FinanceHandler) CreatePartner(ctx context.Context, req *pb.Partner) (*pb.Partner, error) {
+	partner, err := h.payoutService.CreatePartner(ctx, mapProtoToPartner(req))
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapPartnerToProto(partner), nil
+}
+
+func (h *FinanceHandler) UpdatePartner(ctx context.Context, req *pb.Partner) (*pb.Partner, error) {
+	partnerID, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid partner id")
+	}
+
+	partner := mapProtoToPartner(req)
+	partner.ID = partnerID
+	updated, err := h.payoutService.UpdatePartner(ctx, partner)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapPartnerToProto(updated), nil
+}
+
+func (h *FinanceHandler) ListPartners(ctx context.Context, _ *emptypb.Empty) (*pb.PartnerList, error) {
+	partners, err := h.payoutService.ListPartners(ctx)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+
+	pbPartners := make([]*pb.Partner, len(partners))
+	for i := range partners {
+		pbPartners[i] = mapPartnerToProto(&partners[i])
+	}
+	return &pb.PartnerList{Partners: pbPartners}, nil
+}
+
+func (h *FinanceHandler) CalculatePayouts(ctx context.Context, req *pb.CalculatePayoutsRequest) (*pb.PayoutList, error) {
+	payouts, err := h.payoutService.CalculatePayouts(ctx, int(req.Year), time.Month(req.Month))
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapPayoutsToProto(payouts), nil
+}
+
+func (h *FinanceHandler) ListPayouts(ctx context.Context, req *pb.ListPayoutsRequest) (*pb.PayoutList, error) {
+	var partnerID uuid.UUID
+	if req.PartnerId != "" {
+		var err error
+		if partnerID, err = uuid.Parse(req.PartnerId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid partner id")
+		}
+	}
+
+	payouts, err := h.payoutService.ListPayouts(ctx, partnerID, req.Status)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapPayoutsToProto(payouts), nil
+}
+
+func (h *FinanceHandler) UpdatePayoutStatus(ctx context.Context, req *pb.PayoutStatusRequest) (*pb.Payout, error) {
+	payoutID, err := uuid.Parse(req.PayoutId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payout id")
+	}
+
+	payout, err := h.payoutService.UpdatePayoutStatus(ctx, payoutID, req.Status, req.Reference, req.Note)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapPayoutToProto(payout), nil
+}
+
+func (h *FinanceHandler) GetPayoutStatement(ctx context.Context, req *pb.PayoutStatementRequest) (*pb.Document, error) {
+	payoutID, err := uuid.Parse(req.PayoutId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payout id")
+	}
+
+	doc, err := h.payoutService.GetPayoutStatement(ctx, payoutID, req.Format)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapDocumentToProto(doc), nil
+}
+
+func (h *FinanceHandler) CreateMatchingCampaign(ctx context.Context, req *pb.MatchingCampaign) (*pb.MatchingCampaign, error) {
+	creatorID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign := &models.MatchingCampaign{
+		Name:                req.Name,
+		Organization:        req.Organization,
+		EmailDomains:        strings.Join(req.EmailDomains, ","),
+		MatchRatio:          int(req.MatchRatio),
+		MaxTreesPerEmployee: int(req.MaxTreesPerEmployee),
+		BudgetAmount:        req.BudgetAmount,
+		Currency:            req.Currency,
+	}
+	if req.StartsAt != nil {
+		t := req.StartsAt.AsTime()
+		campaign.StartsAt = &t
+	}
+	if req.EndsAt != nil {
+		t := req.EndsAt.AsTime()
+		campaign.EndsAt = &t
+	}
+
+	created, err := h.financeService.CreateMatchingCampaign(ctx, creatorID, campaign)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapMatchingCampaignToProto(created), nil
+}
+
+func (h *FinanceHandler) ListMatchingCampaigns(ctx context.Context, _ *emptypb.Empty) (*pb.MatchingCampaignList, error) {
+	campaigns, err := h.financeService.ListMatchingCampaigns(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbCampaigns := make([]*pb.MatchingCampaign, len(campaigns))
+	for i := range campaigns {
+		pbCampaigns[i] = mapMatchingCampaignToProto(&campaigns[i])
+	}
+	return &pb.MatchingCampaignList{Campaigns: pbCampaigns}, nil
+}
+
+func (h *FinanceHandler) UpdateMatchingCampaignStatus(ctx context.Context, req *pb.MatchingCampaignStatusRequest) (*pb.MatchingCampaign, error) {
+	campaignID, err := uuid.Parse(req.CampaignId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid campaign id")
+	}
+
+	campaign, err := h.financeService.UpdateMatchingCampaignStatus(ctx, campaignID, req.Status)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapMatchingCampaignToProto(campaign), nil
+}
+
+func (h *FinanceHandler) ListCampaignMatches(ctx context.Context, req *pb.ListCampaignMatchesRequest) (*pb.CampaignMatchList, error) {
+	campaignID, err := uuid.Parse(req.CampaignId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid campaign id")
+	}
+
+	matches, err := h.financeService.ListCampaignMatches(ctx, campaignID)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+
+	pbMatches := make([]*pb.CampaignMatch, len(matches))
+	for i, m := range matches {
+		pbMatches[i] = &pb.CampaignMatch{
+			Id:            m.ID.String(),
+			CampaignId:    m.CampaignID.String(),
+			UserId:        m.UserID.String(),
+			TransactionId: m.TransactionID.String(),
+			ReferenceId:   m.ReferenceID,
+			Trees:         int32(m.Trees),
+			Amount:        m.Amount,
+			Currency:      m.Currency,
+			CreatedAt:     timestamppb.New(m.CreatedAt),
+		}
+	}
+	return &pb.CampaignMatchList{Matches: pbMatches}, nil
+}
+
+func (h *FinanceHandler) GetRevenueReport(ctx context.Context, req *pb.RevenueReportRequest) (*pb.RevenueReport, error) {
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	rows, from, to, err := h.reportService.RevenueReport(ctx, req.GroupBy, from, to)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+
+	pbRows := make([]*pb.RevenueRow, len(rows))
+	for i, row := range rows {
+		pbRows[i] = &pb.RevenueRow{
+			Key:              row.Key,
+			Label:            row.Label,
+			Currency:         row.Currency,
+			TransactionCount: row.Count,
+			Amount:           row.Amount,
+			DiscountAmount:   row.DiscountAmount,
+		}
+	}
+	return &pb.RevenueReport{
+		GroupBy: req.GroupBy,
+		From:    timestamppb.New(from),
+		To:      timestamppb.New(to),
+		Rows:    pbRows,
+	}, nil
+}
+
+func (h *FinanceHandler) ExportJournal(ctx context.Context, req *pb.JournalExportRequest) (*pb.Document, error) {
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	doc, err := h.reportService.ExportJournal(ctx, from, to, req.Format)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapDocumentToProto(doc), nil
+}
+
+func (h *FinanceHandler) GetPendingInvoiceReport(ctx context.Context, _ *emptypb.Empty) (*pb.PendingInvoiceReport, error) {
+	txs, totals, err := h.reportService.PendingInvoices(ctx)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+
+	invoices := make([]*pb.Transaction, len(txs))
+	for i := range txs {
+		invoices[i] = mapTransactionToProto(&txs[i])
+	}
+	return &pb.PendingInvoiceReport{Invoices: invoices, Totals: mapCurrencyTotalsToProto(totals)}, nil
+}
+
+func (h *FinanceHandler) GetWalletLiabilityReport(ctx context.Context, _ *emptypb.Empty) (*pb.WalletLiabilityReport, error) {
+	totals, err := h.reportService.WalletLiabilities(ctx)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return &pb.WalletLiabilityReport{AsOf: timestamppb.Now(), Totals: mapCurrencyTotalsToProto(totals)}, nil
+}
+
+func (h *FinanceHandler) GetPaymentMethodReport(ctx context.Context, req *pb.PaymentMethodReportRequest) (*pb.PaymentMethodReport, error) {
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	rows, from, to, err := h.reportService.PaymentMethods(ctx, from, to)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+
+	pbRows := make([]*pb.PaymentMethodRow, len(rows))
+	for i, row := range rows {
+		pbRows[i] = &pb.PaymentMethodRow{
+			Method:    row.Method,
+			Channel:   row.Channel,
+			Currency:  row.Currency,
+			Count:     row.Count,
+			Amount:    row.Amount,
+			FeeAmount: row.FeeAmount,
+		}
+	}
+	return &pb.PaymentMethodReport{
+		From: timestamppb.New(from),
+		To:   timestamppb.New(to),
+		Rows: pbRows,
+	}, nil
+}
+
+func (h *FinanceHandler) ListJobs(ctx context.Context, _ *emptypb.Empty) (*pb.JobList, error) {
+	jobs, err := h.jobs.Jobs(ctx, 5)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbJobs := make([]*pb.Job, len(jobs))
+	for i, job := range jobs {
+		runs := make([]*pb.JobRun, len(job.RecentRuns))
+		for j := range job.RecentRuns {
+			runs[j] = mapJobRunToProto(&job.RecentRuns[j])
+		}
+		pbJobs[i] = &pb.Job{
+			Name:           job.Name,
+			Description:    job.Description,
+			Schedule:       job.Spec,
+			Enabled:        job.Enabled,
+			TimeoutSeconds: int64(job.Timeout.Seconds()),
+			RecentRuns:     runs,
+		}
+		if !job.NextRunAt.IsZero() {
+			pbJobs[i].NextRunAt = timestamppb.New(job.NextRunAt)
+		}
+	}
+	return &pb.JobList{Jobs: pbJobs}, nil
+}
+
+func (h *FinanceHandler) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.JobRun, error) {
+	run, err := h.jobs.Trigger(ctx, req.GetName())
+	switch {
+	case errors.Is(err, scheduler.ErrJobNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, scheduler.ErrJobRunning):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return mapJobRunToProto(run), nil
+}
+
+func (h *FinanceHandler) GetReceipt(ctx context.Context, req *pb.ReceiptRequest) (*pb.Document, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := uuid.Parse(req.TransactionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+	}
+
+	doc, err := h.financeService.GetReceipt(ctx, userID, txID, req.Format)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapDocumentToProto(doc), nil
+}
+
+func (h *FinanceHandler) GetStatement(ctx context.Context, req *pb.StatementRequest) (*pb.Document, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := h.financeService.GetStatement(ctx, userID, req)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapDocumentToProto(doc), nil
+}
+
+func (h *FinanceHandler) IssueDonationCertificate(ctx context.Context, req *pb.CertificateRequest) (*pb.Document, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := h.certificateService.IssueCertificate(ctx, userID, req)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+	return mapDocumentToProto(doc), nil
+}
+
+func (h *FinanceHandler) VerifyDonationCertificate(ctx context.Context, req *pb.VerifyCertificateRequest) (*pb.CertificateVerification, error) {
+	if req.VerificationCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "verification code is required")
+	}
+
+	cert, valid, err := h.certificateService.VerifyCertificate(ctx, req.VerificationCode)
+	if err != nil {
+		return nil, mapFinanceError(err)
+	}
+
+	return &pb.CertificateVerification{
+		Valid:             valid,
+		CertificateNumber: cert.Number,
+		RecipientName:     cert.RecipientName,
+		OrganizationName:  cert.OrganizationName,
+		Year:              int32(cert.Year),
+		TotalAmount:       cert.TotalAmount,
+		IssuedAt:          timestamppb.New(cert.IssuedAt),
+		Currency:          cert.Currency,
+	}, nil
+}
+
+func mapFinanceError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrCurrencyMismatch), errors.Is(err, models.ErrVoucherUnavailable),
+		errors.Is(err, models.ErrInsufficientBalance), errors.Is(err, models.ErrLimitExceeded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func mapCurrencyTotalsToProto(totals []repository.CurrencyTotal) []*pb.CurrencyTotal {
+	res := make([]*pb.CurrencyTotal, len(totals))
+	for i, t := range totals {
+		res[i] = &pb.CurrencyTotal{Currency: t.Currency, Count: t.Count, Amount: t.Amount}
+	}
+	return res
+}
+
+func mapJobRunToProto(run *scheduler.Run) *pb.JobRun {
+	res := &pb.JobRun{
+		Id:          run.ID,
+		Job:         run.Job,
+		Trigger:     run.Trigger,
+		Status:      run.Status,
+		ScheduledAt: timestamppb.New(run.ScheduledAt),
+		StartedAt:   timestamppb.New(run.StartedAt),
+		DurationMs:  run.Duration.Milliseconds(),
+		Error:       run.Error,
+	}
+	if !run.FinishedAt.IsZero() {
+		res.FinishedAt = timestamppb.New(run.FinishedAt)
+	}
+	return res
+}
+
+func mapDocumentToProto(doc *models.Document) *pb.Document {
+	return &pb.Document{
+		FileName:    doc.FileName,
+		ContentType: doc.ContentType,
+		Content:     doc.Content,
+	}
+}
+
 func mapTransactionToProto(tx *models.Transaction) *pb.Transaction {
-	return &pb.Transaction{
+	res := &pb.Transaction{
 		Id:     tx.ID.String(),
 		UserId: tx.UserID.String(),
 		Amount: tx.Amount,
@@ -120,5 +859,237 @@ func mapTransactionToProto(tx *models.Transaction) *pb.Transaction {
 		PaymentUrl: tx.Payment.PaymentURL,
 		ExpiresAt:  timestamppb.New(tx.Payment.ExpiresAt),
 		InvoiceId:  tx.ID.String(),
+		Currency:         tx.Currency,
+		OriginalAmount:   tx.OriginalAmount,
+		OriginalCurrency: tx.OriginalCurrency,
+		ExchangeRate:     tx.ExchangeRate,
+		VoucherCode:      tx.VoucherCode,
+		DiscountAmount:   tx.DiscountAmount,
+		PaymentAmount:    tx.Payment.Amount,
+		PaymentMethod:    tx.Payment.Method,
+		PaymentChannel:   tx.Payment.Channel,
 	}
-}
\ No newline at end of file
+	if tx.Direction != "" {
+		res.Direction = tx.Direction
+	}
+	if tx.CounterpartyID != nil {
+		res.CounterpartyId = tx.CounterpartyID.String()
+	}
+	return res
+}
+
+func mapBalanceHoldToProto(hold *models.BalanceHold) *pb.BalanceHold {
+	res := &pb.BalanceHold{
+		Id:          hold.ID.String(),
+		UserId:      hold.UserID.String(),
+		Amount:      hold.Amount,
+		Currency:    hold.Currency,
+		Type:        hold.Type,
+		ReferenceId: hold.ReferenceID,
+		Description: hold.Description,
+		Status:      hold.Status,
+		CreatedAt:   timestamppb.New(hold.CreatedAt),
+	}
+	if hold.TransactionID != nil {
+		res.TransactionId = hold.TransactionID.String()
+	}
+	if hold.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*hold.ExpiresAt)
+	}
+	return res
+}
+
+func mapTopUpReviewToProto(review *models.TopUpReview) *pb.TopUpReview {
+	res := &pb.TopUpReview{
+		Id:            review.ID.String(),
+		TransactionId: review.TransactionID.String(),
+		UserId:        review.UserID.String(),
+		Amount:        review.Amount,
+		Currency:      review.Currency,
+		Reasons:       review.Reasons,
+		Status:        review.Status,
+		Note:          review.Note,
+		CreatedAt:     timestamppb.New(review.CreatedAt),
+	}
+	if review.Rules != "" {
+		res.Rules = strings.Split(review.Rules, ",")
+	}
+	if review.ReviewerID != nil {
+		res.ReviewerId = review.ReviewerID.String()
+	}
+	if review.ReviewedAt != nil {
+		res.ReviewedAt = timestamppb.New(*review.ReviewedAt)
+	}
+	return res
+}
+
+func mapBalanceAdjustmentToProto(adjustment *models.BalanceAdjustment) *pb.BalanceAdjustment {
+	res := &pb.BalanceAdjustment{
+		Id:              adjustment.ID.String(),
+		UserId:          adjustment.UserID.String(),
+		Amount:          adjustment.Amount,
+		Currency:        adjustment.Currency,
+		Reason:          adjustment.Reason,
+		TicketReference: adjustment.TicketReference,
+		Status:          adjustment.Status,
+		RequestedBy:     adjustment.RequestedBy.String(),
+		DecisionNote:    adjustment.DecisionNote,
+		CreatedAt:       timestamppb.New(adjustment.CreatedAt),
+	}
+	if adjustment.DecidedBy != nil {
+		res.DecidedBy = adjustment.DecidedBy.String()
+	}
+	if adjustment.DecidedAt != nil {
+		res.DecidedAt = timestamppb.New(*adjustment.DecidedAt)
+	}
+	if adjustment.TransactionID != nil {
+		res.TransactionId = adjustment.TransactionID.String()
+	}
+	return res
+}
+
+func mapProtoToPartner(req *pb.Partner) *models.Partner {
+	partner := &models.Partner{
+		Name:              req.Name,
+		Email:             req.Email,
+		BankName:          req.BankName,
+		BankAccountNumber: req.BankAccountNumber,
+		BankAccountName:   req.BankAccountName,
+		ShareBps:          int(req.ShareBps),
+	}
+	for _, plot := range req.Plots {
+		partner.Plots = append(partner.Plots, models.PartnerPlot{PlotID: plot.PlotId, ShareBps: int(plot.ShareBps)})
+	}
+	return partner
+}
+
+func mapPartnerToProto(partner *models.Partner) *pb.Partner {
+	res := &pb.Partner{
+		Id:                partner.ID.String(),
+		Name:              partner.Name,
+		Email:             partner.Email,
+		BankName:          partner.BankName,
+		BankAccountNumber: partner.BankAccountNumber,
+		BankAccountName:   partner.BankAccountName,
+		ShareBps:          int32(partner.ShareBps),
+		CreatedAt:         timestamppb.New(partner.CreatedAt),
+	}
+	for _, plot := range partner.Plots {
+		res.Plots = append(res.Plots, &pb.PartnerPlot{PlotId: plot.PlotID, ShareBps: int32(plot.ShareBps)})
+	}
+	return res
+}
+
+func mapPayoutsToProto(payouts []models.Payout) *pb.PayoutList {
+	res := &pb.PayoutList{Payouts: make([]*pb.Payout, len(payouts))}
+	for i := range payouts {
+		res.Payouts[i] = mapPayoutToProto(&payouts[i])
+	}
+	return res
+}
+
+func mapPayoutToProto(payout *models.Payout) *pb.Payout {
+	res := &pb.Payout{
+		Id:            payout.ID.String(),
+		PartnerId:     payout.PartnerID.String(),
+		PartnerName:   payout.Partner.Name,
+		PeriodStart:   timestamppb.New(payout.PeriodStart),
+		PeriodEnd:     timestamppb.New(payout.PeriodEnd),
+		Currency:      payout.Currency,
+		RevenueAmount: payout.RevenueAmount,
+		Amount:        payout.Amount,
+		Status:        payout.Status,
+		Reference:     payout.Reference,
+		Note:          payout.Note,
+		CreatedAt:     timestamppb.New(payout.CreatedAt),
+	}
+	for _, line := range payout.Lines {
+		res.Lines = append(res.Lines, &pb.PayoutLine{
+			PlotId:           line.PlotID,
+			PlotName:         line.PlotName,
+			TransactionCount: line.TransactionCount,
+			RevenueAmount:    line.RevenueAmount,
+			ShareBps:         int32(line.ShareBps),
+			Amount:           line.Amount,
+		})
+	}
+	if payout.PaidAt != nil {
+		res.PaidAt = timestamppb.New(*payout.PaidAt)
+	}
+	return res
+}
+
+func mapMatchingCampaignToProto(c *models.MatchingCampaign) *pb.MatchingCampaign {
+	res := &pb.MatchingCampaign{
+		Id:                  c.ID.String(),
+		Name:                c.Name,
+		Organization:        c.Organization,
+		EmailDomains:        strings.Split(c.EmailDomains, ","),
+		MatchRatio:          int32(c.MatchRatio),
+		MaxTreesPerEmployee: int32(c.MaxTreesPerEmployee),
+		BudgetAmount:        c.BudgetAmount,
+		SpentAmount:         c.SpentAmount,
+		Currency:            c.Currency,
+		Status:              c.Status,
+		CreatedAt:           timestamppb.New(c.CreatedAt),
+	}
+	if c.StartsAt != nil {
+		res.StartsAt = timestamppb.New(*c.StartsAt)
+	}
+	if c.EndsAt != nil {
+		res.EndsAt = timestamppb.New(*c.EndsAt)
+	}
+	return res
+}
+
+func mapVoucherToProto(v *models.Voucher) *pb.Voucher {
+	res := &pb.Voucher{
+		Id:              v.ID.String(),
+		Code:            v.Code,
+		Description:     v.Description,
+		DiscountType:    v.DiscountType,
+		Value:           v.Value,
+		Currency:        v.Currency,
+		TransactionType: v.TransactionType,
+		SpeciesId:       v.SpeciesID,
+		PlotId:          v.PlotID,
+		MaxRedemptions:  int32(v.MaxRedemptions),
+		MaxPerUser:      int32(v.MaxPerUser),
+		RedemptionCount: int32(v.RedemptionCount),
+		CreatedAt:       timestamppb.New(v.CreatedAt),
+	}
+	if v.ValidFrom != nil {
+		res.ValidFrom = timestamppb.New(*v.ValidFrom)
+	}
+	if v.ValidUntil != nil {
+		res.ValidUntil = timestamppb.New(*v.ValidUntil)
+	}
+	return res
+}
+
+func mapReconciliationReportToProto(report *models.ReconciliationReport) *pb.ReconciliationReport {
+	discrepancies := make([]*pb.ReconciliationDiscrepancy, len(report.Discrepancies))
+	for i, d := range report.Discrepancies {
+		discrepancies[i] = &pb.ReconciliationDiscrepancy{
+			TransactionId:  d.TransactionID.String(),
+			UserId:         d.UserID.String(),
+			Type:           d.Type,
+			Amount:         d.Amount,
+			Currency:       d.Currency,
+			Kind:           d.Kind,
+			LocalStatus:    d.LocalStatus,
+			ProviderStatus: d.ProviderStatus,
+			Action:         d.Action,
+			Detail:         d.Detail,
+		}
+	}
+
+	return &pb.ReconciliationReport{
+		Id:            report.ID.String(),
+		StartedAt:     timestamppb.New(report.StartedAt),
+		FinishedAt:    timestamppb.New(report.FinishedAt),
+		Checked:       int32(report.Checked),
+		Fixed:         int32(report.Fixed),
+		Discrepancies: discrepancies,
+	}
+}
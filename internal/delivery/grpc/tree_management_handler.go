@@ -5,8 +5,10 @@ import (
 	"errors"
 	"reforest/internal/models"
 	"reforest/internal/service"
+	"reforest/pkg/money"
 	"reforest/pkg/pb"
 
+	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -28,25 +30,46 @@ func NewTreeManagementHandler(treeService service.TreeManagementService) *TreeMa
 }
 
 func mapSpeciesToProto(s *models.Species) *pb.Species {
+	currency := s.Currency
+	if currency == "" {
+		// Species created before prices carried a currency are priced in IDR.
+		currency = money.DefaultCurrency
+	}
 	return &pb.Species{
 		Id:              s.ID.Hex(),
 		CommonName:      s.CommonName,
 		SpaceRequiredM2: float32(s.SpaceRequiredM2),
 		Price:           s.Price,
+		Currency:        currency,
 	}
 }
 
 func mapPlotToProto(p *models.Plot) *pb.Plot {
-	return &pb.Plot{
+	plot := &pb.Plot{
 		Id:               p.ID.Hex(),
 		LocationName:     p.LocationName,
 		Address:          p.Address,
 		AvailableSpaceM2: float32(p.AvailableSpaceM2),
 	}
+	if p.Location != nil {
+		plot.Location = mapGeoPointToProto(p.Location.Coordinates)
+	}
+	if p.Boundary != nil && len(p.Boundary.Coordinates) > 0 {
+		ring := p.Boundary.Coordinates[0]
+		for _, pos := range ring[:len(ring)-1] {
+			plot.Boundary = append(plot.Boundary, mapGeoPointToProto(pos))
+		}
+	}
+	return plot
+}
+
+// mapGeoPointToProto converts a GeoJSON [longitude, latitude] position.
+func mapGeoPointToProto(pos []float64) *pb.GeoPoint {
+	return &pb.GeoPoint{Latitude: pos[1], Longitude: pos[0]}
 }
 
 func mapTreeToProto(t *models.Tree) *pb.Tree {
-	return &pb.Tree{
+	tree := &pb.Tree{
 		Id:                  t.ID.Hex(),
 		SponsorId:           t.SponsorID,
 		SpeciesId:           t.SpeciesID.Hex(),
@@ -55,11 +78,34 @@ func mapTreeToProto(t *models.Tree) *pb.Tree {
 		CurrentHeightMeters: float32(t.CurrentHeightMeters),
 		LastCareDate:        timestamppb.New(t.LastCareDate),
 		AdoptedAt:           timestamppb.New(t.AdoptedAt),
+		Position:            mapGridPositionToProto(t.Position),
+	}
+	if t.Location != nil {
+		tree.Location = mapGeoPointToProto(t.Location.Coordinates)
+	}
+	return tree
+}
+
+func mapGridPositionToProto(p *models.GridPosition) *pb.GridPosition {
+	if p == nil {
+		return nil
+	}
+	return &pb.GridPosition{Row: int32(p.Row), Column: int32(p.Column)}
+}
+
+func mapIntentToProto(i *models.AdoptionIntent) *pb.AdoptionIntent {
+	return &pb.AdoptionIntent{
+		Id:        i.ID.Hex(),
+		SponsorId: i.SponsorID,
+		SpeciesId: i.SpeciesID.Hex(),
+		PlotId:    i.PlotID.Hex(),
+		Status:    i.Status,
+		CreatedAt: timestamppb.New(i.CreatedAt),
 	}
 }
 
 func mapLogToProto(l *models.LogEntry) *pb.LogEntry {
-	return &pb.LogEntry{
+	log := &pb.LogEntry{
 		Id:                  l.ID.Hex(),
 		AdoptedTreeId:       l.AdoptedTreeID.Hex(),
 		AdminId:             l.AdminID,
@@ -67,12 +113,19 @@ func mapLogToProto(l *models.LogEntry) *pb.LogEntry {
 		Activity:            l.Activity,
 		Note:                l.Note,
 		RecordedAt:          timestamppb.New(l.RecordedAt),
+		Position:            mapGridPositionToProto(l.Position),
 	}
+	if l.Location != nil {
+		log.Location = mapGeoPointToProto(l.Location.Coordinates)
+	}
+	return log
 }
 
+// getUserID returns the caller the interceptor verified from the token,
+// falling back to the x-user-id header only when there are no claims.
 func (h *TreeManagementHandler) getUserID(ctx context.Context) (string, error) {
-	if userID, ok := ctx.Value(userIDKey).(string); ok && userID != "" {
-		return userID, nil
+	if userID, ok := ctx.Value(userIDKey).(uuid.UUID); ok {
+		return userID.String(), nil
 	}
 
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -95,6 +148,9 @@ func (h *TreeManagementHandler) CreateSpecies(ctx context.Context, req *pb.Speci
 		if errors.Is(err, models.ErrAlreadyExists) {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
 		}
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to create species")
 	}
 	return mapSpeciesToProto(createdSpecies), nil
@@ -112,9 +168,12 @@ func (h *TreeManagementHandler) GetSpecies(ctx context.Context, req *pb.IdReques
 	return mapSpeciesToProto(species), nil
 }
 
-func (h *TreeManagementHandler) ListSpecies(ctx context.Context, _ *emptypb.Empty) (*pb.SpeciesList, error) {
-	speciesList, err := h.treeService.ListSpecies(ctx)
+func (h *TreeManagementHandler) ListSpecies(ctx context.Context, req *pb.ListSpeciesRequest) (*pb.SpeciesList, error) {
+	speciesList, page, err := h.treeService.ListSpecies(ctx, req)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to list species")
 	}
 
@@ -123,7 +182,11 @@ func (h *TreeManagementHandler) ListSpecies(ctx context.Context, _ *emptypb.Empt
 		pbSpecies[i] = mapSpeciesToProto(s)
 	}
 
-	return &pb.SpeciesList{Species: pbSpecies}, nil
+	return &pb.SpeciesList{
+		Species:       pbSpecies,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
 }
 
 func (h *TreeManagementHandler) UpdateSpecies(ctx context.Context, req *pb.Species) (*pb.Species, error) {
@@ -136,6 +199,9 @@ func (h *TreeManagementHandler) UpdateSpecies(ctx context.Context, req *pb.Speci
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to update species")
 	}
 	return mapSpeciesToProto(updatedSpecies), nil
@@ -190,6 +256,9 @@ func (h *TreeManagementHandler) CreateLog(ctx context.Context, req *pb.CreateLog
 		if errors.Is(err, models.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to create log")
 	}
 
@@ -236,6 +305,9 @@ func (h *TreeManagementHandler) DeleteLog(ctx context.Context, req *pb.IdRequest
 func (h *TreeManagementHandler) CreatePlot(ctx context.Context, req *pb.Plot) (*pb.Plot, error) {
 	createdPlot, err := h.treeService.CreatePlot(ctx, req)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		if errors.Is(err, models.ErrAlreadyExists) {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
 		}
@@ -256,9 +328,12 @@ func (h *TreeManagementHandler) GetPlot(ctx context.Context, req *pb.IdRequest)
 	return mapPlotToProto(plot), nil
 }
 
-func (h *TreeManagementHandler) ListPlots(ctx context.Context, _ *emptypb.Empty) (*pb.PlotList, error) {
-	plotList, err := h.treeService.ListPlots(ctx)
+func (h *TreeManagementHandler) ListPlots(ctx context.Context, req *pb.ListPlotsRequest) (*pb.PlotList, error) {
+	plotList, page, err := h.treeService.ListPlots(ctx, req)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to list plots")
 	}
 
@@ -268,10 +343,42 @@ func (h *TreeManagementHandler) ListPlots(ctx context.Context, _ *emptypb.Empty)
 	}
 
 	return &pb.PlotList{
-		Plots: pbPlots,
+		Plots:         pbPlots,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
+func (h *TreeManagementHandler) FindPlotsNear(ctx context.Context, req *pb.FindPlotsNearRequest) (*pb.PlotList, error) {
+	plots, err := h.treeService.FindPlotsNear(ctx, req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to find plots")
+	}
+	return mapPlotsToProto(plots), nil
+}
+
+func (h *TreeManagementHandler) FindPlotsWithin(ctx context.Context, req *pb.FindPlotsWithinRequest) (*pb.PlotList, error) {
+	plots, err := h.treeService.FindPlotsWithin(ctx, req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to find plots")
+	}
+	return mapPlotsToProto(plots), nil
+}
+
+func mapPlotsToProto(plots []*models.Plot) *pb.PlotList {
+	res := &pb.PlotList{Plots: make([]*pb.Plot, len(plots))}
+	for i, p := range plots {
+		res.Plots[i] = mapPlotToProto(p)
+	}
+	return res
+}
+
 func (h *TreeManagementHandler) UpdatePlot(ctx context.Context, req *pb.Plot) (*pb.Plot, error) {
 	id, err := primitive.ObjectIDFromHex(req.GetId())
 	if err != nil {
@@ -282,6 +389,12 @@ func (h *TreeManagementHandler) UpdatePlot(ctx context.Context, req *pb.Plot) (*
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, models.ErrAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to update plot")
 	}
 	return mapPlotToProto(updatedPlot), nil
@@ -302,9 +415,12 @@ func (h *TreeManagementHandler) DeletePlot(ctx context.Context, req *pb.IdReques
 }
 
 
-func (h *TreeManagementHandler) ListTrees(ctx context.Context, _ *emptypb.Empty) (*pb.TreeList, error) {
-	treeList, err := h.treeService.ListTrees(ctx)
+func (h *TreeManagementHandler) ListTrees(ctx context.Context, req *pb.ListTreesRequest) (*pb.TreeList, error) {
+	treeList, page, err := h.treeService.ListTrees(ctx, req)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to list trees")
 	}
 
@@ -313,7 +429,11 @@ func (h *TreeManagementHandler) ListTrees(ctx context.Context, _ *emptypb.Empty)
 		pbTrees[i] = mapTreeToProto(t)
 	}
 
-	return &pb.TreeList{Trees: pbTrees}, nil
+	return &pb.TreeList{
+		Trees:         pbTrees,
+		NextPageToken: page.NextPageToken,
+		TotalCount:    page.TotalCount,
+	}, nil
 }
 
 func (h *TreeManagementHandler) AdoptTree(ctx context.Context, req *pb.AdoptTreeRequest) (*pb.AdoptTreeResponse, error) {
@@ -329,6 +449,10 @@ func (h *TreeManagementHandler) AdoptTree(ctx context.Context, req *pb.AdoptTree
 		if errors.Is(err, models.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			// e.g. the species price cannot be converted into the wallet currency
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "failed to adopt tree: %v", err)
 	}
 
@@ -339,6 +463,73 @@ func (h *TreeManagementHandler) AdoptTree(ctx context.Context, req *pb.AdoptTree
 	}, nil
 }
 
+func (h *TreeManagementHandler) ClaimGift(ctx context.Context, req *pb.ClaimGiftRequest) (*pb.Tree, error) {
+	userID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := h.treeService.ClaimGift(ctx, req.GetClaimCode(), userID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "gift not found")
+		}
+		if errors.Is(err, models.ErrAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to claim gift: %v", err)
+	}
+	return mapTreeToProto(tree), nil
+}
+
+func (h *TreeManagementHandler) ListAdoptionIntents(ctx context.Context, req *pb.IdsRequest) (*pb.AdoptionIntentList, error) {
+	intents, err := h.treeService.ListAdoptionIntents(ctx, req.GetIds())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list adoption intents")
+	}
+
+	pbIntents := make([]*pb.AdoptionIntent, len(intents))
+	for i, intent := range intents {
+		pbIntents[i] = mapIntentToProto(intent)
+	}
+
+	return &pb.AdoptionIntentList{Intents: pbIntents}, nil
+}
+
+func (h *TreeManagementHandler) ListMyTrees(ctx context.Context, req *pb.ListMyTreesRequest) (*pb.MyTreeList, error) {
+	sponsorID, err := h.getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	portfolio, err := h.treeService.ListMyTrees(ctx, sponsorID, req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list trees")
+	}
+
+	res := &pb.MyTreeList{
+		Trees:          make([]*pb.MyTree, len(portfolio.Trees)),
+		PendingIntents: make([]*pb.AdoptionIntent, len(portfolio.PendingIntents)),
+		NextPageToken:  portfolio.Page.NextPageToken,
+		TotalCount:     portfolio.Page.TotalCount,
+	}
+	for i, t := range portfolio.Trees {
+		res.Trees[i] = &pb.MyTree{
+			Tree:        mapTreeToProto(t.Tree),
+			SpeciesName: t.SpeciesName,
+			PlotName:    t.PlotName,
+		}
+	}
+	for i, intent := range portfolio.PendingIntents {
+		res.PendingIntents[i] = mapIntentToProto(intent)
+	}
+	return res, nil
+}
+
 func (h *TreeManagementHandler) GetTree(ctx context.Context, req *pb.IdRequest) (*pb.Tree, error) {
 	id, err := primitive.ObjectIDFromHex(req.GetId())
 	if err != nil {
@@ -23,5 +23,6 @@ type Profile struct {
 	FullName    string    `gorm:"not null"`
 	DateOfBirth time.Time
 	Age         int
-	Balance     int64 `gorm:"default:0"`
+	Balance     int64  `gorm:"default:0"` // minor units of Currency
+	Currency    string `gorm:"type:varchar(3);not null;default:'IDR'"`
 }
@@ -11,4 +11,7 @@ var (
 	ErrInternal           = errors.New("internal system error")
 	ErrInvalidInput       = errors.New("invalid input provided")
 	ErrInsufficientBalance = errors.New("insufficient balance")
+	ErrCurrencyMismatch    = errors.New("currency mismatch")
+	ErrVoucherUnavailable  = errors.New("voucher cannot be applied")
+	ErrLimitExceeded       = errors.New("limit exceeded")
 )
\ No newline at end of file
@@ -6,25 +6,326 @@ import (
 	"github.com/google/uuid"
 )
 
+// PaymentMethod is how an invoice is paid. An empty Method leaves the choice
+// to the sponsor on the invoice page; Channel narrows a method down to one
+// bank or e-wallet.
+type PaymentMethod struct {
+	Method  string // VIRTUAL_ACCOUNT, QRIS, EWALLET, CARD
+	Channel string // e.g. BCA, OVO
+}
+
 type Payment struct {
 	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	TransactionID uuid.UUID `gorm:"type:uuid;not null"`
 	Amount        int64     `gorm:"not null"`
-	Status        string    `gorm:"default:'PENDING'"` // PENDING, FINISHED, FAILED, EXPIRED
+	Status        string    `gorm:"default:'PENDING'"` // PENDING, REVIEW, SUCCESS, EXPIRED, CREDITED, REJECTED
 	ExternalID    string
 	PaymentURL    string
 	ExpiresAt     time.Time
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	// Preferred at checkout, replaced by the method the sponsor actually
+	// used once the invoice is paid.
+	PaymentMethod `gorm:"embedded"`
+	// Set when an invoice is paid. FeeAmount is what the payment provider
+	// kept, in minor units of the transaction currency.
+	FeeAmount int64
+	PaidAt    *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type Transaction struct {
 	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	UserID    uuid.UUID `gorm:"type:uuid;not null"`
-	Amount    int64     `gorm:"not null"`
+	Amount    int64     `gorm:"not null"` // minor units of Currency
+	Currency  string    `gorm:"type:varchar(3);not null;default:'IDR'"`
 	ReferenceID string    `gorm:"index"` // Links to AdoptionIntent ID
-	Type      string    `gorm:"not null"` // DEPOSIT, ADOPT, CARE
+	Type      string    `gorm:"not null"` // DEPOSIT, ADOPT, CARE, TRANSFER, ADJUSTMENT
+	// Set when the price was converted into the wallet currency at checkout.
+	OriginalAmount   int64
+	OriginalCurrency string `gorm:"type:varchar(3)"`
+	ExchangeRate     string
+	// Set when a voucher was applied. Amount is what was charged after the
+	// discount; deposits still credit Amount + DiscountAmount to the wallet.
+	VoucherCode    string
+	DiscountAmount int64
+	// Set on transfers and adjustments. Each side of a transfer is its own
+	// transaction and the incoming one references the outgoing one.
+	Direction      string     `gorm:"type:varchar(3)"` // IN, OUT
+	CounterpartyID *uuid.UUID `gorm:"type:uuid"`
 	Payment   Payment   `gorm:"foreignKey:TransactionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// PaymentReminder records that the sponsor of a pending invoice was reminded
+// OffsetMinutes before it expires, so each reminder is only sent once.
+type PaymentReminder struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	TransactionID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_payment_reminder"`
+	OffsetMinutes int       `gorm:"not null;uniqueIndex:idx_payment_reminder"`
+	SentAt        time.Time `gorm:"not null"`
+}
+
+// BalanceHold reserves wallet funds for a payment that is not settled yet.
+// The amount is taken out of the wallet balance when the hold is placed; a
+// CAPTURED hold has been spent and a RELEASED or EXPIRED one was given back.
+// Holds placed towards an invoice carry its TransactionID and follow the
+// invoice. Standalone holds name the Type and ReferenceID of the purchase
+// that capturing them records, and expire at ExpiresAt.
+type BalanceHold struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index"`
+	TransactionID *uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	Amount        int64      `gorm:"not null"`
+	Currency      string     `gorm:"type:varchar(3);not null"`
+	Type          string     // ADOPT, CARE
+	ReferenceID   string
+	Description   string
+	Status        string     `gorm:"not null;default:'HELD'"` // HELD, CAPTURED, RELEASED, EXPIRED
+	ExpiresAt     *time.Time `gorm:"index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// TopUpReview is a top-up the fraud rules flagged. Its invoice is only issued
+// once an admin approves it; until then the payment stays in REVIEW. Rules
+// lists the codes of the rules that fired and Reasons explains them.
+type TopUpReview struct {
+	ID              uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	TransactionID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	UserID          uuid.UUID `gorm:"type:uuid;not null;index"`
+	Amount          int64     `gorm:"not null"`
+	Currency        string    `gorm:"type:varchar(3);not null"`
+	Rules           string    // comma separated, e.g. VELOCITY,DAILY_VOLUME
+	Reasons         string
+	InvoiceDuration int        // seconds, as requested by the user
+	Status          string     `gorm:"not null;default:'PENDING';index"` // PENDING, APPROVED, REJECTED
+	ReviewerID      *uuid.UUID `gorm:"type:uuid"`
+	Note            string
+	ReviewedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// BalanceAdjustment is a manual correction of a wallet by support staff. It
+// is requested by one admin and only posted, as an ADJUSTMENT transaction,
+// once a different admin approves it. Amount is signed: positive credits the
+// wallet, negative debits it.
+type BalanceAdjustment struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Amount          int64      `gorm:"not null"`
+	Currency        string     `gorm:"type:varchar(3);not null"`
+	Reason          string     `gorm:"not null"`
+	TicketReference string     `gorm:"not null"`
+	Status          string     `gorm:"not null;default:'PENDING';index"` // PENDING, APPROVED, REJECTED
+	RequestedBy     uuid.UUID  `gorm:"type:uuid;not null"`
+	DecidedBy       *uuid.UUID `gorm:"type:uuid"`
+	DecisionNote    string
+	DecidedAt       *time.Time
+	TransactionID   *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Voucher is a promo code that discounts a checkout. Value is a percentage
+// for PERCENTAGE vouchers and minor units of Currency for FIXED ones. Empty
+// restrictions and zero limits mean any and unlimited.
+type Voucher struct {
+	ID              uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Code            string    `gorm:"uniqueIndex;not null"`
+	Description     string
+	DiscountType    string `gorm:"not null"` // PERCENTAGE, FIXED
+	Value           int64  `gorm:"not null"`
+	Currency        string `gorm:"type:varchar(3)"`
+	TransactionType string // ADOPT, DEPOSIT
+	SpeciesID       string
+	PlotID          string
+	MaxRedemptions  int
+	MaxPerUser      int
+	RedemptionCount int `gorm:"not null;default:0"`
+	ValidFrom       *time.Time
+	ValidUntil      *time.Time
+	CreatedAt       time.Time
+}
+
+// VoucherRedemption records a voucher applied to a transaction. It is
+// RELEASED when the invoice expires, which frees the use again.
+type VoucherRedemption struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	VoucherID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	TransactionID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	DiscountAmount int64     `gorm:"not null"`
+	Currency       string    `gorm:"type:varchar(3);not null"`
+	Status         string    `gorm:"not null;default:'APPLIED'"` // APPLIED, RELEASED
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// MatchingCampaign is a company's pledge to match the adoptions of its
+// employees, recognised by the domain of their email address. Every tree an
+// employee adopts funds MatchRatio more trees from the campaign budget until
+// SpentAmount reaches BudgetAmount. A zero MaxTreesPerEmployee means no
+// per-employee cap.
+type MatchingCampaign struct {
+	ID                  uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name                string    `gorm:"not null"`
+	Organization        string    `gorm:"not null"`
+	EmailDomains        string    `gorm:"not null"` // comma separated, e.g. acme.com,acme.co.id
+	MatchRatio          int       `gorm:"not null;default:1"`
+	MaxTreesPerEmployee int
+	BudgetAmount        int64  `gorm:"not null"` // minor units of Currency
+	SpentAmount         int64  `gorm:"not null;default:0"`
+	Currency            string `gorm:"type:varchar(3);not null"`
+	Status              string `gorm:"not null;default:'ACTIVE';index"` // ACTIVE, PAUSED, ENDED
+	StartsAt            *time.Time
+	EndsAt              *time.Time
+	CreatedBy           uuid.UUID `gorm:"type:uuid;not null"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// CampaignMatch records the trees a campaign funded for one settled ADOPT
+// transaction. Amount is what they took from the campaign budget.
+type CampaignMatch struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CampaignID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;index"`
+	TransactionID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	ReferenceID   string    // the employee's adoption intent
+	Trees         int       `gorm:"not null"`
+	Amount        int64     `gorm:"not null"`
+	Currency      string    `gorm:"type:varchar(3);not null"`
+	CreatedAt     time.Time
+}
+
+// Partner is a landowner whose land hosts some of our plots. They are owed
+// ShareBps of the settled ADOPT and CARE revenue of each of their plots,
+// unless the plot link sets its own share. Shares are in basis points.
+type Partner struct {
+	ID                uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name              string    `gorm:"not null"`
+	Email             string
+	BankName          string
+	BankAccountNumber string
+	BankAccountName   string
+	ShareBps          int           `gorm:"not null"`
+	Plots             []PartnerPlot `gorm:"foreignKey:PartnerID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// PartnerPlot links a plot of the tree service to the partner owning its
+// land. A plot has at most one partner.
+type PartnerPlot struct {
+	PlotID    string    `gorm:"primaryKey"`
+	PartnerID uuid.UUID `gorm:"type:uuid;not null;index"`
+	ShareBps  int       // overrides Partner.ShareBps when set
+}
+
+// Payout is what a partner is owed for one calendar month of revenue in one
+// currency, broken down per plot in Lines. It is calculated once and then
+// only changes status.
+type Payout struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	PartnerID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_payout_period"`
+	Partner       Partner
+	PeriodStart   time.Time `gorm:"not null;uniqueIndex:idx_payout_period"`
+	PeriodEnd     time.Time `gorm:"not null"`
+	Currency      string    `gorm:"type:varchar(3);not null;uniqueIndex:idx_payout_period"`
+	RevenueAmount int64     `gorm:"not null"`
+	Amount        int64     `gorm:"not null"`
+	Status        string    `gorm:"not null;default:'PENDING';index"` // PENDING, PAID, CANCELLED
+	Reference     string    // bank transfer reference once paid
+	Note          string
+	PaidAt        *time.Time
+	Lines         []PayoutLine `gorm:"foreignKey:PayoutID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// PayoutLine is the share of one plot's revenue in a payout.
+type PayoutLine struct {
+	ID               uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	PayoutID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	PlotID           string    `gorm:"not null"`
+	PlotName         string
+	TransactionCount int64
+	RevenueAmount    int64
+	ShareBps         int
+	Amount           int64
+}
+
+// DonationCertificate summarises a sponsor's ADOPT and CARE spending for a
+// calendar year. Numbers are sequential per year, e.g. RF-2026-000001.
+// Certificates are always per user: OrganizationName and TaxID only name the
+// party the sponsor donated on behalf of, and do not pool other accounts.
+type DonationCertificate struct {
+	ID               uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID           uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_certificate_user_year"`
+	Year             int       `gorm:"not null;uniqueIndex:idx_certificate_user_year;uniqueIndex:idx_certificate_year_sequence"`
+	Sequence         int       `gorm:"not null;uniqueIndex:idx_certificate_year_sequence"`
+	Number           string    `gorm:"uniqueIndex;not null"`
+	RecipientName    string
+	OrganizationName string
+	TaxID            string
+	Currency         string `gorm:"type:varchar(3);not null;default:'IDR'"`
+	AdoptTotal       int64
+	CareTotal        int64
+	TotalAmount      int64
+	TransactionCount int
+	VerificationCode string `gorm:"uniqueIndex;not null"`
+	Signature        string `gorm:"not null"`
+	IssuedAt         time.Time
+}
+
+// ReconciliationReport records one pass of comparing local payments with the
+// payment provider, along with every discrepancy found.
+type ReconciliationReport struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	StartedAt     time.Time
+	FinishedAt    time.Time
+	Checked       int
+	Fixed         int
+	Discrepancies []ReconciliationDiscrepancy `gorm:"foreignKey:ReportID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// ReconciliationDiscrepancy is a payment whose local status did not match the
+// provider. Action is what the job did about it, NONE when it needs a human.
+type ReconciliationDiscrepancy struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ReportID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	TransactionID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID         uuid.UUID `gorm:"type:uuid"`
+	Type           string
+	Amount         int64
+	Currency       string `gorm:"type:varchar(3)"`
+	Kind           string // MISSED_PAYMENT, LATE_PAYMENT, MISSED_EXPIRY, STATUS_DRIFT, AMOUNT_MISMATCH, CURRENCY_MISMATCH, LOOKUP_FAILED
+	LocalStatus    string
+	ProviderStatus string
+	Action         string // SETTLED, CREDITED_TO_WALLET, EXPIRED, INVOICE_EXPIRED, NONE
+	Detail         string
+	CreatedAt      time.Time
+}
+
+// WalletBalance is a wallet's balance in its own currency, optionally
+// converted into a display currency.
+// WalletBalance is the total balance of a wallet, including the funds held
+// for unsettled payments, and the part of it that is available to spend.
+type WalletBalance struct {
+	Balance          int64
+	Available        int64
+	Currency         string
+	DisplayBalance   int64
+	DisplayAvailable int64
+	DisplayCurrency  string
+}
+
+// Document is a rendered file (receipt, statement, ...) ready to be downloaded
+// or attached to an email.
+type Document struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
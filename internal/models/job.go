@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobRun is one run of a scheduled job. A scheduled activation is recorded
+// once however many replicas reach it, see pkg/scheduler.
+type JobRun struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	JobName     string    `gorm:"not null;uniqueIndex:idx_job_runs_activation,priority:1"`
+	Trigger     string    `gorm:"not null"` // SCHEDULE, MANUAL
+	Status      string    `gorm:"not null"` // RUNNING, SUCCEEDED, FAILED
+	ScheduledAt time.Time `gorm:"not null;uniqueIndex:idx_job_runs_activation,priority:2"`
+	StartedAt   time.Time `gorm:"not null"`
+	FinishedAt  *time.Time
+	DurationMs  int64
+	Error       string `gorm:"type:text"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OutboxEvent is a finance event stored in the same Postgres transaction as
+// the change it announces, until the relay publishes it.
+type OutboxEvent struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	RoutingKey  string    `gorm:"not null"`
+	Payload     string    `gorm:"type:text;not null"` // JSON body
+	Attempts    int       `gorm:"not null;default:0"`
+	LastError   string
+	AvailableAt time.Time  `gorm:"not null;index:idx_outbox_events_pending,priority:2"`
+	SentAt      *time.Time `gorm:"index:idx_outbox_events_pending,priority:1"`
+	CreatedAt   time.Time
+}
+
+// OutboxDocument is the tree service's equivalent of OutboxEvent, stored in
+// the outbox collection.
+type OutboxDocument struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	RoutingKey  string             `bson:"routing_key"`
+	Payload     string             `bson:"payload"`
+	Attempts    int                `bson:"attempts"`
+	LastError   string             `bson:"last_error,omitempty"`
+	AvailableAt time.Time          `bson:"available_at"`
+	SentAt      *time.Time         `bson:"sent_at"`
+	CreatedAt   time.Time          `bson:"created_at"`
+}
@@ -10,7 +10,8 @@ type Species struct {
 	ID              primitive.ObjectID `bson:"_id,omitempty"`
 	CommonName      string             `bson:"common_name"`
 	SpaceRequiredM2 float64            `bson:"space_required_m2"`
-	Price           int32              `bson:"price"`
+	Price           int32              `bson:"price"` // minor units of Currency
+	Currency        string             `bson:"currency"`
 }
 
 type Plot struct {
@@ -18,6 +19,31 @@ type Plot struct {
 	LocationName     string             `bson:"location_name"`
 	Address          string             `bson:"address"`
 	AvailableSpaceM2 float64            `bson:"available_space_m2"`
+	// Location pins the plot on a map; Boundary outlines it. Both are
+	// indexed 2dsphere and unset on plots created without coordinates.
+	Location *GeoPoint   `bson:"location,omitempty"`
+	Boundary *GeoPolygon `bson:"boundary,omitempty"`
+}
+
+// GeoPoint is a GeoJSON Point. Coordinates are longitude then latitude.
+type GeoPoint struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+func NewGeoPoint(lng, lat float64) *GeoPoint {
+	return &GeoPoint{Type: "Point", Coordinates: []float64{lng, lat}}
+}
+
+// GeoPolygon is a GeoJSON Polygon. Plots use a single closed ring of
+// [longitude, latitude] positions.
+type GeoPolygon struct {
+	Type        string        `bson:"type"`
+	Coordinates [][][]float64 `bson:"coordinates"`
+}
+
+func NewGeoPolygon(ring [][]float64) *GeoPolygon {
+	return &GeoPolygon{Type: "Polygon", Coordinates: [][][]float64{ring}}
 }
 
 type Tree struct {
@@ -29,6 +55,73 @@ type Tree struct {
 	CurrentHeightMeters float64            `bson:"-"`
 	LastCareDate        time.Time          `bson:"last_care_date"`
 	AdoptedAt           time.Time          `bson:"adopted_at"`
+	// Set on trees a corporate matching campaign funded.
+	MatchID string `bson:"match_id,omitempty"`
+	// Where the tree stands in its plot, once recorded.
+	Location *GeoPoint     `bson:"location,omitempty"`
+	Position *GridPosition `bson:"position,omitempty"`
+}
+
+// GridPosition is the planting row and column of a tree within its plot,
+// both counted from 1.
+type GridPosition struct {
+	Row    int `bson:"row"`
+	Column int `bson:"column"`
+}
+
+// ListOptions selects one page of a list, ordered by SortBy and then ID.
+// PageToken is the NextPageToken of the previous page, empty for the first.
+type ListOptions struct {
+	PageSize   int
+	PageToken  string
+	SortBy     string
+	Descending bool
+}
+
+// PageInfo tells where a page sits in the full list. NextPageToken is empty
+// on the last page.
+type PageInfo struct {
+	NextPageToken string
+	TotalCount    int64
+}
+
+// SpeciesFilter narrows ListSpecies. Zero values do not filter.
+type SpeciesFilter struct {
+	MinPrice           int32
+	MaxPrice           int32
+	Currency           string
+	MaxSpaceRequiredM2 float64
+}
+
+// PlotFilter narrows ListPlots. Zero values do not filter.
+type PlotFilter struct {
+	Location            string // substring of the location name or address
+	MinAvailableSpaceM2 float64
+}
+
+// TreeFilter narrows ListTrees. Zero values do not filter.
+type TreeFilter struct {
+	SponsorID   string
+	SpeciesID   primitive.ObjectID
+	PlotID      primitive.ObjectID
+	AdoptedFrom time.Time
+	AdoptedTo   time.Time // exclusive
+}
+
+// PortfolioTree is one of a sponsor's trees with the names of its species
+// and plot.
+type PortfolioTree struct {
+	Tree        *Tree
+	SpeciesName string
+	PlotName    string
+}
+
+// Portfolio is a page of a sponsor's trees and the adoptions they have yet
+// to pay for.
+type Portfolio struct {
+	Trees          []PortfolioTree
+	PendingIntents []*AdoptionIntent
+	Page           PageInfo
 }
 
 type AdoptionIntent struct {
@@ -41,6 +134,25 @@ type AdoptionIntent struct {
 	CreatedAt  time.Time          `bson:"created_at"`
 }
 
+// Gift is an adoption paid for by one sponsor on behalf of someone else. The
+// tree is planted without a sponsor and transferred to whoever redeems
+// ClaimCode.
+type Gift struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	IntentID       primitive.ObjectID `bson:"intent_id"` // also the tree ID once planted
+	GifterID       string             `bson:"gifter_id"`
+	GifterName     string             `bson:"gifter_name"`
+	GifterEmail    string             `bson:"gifter_email"`
+	RecipientName  string             `bson:"recipient_name"`
+	RecipientEmail string             `bson:"recipient_email"`
+	Message        string             `bson:"message"`
+	ClaimCode      string             `bson:"claim_code"`
+	Status         string             `bson:"status"` // PENDING, SENT, CLAIMED, EXPIRED
+	RecipientID    string             `bson:"recipient_id,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	ClaimedAt      time.Time          `bson:"claimed_at,omitempty"`
+}
+
 type LogEntry struct {
 	ID                  primitive.ObjectID `bson:"_id,omitempty"`
 	AdoptedTreeID       primitive.ObjectID `bson:"adopted_tree_id"`
@@ -49,4 +161,7 @@ type LogEntry struct {
 	Activity            string             `bson:"activity"`
 	Note                string             `bson:"note"`
 	RecordedAt          time.Time          `bson:"recorded_at"`
+	// The tree location and grid position the entry recorded, if any.
+	Location *GeoPoint     `bson:"location,omitempty"`
+	Position *GridPosition `bson:"position,omitempty"`
 }
\ No newline at end of file
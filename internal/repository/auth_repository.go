@@ -5,12 +5,14 @@ import (
 	"errors"
 	"reforest/internal/models"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type AuthRepository interface {
 	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
 }
 
 type authRepository struct {
@@ -36,4 +38,13 @@ func (r *authRepository) GetByEmail(ctx context.Context, email string) (*models.
     var user models.User
     err := r.db.WithContext(ctx).Preload("Profile").Where("email = ?", email).First(&user).Error
     return &user, err
-}
\ No newline at end of file
+}
+
+func (r *authRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Preload("Profile").Where("id = ?", id).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &user, err
+}
@@ -60,7 +60,7 @@ func TestAuthRepository_CreateUser(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(0, 1))
 		// Expect Insert into profiles (association)
 		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "profiles"`)).
-			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 		mock.ExpectCommit()
 
@@ -158,4 +158,44 @@ func TestAuthRepository_GetByEmail(t *testing.T) {
 			t.Errorf("expected ErrRecordNotFound, got %v", err)
 		}
 	})
-}
\ No newline at end of file
+}
+func TestAuthRepository_GetByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		defer func() { sqlDB, _ := db.DB(); sqlDB.Close() }()
+		repo := NewAuthRepository(db)
+
+		userID := uuid.New()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id =`)).
+			WithArgs(userID, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "role_type"}).
+				AddRow(userID, "test@example.com", "hash", "SPONSOR"))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profiles" WHERE "profiles"."id" =`)).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name"}).AddRow(userID, "Test User"))
+
+		user, err := repo.GetByID(context.Background(), userID)
+		if err != nil {
+			t.Fatalf("GetByID() error = %v", err)
+		}
+		if user.Profile.FullName != "Test User" {
+			t.Errorf("expected profile to be loaded, got %q", user.Profile.FullName)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		defer func() { sqlDB, _ := db.DB(); sqlDB.Close() }()
+		repo := NewAuthRepository(db)
+
+		userID := uuid.New()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE id =`)).
+			WithArgs(userID, 1).
+			WillReturnError(gorm.ErrRecordNotFound)
+
+		_, err := repo.GetByID(context.Background(), userID)
+		if !errors.Is(err, models.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
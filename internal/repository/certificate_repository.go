@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reforest/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DonationTotals struct {
+	Currency         string
+	AdoptTotal       int64
+	CareTotal        int64
+	TransactionCount int
+}
+
+type CertificateRepository interface {
+	GetDonationTotals(ctx context.Context, userID uuid.UUID, from, to time.Time) (*DonationTotals, error)
+	GetRecipientName(ctx context.Context, userID uuid.UUID) (string, error)
+	GetCertificateByUserAndYear(ctx context.Context, userID uuid.UUID, year int) (*models.DonationCertificate, error)
+	GetCertificateByVerificationCode(ctx context.Context, code string) (*models.DonationCertificate, error)
+	CreateCertificate(ctx context.Context, cert *models.DonationCertificate, sign func(*models.DonationCertificate)) error
+}
+
+type certificateRepository struct {
+	db *gorm.DB
+}
+
+func NewCertificateRepository(db *gorm.DB) CertificateRepository {
+	return &certificateRepository{db: db}
+}
+
+func (r *certificateRepository) GetDonationTotals(ctx context.Context, userID uuid.UUID, from, to time.Time) (*DonationTotals, error) {
+	var rows []struct {
+		Type     string
+		Currency string
+		Total    int64
+		Count    int
+	}
+	err := r.db.WithContext(ctx).
+		Table("transactions").
+		Select("transactions.type, transactions.currency, SUM(transactions.amount) AS total, COUNT(*) AS count").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("transactions.user_id = ? AND payments.status = ? AND transactions.type IN ? AND transactions.created_at >= ? AND transactions.created_at < ?",
+			userID, "SUCCESS", []string{"ADOPT", "CARE"}, from, to).
+		Group("transactions.type, transactions.currency").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	totals := &DonationTotals{}
+	for _, row := range rows {
+		if totals.Currency != "" && totals.Currency != row.Currency {
+			return nil, fmt.Errorf("%w: donations recorded in both %s and %s", models.ErrCurrencyMismatch, totals.Currency, row.Currency)
+		}
+		totals.Currency = row.Currency
+		switch row.Type {
+		case "ADOPT":
+			totals.AdoptTotal = row.Total
+		case "CARE":
+			totals.CareTotal = row.Total
+		}
+		totals.TransactionCount += row.Count
+	}
+	return totals, nil
+}
+
+func (r *certificateRepository) GetRecipientName(ctx context.Context, userID uuid.UUID) (string, error) {
+	var profile models.Profile
+	err := r.db.WithContext(ctx).Select("full_name").Where("id = ?", userID).First(&profile).Error
+	return profile.FullName, err
+}
+
+func (r *certificateRepository) GetCertificateByUserAndYear(ctx context.Context, userID uuid.UUID, year int) (*models.DonationCertificate, error) {
+	var cert models.DonationCertificate
+	err := r.db.WithContext(ctx).Where("user_id = ? AND year = ?", userID, year).First(&cert).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &cert, err
+}
+
+func (r *certificateRepository) GetCertificateByVerificationCode(ctx context.Context, code string) (*models.DonationCertificate, error) {
+	var cert models.DonationCertificate
+	err := r.db.WithContext(ctx).Where("verification_code = ?", code).First(&cert).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &cert, err
+}
+
+// CreateCertificate assigns the next sequence number for the certificate's
+// year under an advisory lock so concurrent issuance never reuses a number.
+// The lock is keyed on the certificate namespace as well as the year so it
+// cannot collide with other advisory lock users such as the job scheduler.
+// sign is called once the number is known, before the row is inserted.
+func (r *certificateRepository) CreateCertificate(ctx context.Context, cert *models.DonationCertificate, sign func(*models.DonationCertificate)) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('certificate'), ?)", cert.Year).Error; err != nil {
+			return err
+		}
+
+		var last int
+		if err := tx.Model(&models.DonationCertificate{}).
+			Select("COALESCE(MAX(sequence), 0)").
+			Where("year = ?", cert.Year).
+			Scan(&last).Error; err != nil {
+			return err
+		}
+
+		cert.Sequence = last + 1
+		cert.Number = fmt.Sprintf("RF-%d-%06d", cert.Year, cert.Sequence)
+		sign(cert)
+
+		if err := tx.Create(cert).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return models.ErrAlreadyExists
+			}
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDonationTotals(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewCertificateRepository(db)
+	userID := uuid.New()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	rows := sqlmock.NewRows([]string{"type", "currency", "total", "count"}).
+		AddRow("ADOPT", "IDR", int64(300000), 3).
+		AddRow("CARE", "IDR", int64(50000), 2)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT transactions.type, transactions.currency, SUM(transactions.amount) AS total, COUNT(*) AS count FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE transactions.user_id = $1 AND payments.status = $2 AND transactions.type IN ($3,$4) AND transactions.created_at >= $5 AND transactions.created_at < $6 GROUP BY transactions.type, transactions.currency`)).
+		WithArgs(userID, "SUCCESS", "ADOPT", "CARE", from, to).
+		WillReturnRows(rows)
+
+	totals, err := repo.GetDonationTotals(context.Background(), userID, from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300000), totals.AdoptTotal)
+	assert.Equal(t, int64(50000), totals.CareTotal)
+	assert.Equal(t, 5, totals.TransactionCount)
+	assert.Equal(t, "IDR", totals.Currency)
+}
+
+func TestGetCertificateByVerificationCode_NotFound(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewCertificateRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "donation_certificates" WHERE verification_code = $1`)).
+		WithArgs("AAAA-BBBB-CCCC-DDDD", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.GetCertificateByVerificationCode(context.Background(), "AAAA-BBBB-CCCC-DDDD")
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestCreateCertificate_ConcurrentIssue(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewCertificateRepository(db)
+	cert := &models.DonationCertificate{ID: uuid.New(), UserID: uuid.New(), Year: 2025}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock(hashtext('certificate'), $1)`)).
+		WithArgs(2025).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(MAX(sequence), 0) FROM "donation_certificates" WHERE year = $1`)).
+		WithArgs(2025).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(41))
+	// another request issued the user's certificate for the year first
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "donation_certificates"`)).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_certificate_user_year"})
+	mock.ExpectRollback()
+
+	err := repo.CreateCertificate(context.Background(), cert, func(c *models.DonationCertificate) {})
+	assert.ErrorIs(t, err, models.ErrAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCertificate_AssignsNextSequence(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewCertificateRepository(db)
+	cert := &models.DonationCertificate{ID: uuid.New(), UserID: uuid.New(), Year: 2025}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock(hashtext('certificate'), $1)`)).
+		WithArgs(2025).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(MAX(sequence), 0) FROM "donation_certificates" WHERE year = $1`)).
+		WithArgs(2025).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(41))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "donation_certificates"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(cert.ID))
+	mock.ExpectCommit()
+
+	signed := false
+	err := repo.CreateCertificate(context.Background(), cert, func(c *models.DonationCertificate) {
+		signed = c.Number != ""
+		c.Signature = "sig"
+		c.VerificationCode = "code"
+	})
+	assert.NoError(t, err)
+	assert.True(t, signed, "sign should be called after the number is assigned")
+	assert.Equal(t, 42, cert.Sequence)
+	assert.Equal(t, "RF-2025-000042", cert.Number)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"reforest/internal/models"
 	"time"
 
@@ -10,16 +13,116 @@ import (
 )
 
 type FinanceRepository interface {
-	GetUserBalance(ctx context.Context, userID uuid.UUID) (int64, error)
-	UpdateWalletBalance(ctx context.Context, userID uuid.UUID, amount int64) error
+	// WithTransaction runs fn against a repository bound to one database
+	// transaction, committing only if fn succeeds.
+	WithTransaction(ctx context.Context, fn func(repo FinanceRepository) error) error
+	// EnqueueEvent stores an event in the outbox for the relay to publish.
+	EnqueueEvent(ctx context.Context, routingKey string, payload interface{}) error
+
+	GetUserBalance(ctx context.Context, userID uuid.UUID) (int64, string, error)
+	UpdateWalletBalance(ctx context.Context, userID uuid.UUID, amount int64, currency string) error
 	GetUserEmailAndBalance(ctx context.Context, userID uuid.UUID) (string, int64, error)
+	GetUserIDByEmail(ctx context.Context, email string) (uuid.UUID, error)
+	// DebitWallet takes amount from the wallet, failing with
+	// ErrInsufficientBalance rather than letting the balance go negative.
+	DebitWallet(ctx context.Context, userID uuid.UUID, amount int64, currency string) error
 
 	CreateTransaction(ctx context.Context, tx *models.Transaction) error
 	GetTransactionByID(ctx context.Context, id uuid.UUID) (*models.Transaction, error)
 	GetTransactionsByUserID(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error)
 	UpdateTransactionStatus(ctx context.Context, id uuid.UUID, status string) error
-	UpdateTransactionInvoiceDetails(ctx context.Context, id uuid.UUID, paymentURL string, expiresAt time.Time) error
+	// TransitionPaymentStatus moves the payment of transaction id to status
+	// only if it is still in one of from, reporting whether it did. Of
+	// several callers racing to settle the same payment, exactly one wins.
+	TransitionPaymentStatus(ctx context.Context, id uuid.UUID, status string, from ...string) (bool, error)
+	UpdateTransactionInvoiceDetails(ctx context.Context, id uuid.UUID, invoiceID, paymentURL string, expiresAt time.Time) error
+	UpdatePaymentSettlement(ctx context.Context, id uuid.UUID, method models.PaymentMethod, fee int64, paidAt time.Time) error
 	GetPendingTransactionsBefore(ctx context.Context, expiryTime time.Time) ([]models.Transaction, error)
+	// GetPendingInvoicesExpiringBetween returns the issued, still PENDING
+	// invoices of a transaction type that expire after from and no later
+	// than to.
+	GetPendingInvoicesExpiringBetween(ctx context.Context, txType string, from, to time.Time) ([]models.Transaction, error)
+	ListPaymentReminders(ctx context.Context, txIDs []uuid.UUID) ([]models.PaymentReminder, error)
+	// CreatePaymentReminder records a reminder, failing with
+	// ErrAlreadyExists if it was sent before.
+	CreatePaymentReminder(ctx context.Context, reminder *models.PaymentReminder) error
+	GetSettledTransactionsByUserIDBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.Transaction, error)
+	SumTransfersSentSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
+	// CountDepositsSince counts the top-ups the user started since the given
+	// time, whatever became of them.
+	CountDepositsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
+	// CountDepositsOfAmountSince is CountDepositsSince limited to top-ups of
+	// exactly amount.
+	CountDepositsOfAmountSince(ctx context.Context, userID uuid.UUID, amount int64, since time.Time) (int64, error)
+	// SumDepositsSince totals the top-ups started since the given time that
+	// have not expired or been rejected.
+	SumDepositsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error)
+
+	GetReconciliationCandidates(ctx context.Context, expiredSince time.Time) ([]models.Transaction, error)
+	CreateReconciliationReport(ctx context.Context, report *models.ReconciliationReport) error
+	ListReconciliationReports(ctx context.Context, limit int) ([]models.ReconciliationReport, error)
+
+	CreateVoucher(ctx context.Context, voucher *models.Voucher) error
+	GetVoucherByCode(ctx context.Context, code string) (*models.Voucher, error)
+	ListVouchers(ctx context.Context) ([]models.Voucher, error)
+	// RedeemVoucher records a redemption, failing with ErrVoucherUnavailable
+	// when the voucher or the user's share of it is used up.
+	RedeemVoucher(ctx context.Context, redemption *models.VoucherRedemption, maxPerUser int) error
+	// ReleaseVoucherRedemption gives back the use taken by a transaction that
+	// was never paid. It is a no-op when nothing was redeemed.
+	ReleaseVoucherRedemption(ctx context.Context, txID uuid.UUID) error
+
+	CreateBalanceHold(ctx context.Context, hold *models.BalanceHold) error
+	GetBalanceHold(ctx context.Context, id uuid.UUID) (*models.BalanceHold, error)
+	GetBalanceHoldByTransactionID(ctx context.Context, txID uuid.UUID) (*models.BalanceHold, error)
+	ListBalanceHoldsByUserID(ctx context.Context, userID uuid.UUID) ([]models.BalanceHold, error)
+	// ListExpiredBalanceHolds returns the HELD holds whose ExpiresAt is
+	// before the given time.
+	ListExpiredBalanceHolds(ctx context.Context, before time.Time) ([]models.BalanceHold, error)
+	// SumHeldBalance totals the funds of the user's wallet that are HELD.
+	SumHeldBalance(ctx context.Context, userID uuid.UUID) (int64, error)
+	// SettleBalanceHold moves a HELD hold to status. It returns ErrNotFound
+	// when the hold has already been settled.
+	SettleBalanceHold(ctx context.Context, id uuid.UUID, status string) error
+	// CaptureBalanceHold marks a HELD hold CAPTURED by the transaction that
+	// spent it, returning ErrNotFound when the hold has already been settled.
+	CaptureBalanceHold(ctx context.Context, id, txID uuid.UUID) error
+
+	CreateTopUpReview(ctx context.Context, review *models.TopUpReview) error
+	GetTopUpReview(ctx context.Context, id uuid.UUID) (*models.TopUpReview, error)
+	// ListTopUpReviews returns reviews with the given status, oldest first.
+	// An empty status lists all of them.
+	ListTopUpReviews(ctx context.Context, status string) ([]models.TopUpReview, error)
+	// DecideTopUpReview records an admin's decision on a PENDING review. It
+	// returns ErrNotFound when the review has already been decided.
+	DecideTopUpReview(ctx context.Context, id uuid.UUID, status string, reviewerID uuid.UUID, note string) error
+
+	CreateBalanceAdjustment(ctx context.Context, adjustment *models.BalanceAdjustment) error
+	GetBalanceAdjustment(ctx context.Context, id uuid.UUID) (*models.BalanceAdjustment, error)
+	// ListBalanceAdjustments returns adjustments with the given status,
+	// newest first. An empty status lists all of them.
+	ListBalanceAdjustments(ctx context.Context, status string) ([]models.BalanceAdjustment, error)
+	// DecideBalanceAdjustment records the second admin's decision on a
+	// PENDING adjustment, along with the transaction an approval posted. It
+	// returns ErrNotFound when the adjustment has already been decided.
+	DecideBalanceAdjustment(ctx context.Context, id uuid.UUID, status string, deciderID uuid.UUID, note string, txID *uuid.UUID) error
+
+	CreateMatchingCampaign(ctx context.Context, campaign *models.MatchingCampaign) error
+	GetMatchingCampaign(ctx context.Context, id uuid.UUID) (*models.MatchingCampaign, error)
+	ListMatchingCampaigns(ctx context.Context) ([]models.MatchingCampaign, error)
+	// FindMatchingCampaigns returns the ACTIVE campaigns that cover an email
+	// domain and are running at the given time, oldest first.
+	FindMatchingCampaigns(ctx context.Context, domain string, at time.Time) ([]models.MatchingCampaign, error)
+	// UpdateMatchingCampaignStatus pauses, resumes or ends a campaign. It
+	// returns ErrNotFound when the campaign has already ended.
+	UpdateMatchingCampaignStatus(ctx context.Context, id uuid.UUID, status string) error
+	// SpendMatchingBudget takes amount from an ACTIVE campaign's budget,
+	// failing with ErrLimitExceeded when what is left does not cover it.
+	SpendMatchingBudget(ctx context.Context, id uuid.UUID, amount int64) error
+	// SumMatchedTrees totals the trees a campaign has funded for a user.
+	SumMatchedTrees(ctx context.Context, campaignID, userID uuid.UUID) (int64, error)
+	CreateCampaignMatch(ctx context.Context, match *models.CampaignMatch) error
+	ListCampaignMatches(ctx context.Context, campaignID uuid.UUID) ([]models.CampaignMatch, error)
 }
 
 type financeRepository struct {
@@ -30,10 +133,30 @@ func NewFinanceRepository(db *gorm.DB) FinanceRepository {
 	return &financeRepository{db: db}
 }
 
-func (r *financeRepository) GetUserBalance(ctx context.Context, userID uuid.UUID) (int64, error) {
+func (r *financeRepository) WithTransaction(ctx context.Context, fn func(repo FinanceRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&financeRepository{db: tx})
+	})
+}
+
+func (r *financeRepository) EnqueueEvent(ctx context.Context, routingKey string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Create(&models.OutboxEvent{
+		RoutingKey:  routingKey,
+		Payload:     string(body),
+		AvailableAt: time.Now(),
+	}).Error
+}
+
+// GetUserBalance returns the wallet balance in minor units together with the
+// wallet currency.
+func (r *financeRepository) GetUserBalance(ctx context.Context, userID uuid.UUID) (int64, string, error) {
 	var profile models.Profile
-	err := r.db.WithContext(ctx).Select("balance").Where("id = ?", userID).First(&profile).Error
-	return profile.Balance, err
+	err := r.db.WithContext(ctx).Select("balance", "currency").Where("id = ?", userID).First(&profile).Error
+	return profile.Balance, profile.Currency, err
 }
 
 func (r *financeRepository) GetUserEmailAndBalance(ctx context.Context, userID uuid.UUID) (string, int64, error) {
@@ -50,10 +173,41 @@ func (r *financeRepository) GetUserEmailAndBalance(ctx context.Context, userID u
 	return res.Email, res.Balance, err
 }
 
-func (r *financeRepository) UpdateWalletBalance(ctx context.Context, userID uuid.UUID, amount int64) error {
-	return r.db.WithContext(ctx).Model(&models.Profile{}).
-		Where("id = ?", userID).
-		Update("balance", gorm.Expr("balance + ?", amount)).Error
+func (r *financeRepository) GetUserIDByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Select("id").Where("LOWER(email) = LOWER(?)", email).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return uuid.Nil, models.ErrNotFound
+	}
+	return user.ID, err
+}
+
+func (r *financeRepository) DebitWallet(ctx context.Context, userID uuid.UUID, amount int64, currency string) error {
+	res := r.db.WithContext(ctx).Model(&models.Profile{}).
+		Where("id = ? AND currency = ? AND balance >= ?", userID, currency, amount).
+		Update("balance", gorm.Expr("balance - ?", amount))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return models.ErrInsufficientBalance
+	}
+	return nil
+}
+
+// UpdateWalletBalance adds amount to the wallet only when it is held in the
+// given currency, so a mismatched credit or debit can never be applied.
+func (r *financeRepository) UpdateWalletBalance(ctx context.Context, userID uuid.UUID, amount int64, currency string) error {
+	res := r.db.WithContext(ctx).Model(&models.Profile{}).
+		Where("id = ? AND currency = ?", userID, currency).
+		Update("balance", gorm.Expr("balance + ?", amount))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: wallet is not held in %s", models.ErrCurrencyMismatch, currency)
+	}
+	return nil
 }
 
 func (r *financeRepository) CreateTransaction(ctx context.Context, tx *models.Transaction) error {
@@ -63,6 +217,9 @@ func (r *financeRepository) CreateTransaction(ctx context.Context, tx *models.Tr
 func (r *financeRepository) GetTransactionByID(ctx context.Context, id uuid.UUID) (*models.Transaction, error) {
 	var tx models.Transaction
 	err := r.db.WithContext(ctx).Preload("Payment").First(&tx, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
 	return &tx, err
 }
 
@@ -78,20 +235,455 @@ func (r *financeRepository) UpdateTransactionStatus(ctx context.Context, id uuid
 		Update("status", status).Error
 }
 
-func (r *financeRepository) UpdateTransactionInvoiceDetails(ctx context.Context, id uuid.UUID, paymentURL string, expiresAt time.Time) error {
+func (r *financeRepository) TransitionPaymentStatus(ctx context.Context, id uuid.UUID, status string, from ...string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&models.Payment{}).
+		Where("transaction_id = ? AND status IN ?", id, from).
+		Update("status", status)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *financeRepository) UpdateTransactionInvoiceDetails(ctx context.Context, id uuid.UUID, invoiceID, paymentURL string, expiresAt time.Time) error {
 	return r.db.WithContext(ctx).Model(&models.Payment{}).
 		Where("transaction_id = ?", id).
 		Updates(map[string]interface{}{
+			"external_id": invoiceID,
 			"payment_url": paymentURL,
 			"expires_at":  expiresAt,
 		}).Error
 }
 
+// UpdatePaymentSettlement records when and how the invoice of transaction id
+// was paid and the fee the provider kept.
+func (r *financeRepository) UpdatePaymentSettlement(ctx context.Context, id uuid.UUID, method models.PaymentMethod, fee int64, paidAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.Payment{}).
+		Where("transaction_id = ?", id).
+		Updates(map[string]interface{}{
+			"method":     method.Method,
+			"channel":    method.Channel,
+			"fee_amount": fee,
+			"paid_at":    paidAt,
+		}).Error
+}
+
 func (r *financeRepository) GetPendingTransactionsBefore(ctx context.Context, expiryTime time.Time) ([]models.Transaction, error) {
 	var txs []models.Transaction
 	err := r.db.WithContext(ctx).
+		Preload("Payment").
 		Joins("JOIN payments ON payments.transaction_id = transactions.id").
 		Where("payments.status = ? AND payments.expires_at < ?", "PENDING", expiryTime).
 		Find(&txs).Error
 	return txs, err
 }
+
+func (r *financeRepository) GetPendingInvoicesExpiringBetween(ctx context.Context, txType string, from, to time.Time) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	err := r.db.WithContext(ctx).
+		Preload("Payment").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("transactions.type = ? AND payments.status = ? AND payments.payment_url <> ?", txType, "PENDING", "").
+		Where("payments.expires_at > ? AND payments.expires_at <= ?", from, to).
+		Order("payments.expires_at asc").
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *financeRepository) ListPaymentReminders(ctx context.Context, txIDs []uuid.UUID) ([]models.PaymentReminder, error) {
+	var reminders []models.PaymentReminder
+	if len(txIDs) == 0 {
+		return reminders, nil
+	}
+	err := r.db.WithContext(ctx).Where("transaction_id IN ?", txIDs).Find(&reminders).Error
+	return reminders, err
+}
+
+func (r *financeRepository) CreatePaymentReminder(ctx context.Context, reminder *models.PaymentReminder) error {
+	err := r.db.WithContext(ctx).Create(reminder).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return models.ErrAlreadyExists
+	}
+	return err
+}
+
+func (r *financeRepository) GetSettledTransactionsByUserIDBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	err := r.db.WithContext(ctx).
+		Preload("Payment").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("transactions.user_id = ? AND payments.status = ? AND transactions.created_at >= ? AND transactions.created_at < ?", userID, "SUCCESS", from, to).
+		Order("transactions.created_at asc").
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *financeRepository) SumTransfersSentSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	var total int64
+	err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("user_id = ? AND type = ? AND direction = ? AND created_at >= ?", userID, "TRANSFER", "OUT", since).
+		Scan(&total).Error
+	return total, err
+}
+
+func (r *financeRepository) CountDepositsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("user_id = ? AND type = ? AND created_at >= ?", userID, "DEPOSIT", since).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *financeRepository) CountDepositsOfAmountSince(ctx context.Context, userID uuid.UUID, amount int64, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("user_id = ? AND type = ? AND amount = ? AND created_at >= ?", userID, "DEPOSIT", amount, since).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *financeRepository) SumDepositsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	var total int64
+	err := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Select("COALESCE(SUM(transactions.amount), 0)").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("transactions.user_id = ? AND transactions.type = ? AND transactions.created_at >= ? AND payments.status NOT IN ?", userID, "DEPOSIT", since, []string{"EXPIRED", "REJECTED"}).
+		Scan(&total).Error
+	return total, err
+}
+
+// GetReconciliationCandidates returns invoice-backed transactions that are
+// still pending, or that expired at or after expiredSince.
+func (r *financeRepository) GetReconciliationCandidates(ctx context.Context, expiredSince time.Time) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	err := r.db.WithContext(ctx).
+		Preload("Payment").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("payments.payment_url <> '' AND (payments.status = ? OR (payments.status = ? AND payments.expires_at >= ?))", "PENDING", "EXPIRED", expiredSince).
+		Order("transactions.created_at asc").
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *financeRepository) CreateReconciliationReport(ctx context.Context, report *models.ReconciliationReport) error {
+	return r.db.WithContext(ctx).Create(report).Error
+}
+
+func (r *financeRepository) ListReconciliationReports(ctx context.Context, limit int) ([]models.ReconciliationReport, error) {
+	var reports []models.ReconciliationReport
+	err := r.db.WithContext(ctx).
+		Preload("Discrepancies").
+		Order("started_at desc").
+		Limit(limit).
+		Find(&reports).Error
+	return reports, err
+}
+
+func (r *financeRepository) CreateVoucher(ctx context.Context, voucher *models.Voucher) error {
+	err := r.db.WithContext(ctx).Create(voucher).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return models.ErrAlreadyExists
+	}
+	return err
+}
+
+func (r *financeRepository) GetVoucherByCode(ctx context.Context, code string) (*models.Voucher, error) {
+	var voucher models.Voucher
+	err := r.db.WithContext(ctx).First(&voucher, "code = ?", code).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &voucher, err
+}
+
+func (r *financeRepository) ListVouchers(ctx context.Context) ([]models.Voucher, error) {
+	var vouchers []models.Voucher
+	err := r.db.WithContext(ctx).Order("created_at desc").Find(&vouchers).Error
+	return vouchers, err
+}
+
+// RedeemVoucher takes one use of the voucher first, so the row lock it holds
+// serialises concurrent redemptions while the per-user count is checked.
+func (r *financeRepository) RedeemVoucher(ctx context.Context, redemption *models.VoucherRedemption, maxPerUser int) error {
+	db := r.db.WithContext(ctx)
+	res := db.Model(&models.Voucher{}).
+		Where("id = ? AND (max_redemptions = 0 OR redemption_count < max_redemptions)", redemption.VoucherID).
+		UpdateColumn("redemption_count", gorm.Expr("redemption_count + 1"))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: voucher has been fully redeemed", models.ErrVoucherUnavailable)
+	}
+
+	if maxPerUser > 0 {
+		var used int64
+		err := db.Model(&models.VoucherRedemption{}).
+			Where("voucher_id = ? AND user_id = ? AND status = ?", redemption.VoucherID, redemption.UserID, "APPLIED").
+			Count(&used).Error
+		if err != nil {
+			return err
+		}
+		if used >= int64(maxPerUser) {
+			return fmt.Errorf("%w: voucher already used the maximum number of times", models.ErrVoucherUnavailable)
+		}
+	}
+
+	return db.Create(redemption).Error
+}
+
+func (r *financeRepository) ReleaseVoucherRedemption(ctx context.Context, txID uuid.UUID) error {
+	db := r.db.WithContext(ctx)
+	var redemption models.VoucherRedemption
+	err := db.Where("transaction_id = ? AND status = ?", txID, "APPLIED").First(&redemption).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	res := db.Model(&models.VoucherRedemption{}).
+		Where("id = ? AND status = ?", redemption.ID, "APPLIED").
+		Update("status", "RELEASED")
+	if res.Error != nil || res.RowsAffected == 0 {
+		return res.Error
+	}
+	return db.Model(&models.Voucher{}).
+		Where("id = ?", redemption.VoucherID).
+		UpdateColumn("redemption_count", gorm.Expr("redemption_count - 1")).Error
+}
+
+func (r *financeRepository) CreateBalanceHold(ctx context.Context, hold *models.BalanceHold) error {
+	return r.db.WithContext(ctx).Create(hold).Error
+}
+
+func (r *financeRepository) GetBalanceHold(ctx context.Context, id uuid.UUID) (*models.BalanceHold, error) {
+	var hold models.BalanceHold
+	err := r.db.WithContext(ctx).First(&hold, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &hold, err
+}
+
+func (r *financeRepository) GetBalanceHoldByTransactionID(ctx context.Context, txID uuid.UUID) (*models.BalanceHold, error) {
+	var hold models.BalanceHold
+	err := r.db.WithContext(ctx).First(&hold, "transaction_id = ?", txID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &hold, err
+}
+
+func (r *financeRepository) SettleBalanceHold(ctx context.Context, id uuid.UUID, status string) error {
+	res := r.db.WithContext(ctx).Model(&models.BalanceHold{}).
+		Where("id = ? AND status = ?", id, "HELD").
+		Update("status", status)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: hold %s is no longer held", models.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r *financeRepository) ListBalanceHoldsByUserID(ctx context.Context, userID uuid.UUID) ([]models.BalanceHold, error) {
+	var holds []models.BalanceHold
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at desc").Find(&holds).Error
+	return holds, err
+}
+
+func (r *financeRepository) ListExpiredBalanceHolds(ctx context.Context, before time.Time) ([]models.BalanceHold, error) {
+	var holds []models.BalanceHold
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at < ?", "HELD", before).
+		Order("expires_at asc").
+		Find(&holds).Error
+	return holds, err
+}
+
+func (r *financeRepository) SumHeldBalance(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var total int64
+	err := r.db.WithContext(ctx).Model(&models.BalanceHold{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("user_id = ? AND status = ?", userID, "HELD").
+		Scan(&total).Error
+	return total, err
+}
+
+func (r *financeRepository) CaptureBalanceHold(ctx context.Context, id, txID uuid.UUID) error {
+	res := r.db.WithContext(ctx).Model(&models.BalanceHold{}).
+		Where("id = ? AND status = ?", id, "HELD").
+		Updates(map[string]interface{}{
+			"status":         "CAPTURED",
+			"transaction_id": txID,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: hold %s is no longer held", models.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r *financeRepository) CreateTopUpReview(ctx context.Context, review *models.TopUpReview) error {
+	return r.db.WithContext(ctx).Create(review).Error
+}
+
+func (r *financeRepository) GetTopUpReview(ctx context.Context, id uuid.UUID) (*models.TopUpReview, error) {
+	var review models.TopUpReview
+	err := r.db.WithContext(ctx).First(&review, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &review, err
+}
+
+func (r *financeRepository) ListTopUpReviews(ctx context.Context, status string) ([]models.TopUpReview, error) {
+	var reviews []models.TopUpReview
+	query := r.db.WithContext(ctx).Order("created_at asc")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Find(&reviews).Error
+	return reviews, err
+}
+
+func (r *financeRepository) DecideTopUpReview(ctx context.Context, id uuid.UUID, status string, reviewerID uuid.UUID, note string) error {
+	res := r.db.WithContext(ctx).Model(&models.TopUpReview{}).
+		Where("id = ? AND status = ?", id, "PENDING").
+		Updates(map[string]interface{}{
+			"status":      status,
+			"reviewer_id": reviewerID,
+			"note":        note,
+			"reviewed_at": time.Now(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: review %s has already been decided", models.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r *financeRepository) CreateBalanceAdjustment(ctx context.Context, adjustment *models.BalanceAdjustment) error {
+	return r.db.WithContext(ctx).Create(adjustment).Error
+}
+
+func (r *financeRepository) GetBalanceAdjustment(ctx context.Context, id uuid.UUID) (*models.BalanceAdjustment, error) {
+	var adjustment models.BalanceAdjustment
+	err := r.db.WithContext(ctx).First(&adjustment, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &adjustment, err
+}
+
+func (r *financeRepository) ListBalanceAdjustments(ctx context.Context, status string) ([]models.BalanceAdjustment, error) {
+	var adjustments []models.BalanceAdjustment
+	query := r.db.WithContext(ctx).Order("created_at desc")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Find(&adjustments).Error
+	return adjustments, err
+}
+
+func (r *financeRepository) DecideBalanceAdjustment(ctx context.Context, id uuid.UUID, status string, deciderID uuid.UUID, note string, txID *uuid.UUID) error {
+	res := r.db.WithContext(ctx).Model(&models.BalanceAdjustment{}).
+		Where("id = ? AND status = ?", id, "PENDING").
+		Updates(map[string]interface{}{
+			"status":         status,
+			"decided_by":     deciderID,
+			"decision_note":  note,
+			"decided_at":     time.Now(),
+			"transaction_id": txID,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: adjustment %s has already been decided", models.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r *financeRepository) CreateMatchingCampaign(ctx context.Context, campaign *models.MatchingCampaign) error {
+	return r.db.WithContext(ctx).Create(campaign).Error
+}
+
+func (r *financeRepository) GetMatchingCampaign(ctx context.Context, id uuid.UUID) (*models.MatchingCampaign, error) {
+	var campaign models.MatchingCampaign
+	err := r.db.WithContext(ctx).First(&campaign, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &campaign, err
+}
+
+func (r *financeRepository) ListMatchingCampaigns(ctx context.Context) ([]models.MatchingCampaign, error) {
+	var campaigns []models.MatchingCampaign
+	err := r.db.WithContext(ctx).Order("created_at desc").Find(&campaigns).Error
+	return campaigns, err
+}
+
+func (r *financeRepository) FindMatchingCampaigns(ctx context.Context, domain string, at time.Time) ([]models.MatchingCampaign, error) {
+	var campaigns []models.MatchingCampaign
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND ',' || email_domains || ',' LIKE ?", "ACTIVE", "%,"+domain+",%").
+		Where("(starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)", at, at).
+		Order("created_at asc").
+		Find(&campaigns).Error
+	return campaigns, err
+}
+
+func (r *financeRepository) UpdateMatchingCampaignStatus(ctx context.Context, id uuid.UUID, status string) error {
+	res := r.db.WithContext(ctx).Model(&models.MatchingCampaign{}).
+		Where("id = ? AND status <> ?", id, "ENDED").
+		Update("status", status)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: campaign %s has ended", models.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r *financeRepository) SpendMatchingBudget(ctx context.Context, id uuid.UUID, amount int64) error {
+	res := r.db.WithContext(ctx).Model(&models.MatchingCampaign{}).
+		Where("id = ? AND status = ? AND spent_amount + ? <= budget_amount", id, "ACTIVE", amount).
+		UpdateColumn("spent_amount", gorm.Expr("spent_amount + ?", amount))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: campaign %s has no budget left for %d", models.ErrLimitExceeded, id, amount)
+	}
+	return nil
+}
+
+func (r *financeRepository) SumMatchedTrees(ctx context.Context, campaignID, userID uuid.UUID) (int64, error) {
+	var total int64
+	err := r.db.WithContext(ctx).Model(&models.CampaignMatch{}).
+		Select("COALESCE(SUM(trees), 0)").
+		Where("campaign_id = ? AND user_id = ?", campaignID, userID).
+		Scan(&total).Error
+	return total, err
+}
+
+func (r *financeRepository) CreateCampaignMatch(ctx context.Context, match *models.CampaignMatch) error {
+	err := r.db.WithContext(ctx).Create(match).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return models.ErrAlreadyExists
+	}
+	return err
+}
+
+func (r *financeRepository) ListCampaignMatches(ctx context.Context, campaignID uuid.UUID) ([]models.CampaignMatch, error) {
+	var matches []models.CampaignMatch
+	err := r.db.WithContext(ctx).Where("campaign_id = ?", campaignID).Order("created_at desc").Find(&matches).Error
+	return matches, err
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"reforest/internal/models"
+	"reforest/pkg/database"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -21,7 +22,7 @@ func setupMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
 
 	gormDB, err := gorm.Open(postgres.New(postgres.Config{
 		Conn: dbSQL,
-	}), &gorm.Config{})
+	}), database.GormConfig())
 	assert.NoError(t, err)
 
 	return gormDB, mock
@@ -33,17 +34,18 @@ func TestGetUserBalance(t *testing.T) {
 	userID := uuid.New()
 
 	t.Run("success get balance", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "balance"}).
-			AddRow(userID, int64(50000))
+		rows := sqlmock.NewRows([]string{"balance", "currency"}).
+			AddRow(int64(50000), "IDR")
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "balance" FROM "profiles" WHERE id = $1 ORDER BY "profiles"."id" LIMIT $2`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT "balance","currency" FROM "profiles" WHERE id = $1 ORDER BY "profiles"."id" LIMIT $2`)).
 			WithArgs(userID, 1).
 			WillReturnRows(rows)
 
-		balance, err := repo.GetUserBalance(context.Background(), userID)
+		balance, currency, err := repo.GetUserBalance(context.Background(), userID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(50000), balance)
+		assert.Equal(t, "IDR", currency)
 	})
 }
 
@@ -55,14 +57,25 @@ func TestUpdateWalletBalance(t *testing.T) {
 
 	t.Run("success update balance with expression", func(t *testing.T) {
 		mock.ExpectBegin()
-		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profiles" SET "balance"=balance + $1 WHERE id = $2`)).
-			WithArgs(amount, userID).
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profiles" SET "balance"=balance + $1 WHERE id = $2 AND currency = $3`)).
+			WithArgs(amount, userID, "IDR").
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
-		err := repo.UpdateWalletBalance(context.Background(), userID, amount)
+		err := repo.UpdateWalletBalance(context.Background(), userID, amount, "IDR")
 		assert.NoError(t, err)
 	})
+
+	t.Run("rejects wallet held in another currency", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profiles" SET "balance"=balance + $1 WHERE id = $2 AND currency = $3`)).
+			WithArgs(amount, userID, "USD").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.UpdateWalletBalance(context.Background(), userID, amount, "USD")
+		assert.ErrorIs(t, err, models.ErrCurrencyMismatch)
+	})
 }
 
 func TestCreateTransaction(t *testing.T) {
@@ -73,14 +86,15 @@ func TestCreateTransaction(t *testing.T) {
 		ID:          uuid.New(),
 		UserID:      uuid.New(),
 		Amount:      25000,
+		Currency:    "IDR",
 		ReferenceID: "",
 		Type:        "",
 	}
 
 	t.Run("success create tx", func(t *testing.T) {
 		mock.ExpectBegin()
-		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "transactions" ("user_id","amount","reference_id","type","created_at","updated_at","id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
-			WithArgs(tx.UserID, tx.Amount, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), tx.ID).
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "transactions" ("user_id","amount","currency","reference_id","type","original_amount","original_currency","exchange_rate","voucher_code","discount_amount","direction","counterparty_id","created_at","updated_at","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING "id"`)).
+			WithArgs(tx.UserID, tx.Amount, tx.Currency, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), tx.ID).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(tx.ID))
 		mock.ExpectCommit()
 
@@ -174,12 +188,28 @@ func TestUpdateTransactionInvoiceDetails(t *testing.T) {
 	paymentURL := "https://pay.example.com/invoice"
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET "expires_at"=$1,"payment_url"=$2,"updated_at"=$3 WHERE transaction_id = $4`)).
-		WithArgs(expires, paymentURL, sqlmock.AnyArg(), txID).
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET "expires_at"=$1,"external_id"=$2,"payment_url"=$3,"updated_at"=$4 WHERE transaction_id = $5`)).
+		WithArgs(expires, "inv-1", paymentURL, sqlmock.AnyArg(), txID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
-	err := repo.UpdateTransactionInvoiceDetails(context.Background(), txID, paymentURL, expires)
+	err := repo.UpdateTransactionInvoiceDetails(context.Background(), txID, "inv-1", paymentURL, expires)
+	assert.NoError(t, err)
+}
+
+func TestUpdatePaymentSettlement(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	txID := uuid.New()
+	paidAt := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET "channel"=$1,"fee_amount"=$2,"method"=$3,"paid_at"=$4,"updated_at"=$5 WHERE transaction_id = $6`)).
+		WithArgs("BCA", int64(4995), "VIRTUAL_ACCOUNT", paidAt, sqlmock.AnyArg(), txID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := repo.UpdatePaymentSettlement(context.Background(), txID, models.PaymentMethod{Method: "VIRTUAL_ACCOUNT", Channel: "BCA"}, 4995, paidAt)
 	assert.NoError(t, err)
 }
 
@@ -188,16 +218,44 @@ func TestGetPendingTransactionsBefore(t *testing.T) {
 	repo := NewFinanceRepository(db)
 	expiry := time.Now()
 
+	txID := uuid.New()
 	txRows := sqlmock.NewRows([]string{"id", "user_id", "amount", "reference_id", "type", "created_at", "updated_at"}).
-		AddRow(uuid.New(), uuid.New(), int64(9000), "", "DEPOSIT", expiry.Add(-time.Hour), expiry.Add(-time.Hour))
+		AddRow(txID, uuid.New(), int64(9000), "", "DEPOSIT", expiry.Add(-time.Hour), expiry.Add(-time.Hour))
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transactions"."id","transactions"."user_id","transactions"."amount","transactions"."reference_id","transactions"."type","transactions"."created_at","transactions"."updated_at" FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE payments.status = $1 AND payments.expires_at < $2`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transactions"."id","transactions"."user_id","transactions"."amount","transactions"."currency","transactions"."reference_id","transactions"."type","transactions"."original_amount","transactions"."original_currency","transactions"."exchange_rate","transactions"."voucher_code","transactions"."discount_amount","transactions"."direction","transactions"."counterparty_id","transactions"."created_at","transactions"."updated_at" FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE payments.status = $1 AND payments.expires_at < $2`)).
 		WithArgs("PENDING", expiry).
 		WillReturnRows(txRows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE "payments"."transaction_id" = $1`)).
+		WithArgs(txID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "transaction_id", "status", "external_id", "payment_url"}).
+			AddRow(uuid.New(), txID, "PENDING", "inv-1", "https://pay.example.com/invoice"))
 
 	list, err := repo.GetPendingTransactionsBefore(context.Background(), expiry)
 	assert.NoError(t, err)
 	assert.Len(t, list, 1)
+	assert.Equal(t, "inv-1", list[0].Payment.ExternalID)
+}
+
+func TestGetPendingInvoicesExpiringBetween(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	from := time.Now()
+	to := from.Add(12 * time.Hour)
+
+	txID := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE (transactions.type = $1 AND payments.status = $2 AND payments.payment_url <> $3) AND (payments.expires_at > $4 AND payments.expires_at <= $5) ORDER BY payments.expires_at asc`)).
+		WithArgs("ADOPT", "PENDING", "", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "amount", "type"}).AddRow(txID, uuid.New(), int64(150000), "ADOPT"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE "payments"."transaction_id" = $1`)).
+		WithArgs(txID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "transaction_id", "status", "payment_url"}).
+			AddRow(uuid.New(), txID, "PENDING", "https://pay.example.com/invoice"))
+
+	list, err := repo.GetPendingInvoicesExpiringBetween(context.Background(), "ADOPT", from, to)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "https://pay.example.com/invoice", list[0].Payment.PaymentURL)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestUpdateTransactionStatus(t *testing.T) {
@@ -217,3 +275,530 @@ func TestUpdateTransactionStatus(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestTransitionPaymentStatus(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	txID := uuid.New()
+	query := regexp.QuoteMeta(`UPDATE "payments" SET "status"=$1,"updated_at"=$2 WHERE transaction_id = $3 AND status IN ($4,$5)`)
+
+	t.Run("moves a pending payment", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(query).
+			WithArgs("SUCCESS", sqlmock.AnyArg(), txID, "PENDING", "EXPIRED").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		moved, err := repo.TransitionPaymentStatus(context.Background(), txID, "SUCCESS", "PENDING", "EXPIRED")
+		assert.NoError(t, err)
+		assert.True(t, moved)
+	})
+
+	t.Run("leaves a settled payment", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(query).
+			WithArgs("SUCCESS", sqlmock.AnyArg(), txID, "PENDING", "EXPIRED").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		moved, err := repo.TransitionPaymentStatus(context.Background(), txID, "SUCCESS", "PENDING", "EXPIRED")
+		assert.NoError(t, err)
+		assert.False(t, moved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetSettledTransactionsByUserIDBetween(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	userID := uuid.New()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	txID := uuid.New()
+	txRows := sqlmock.NewRows([]string{"id", "user_id", "amount", "reference_id", "type", "created_at", "updated_at"}).
+		AddRow(txID, userID, int64(7000), "", "DEPOSIT", from.Add(time.Hour), from.Add(time.Hour))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE transactions.user_id = $1 AND payments.status = $2 AND transactions.created_at >= $3 AND transactions.created_at < $4 ORDER BY transactions.created_at asc`)).
+		WithArgs(userID, "SUCCESS", from, to).
+		WillReturnRows(txRows)
+
+	paymentRows := sqlmock.NewRows([]string{"id", "transaction_id", "amount", "status", "external_id", "payment_url", "expires_at", "created_at", "updated_at"}).
+		AddRow(uuid.New(), txID, int64(7000), "SUCCESS", "", "", from, from, from)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE "payments"."transaction_id" = $1`)).
+		WithArgs(txID).
+		WillReturnRows(paymentRows)
+
+	list, err := repo.GetSettledTransactionsByUserIDBetween(context.Background(), userID, from, to)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "SUCCESS", list[0].Payment.Status)
+}
+
+func TestGetReconciliationCandidates(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	since := time.Now().Add(-7 * 24 * time.Hour)
+
+	txID := uuid.New()
+	txRows := sqlmock.NewRows([]string{"id", "user_id", "amount", "reference_id", "type", "created_at", "updated_at"}).
+		AddRow(txID, uuid.New(), int64(9000), "", "DEPOSIT", since, since)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE payments.payment_url <> '' AND (payments.status = $1 OR (payments.status = $2 AND payments.expires_at >= $3)) ORDER BY transactions.created_at asc`)).
+		WithArgs("PENDING", "EXPIRED", since).
+		WillReturnRows(txRows)
+
+	paymentRows := sqlmock.NewRows([]string{"id", "transaction_id", "amount", "status", "external_id", "payment_url", "expires_at", "created_at", "updated_at"}).
+		AddRow(uuid.New(), txID, int64(9000), "EXPIRED", "", "https://pay.example.com/invoice", since, since, since)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE "payments"."transaction_id" = $1`)).
+		WithArgs(txID).
+		WillReturnRows(paymentRows)
+
+	list, err := repo.GetReconciliationCandidates(context.Background(), since)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "EXPIRED", list[0].Payment.Status)
+}
+
+func TestListReconciliationReports(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	now := time.Now()
+
+	reportID := uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reconciliation_reports" ORDER BY started_at desc LIMIT $1`)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "started_at", "finished_at", "checked", "fixed"}).
+			AddRow(reportID, now, now, 3, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reconciliation_discrepancies" WHERE "reconciliation_discrepancies"."report_id" = $1`)).
+		WithArgs(reportID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "report_id", "transaction_id", "kind", "action"}).
+			AddRow(uuid.New(), reportID, uuid.New(), "MISSED_PAYMENT", "SETTLED"))
+
+	reports, err := repo.ListReconciliationReports(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Len(t, reports, 1)
+	assert.Equal(t, 3, reports[0].Checked)
+	assert.Len(t, reports[0].Discrepancies, 1)
+}
+
+func TestRedeemVoucher(t *testing.T) {
+	voucherID, userID := uuid.New(), uuid.New()
+	takeUse := regexp.QuoteMeta(`UPDATE "vouchers" SET "redemption_count"=redemption_count + 1 WHERE id = $1 AND (max_redemptions = 0 OR redemption_count < max_redemptions)`)
+	countUses := regexp.QuoteMeta(`SELECT count(*) FROM "voucher_redemptions" WHERE voucher_id = $1 AND user_id = $2 AND status = $3`)
+
+	t.Run("success", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+		redemption := &models.VoucherRedemption{ID: uuid.New(), VoucherID: voucherID, UserID: userID, TransactionID: uuid.New(), DiscountAmount: 5000, Currency: "IDR", Status: "APPLIED"}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(takeUse).WithArgs(voucherID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(countUses).WithArgs(voucherID, userID, "APPLIED").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "voucher_redemptions"`)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(redemption.ID))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.RedeemVoucher(context.Background(), redemption, 1))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fully redeemed", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(takeUse).WithArgs(voucherID).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.RedeemVoucher(context.Background(), &models.VoucherRedemption{VoucherID: voucherID, UserID: userID}, 0)
+		assert.ErrorIs(t, err, models.ErrVoucherUnavailable)
+	})
+
+	t.Run("per user limit reached", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(takeUse).WithArgs(voucherID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(countUses).WithArgs(voucherID, userID, "APPLIED").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		err := repo.RedeemVoucher(context.Background(), &models.VoucherRedemption{VoucherID: voucherID, UserID: userID}, 1)
+		assert.ErrorIs(t, err, models.ErrVoucherUnavailable)
+	})
+}
+
+func TestReleaseVoucherRedemption(t *testing.T) {
+	txID, redemptionID, voucherID := uuid.New(), uuid.New(), uuid.New()
+	findRedemption := regexp.QuoteMeta(`SELECT * FROM "voucher_redemptions" WHERE transaction_id = $1 AND status = $2 ORDER BY "voucher_redemptions"."id" LIMIT $3`)
+
+	t.Run("releases the use", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectQuery(findRedemption).WithArgs(txID, "APPLIED", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "voucher_id", "transaction_id", "status"}).AddRow(redemptionID, voucherID, txID, "APPLIED"))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "voucher_redemptions" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status = $4`)).
+			WithArgs("RELEASED", sqlmock.AnyArg(), redemptionID, "APPLIED").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "vouchers" SET "redemption_count"=redemption_count - 1 WHERE id = $1`)).
+			WithArgs(voucherID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.ReleaseVoucherRedemption(context.Background(), txID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("nothing redeemed", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectQuery(findRedemption).WithArgs(txID, "APPLIED", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		assert.NoError(t, repo.ReleaseVoucherRedemption(context.Background(), txID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDebitWallet(t *testing.T) {
+	userID := uuid.New()
+	debit := regexp.QuoteMeta(`UPDATE "profiles" SET "balance"=balance - $1 WHERE id = $2 AND currency = $3 AND balance >= $4`)
+
+	t.Run("success", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(debit).WithArgs(int64(5000), userID, "IDR", int64(5000)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.DebitWallet(context.Background(), userID, 5000, "IDR"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(debit).WithArgs(int64(5000), userID, "IDR", int64(5000)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.DebitWallet(context.Background(), userID, 5000, "IDR")
+		assert.ErrorIs(t, err, models.ErrInsufficientBalance)
+	})
+}
+
+func TestGetUserIDByEmail(t *testing.T) {
+	userID := uuid.New()
+	query := regexp.QuoteMeta(`SELECT "id" FROM "users" WHERE LOWER(email) = LOWER($1) AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT $2`)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectQuery(query).WithArgs("Sari@Example.com", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(userID))
+
+		id, err := repo.GetUserIDByEmail(context.Background(), "Sari@Example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, userID, id)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectQuery(query).WithArgs("nobody@example.com", 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetUserIDByEmail(context.Background(), "nobody@example.com")
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestSumTransfersSentSince(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	userID, since := uuid.New(), time.Now().Add(-24*time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(amount), 0) FROM "transactions" WHERE user_id = $1 AND type = $2 AND direction = $3 AND created_at >= $4`)).
+		WithArgs(userID, "TRANSFER", "OUT", since).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(int64(75000)))
+
+	total, err := repo.SumTransfersSentSince(context.Background(), userID, since)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(75000), total)
+}
+
+func TestCountDepositsSince(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	userID, since := uuid.New(), time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "transactions" WHERE user_id = $1 AND type = $2 AND created_at >= $3`)).
+		WithArgs(userID, "DEPOSIT", since).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(4)))
+
+	count, err := repo.CountDepositsSince(context.Background(), userID, since)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestSumDepositsSince(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	userID, since := uuid.New(), time.Now().Add(-24*time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(transactions.amount), 0) FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE transactions.user_id = $1 AND transactions.type = $2 AND transactions.created_at >= $3 AND payments.status NOT IN ($4,$5)`)).
+		WithArgs(userID, "DEPOSIT", since, "EXPIRED", "REJECTED").
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(int64(1500000)))
+
+	total, err := repo.SumDepositsSince(context.Background(), userID, since)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500000), total)
+}
+
+func TestCreateBalanceHold(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	holdID, userID, txID := uuid.New(), uuid.New(), uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "balance_holds" ("user_id","transaction_id","amount","currency","type","reference_id","description","status","expires_at","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
+		WithArgs(userID, txID, int64(30000), "IDR", "ADOPT", "intent-1", "", "HELD", nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(holdID))
+	mock.ExpectCommit()
+
+	hold := &models.BalanceHold{UserID: userID, TransactionID: &txID, Amount: 30000, Currency: "IDR", Type: "ADOPT", ReferenceID: "intent-1", Status: "HELD"}
+	assert.NoError(t, repo.CreateBalanceHold(context.Background(), hold))
+	assert.Equal(t, holdID, hold.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBalanceHoldByTransactionID(t *testing.T) {
+	txID := uuid.New()
+	find := regexp.QuoteMeta(`SELECT * FROM "balance_holds" WHERE transaction_id = $1 ORDER BY "balance_holds"."id" LIMIT $2`)
+
+	t.Run("found", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+		holdID := uuid.New()
+
+		mock.ExpectQuery(find).WithArgs(txID, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "transaction_id", "amount", "currency", "status"}).AddRow(holdID, txID, int64(30000), "IDR", "HELD"))
+
+		hold, err := repo.GetBalanceHoldByTransactionID(context.Background(), txID)
+		assert.NoError(t, err)
+		assert.Equal(t, holdID, hold.ID)
+		assert.Equal(t, int64(30000), hold.Amount)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectQuery(find).WithArgs(txID, 1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetBalanceHoldByTransactionID(context.Background(), txID)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+func TestSettleBalanceHold(t *testing.T) {
+	holdID := uuid.New()
+	settle := regexp.QuoteMeta(`UPDATE "balance_holds" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status = $4`)
+
+	t.Run("settles a held hold", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(settle).WithArgs("CAPTURED", sqlmock.AnyArg(), holdID, "HELD").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.SettleBalanceHold(context.Background(), holdID, "CAPTURED"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already settled", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(settle).WithArgs("RELEASED", sqlmock.AnyArg(), holdID, "HELD").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		assert.ErrorIs(t, repo.SettleBalanceHold(context.Background(), holdID, "RELEASED"), models.ErrNotFound)
+	})
+}
+
+func TestGetBalanceHold(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	holdID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "balance_holds" WHERE id = $1 ORDER BY "balance_holds"."id" LIMIT $2`)).
+		WithArgs(holdID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.GetBalanceHold(context.Background(), holdID)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestListExpiredBalanceHolds(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "balance_holds" WHERE status = $1 AND expires_at < $2 ORDER BY expires_at asc`)).
+		WithArgs("HELD", now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "status"}).AddRow(uuid.New(), int64(5000), "HELD"))
+
+	holds, err := repo.ListExpiredBalanceHolds(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Len(t, holds, 1)
+}
+
+func TestSumHeldBalance(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(amount), 0) FROM "balance_holds" WHERE user_id = $1 AND status = $2`)).
+		WithArgs(userID, "HELD").
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(int64(45000)))
+
+	total, err := repo.SumHeldBalance(context.Background(), userID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(45000), total)
+}
+
+func TestCaptureBalanceHold(t *testing.T) {
+	holdID, txID := uuid.New(), uuid.New()
+	capture := regexp.QuoteMeta(`UPDATE "balance_holds" SET "status"=$1,"transaction_id"=$2,"updated_at"=$3 WHERE id = $4 AND status = $5`)
+
+	t.Run("captures a held hold", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(capture).WithArgs("CAPTURED", txID, sqlmock.AnyArg(), holdID, "HELD").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.CaptureBalanceHold(context.Background(), holdID, txID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already settled", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(capture).WithArgs("CAPTURED", txID, sqlmock.AnyArg(), holdID, "HELD").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		assert.ErrorIs(t, repo.CaptureBalanceHold(context.Background(), holdID, txID), models.ErrNotFound)
+	})
+}
+
+func TestDecideTopUpReview(t *testing.T) {
+	reviewID, reviewerID := uuid.New(), uuid.New()
+	decide := regexp.QuoteMeta(`UPDATE "top_up_reviews" SET "note"=$1,"reviewed_at"=$2,"reviewer_id"=$3,"status"=$4,"updated_at"=$5 WHERE id = $6 AND status = $7`)
+
+	t.Run("decides a pending review", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(decide).WithArgs("known donor", sqlmock.AnyArg(), reviewerID, "APPROVED", sqlmock.AnyArg(), reviewID, "PENDING").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.DecideTopUpReview(context.Background(), reviewID, "APPROVED", reviewerID, "known donor"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already decided", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(decide).WithArgs("", sqlmock.AnyArg(), reviewerID, "REJECTED", sqlmock.AnyArg(), reviewID, "PENDING").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		assert.ErrorIs(t, repo.DecideTopUpReview(context.Background(), reviewID, "REJECTED", reviewerID, ""), models.ErrNotFound)
+	})
+}
+
+func TestDecideBalanceAdjustment(t *testing.T) {
+	adjustmentID, deciderID, txID := uuid.New(), uuid.New(), uuid.New()
+	decide := regexp.QuoteMeta(`UPDATE "balance_adjustments" SET "decided_at"=$1,"decided_by"=$2,"decision_note"=$3,"status"=$4,"transaction_id"=$5,"updated_at"=$6 WHERE id = $7 AND status = $8`)
+
+	t.Run("posts a pending adjustment", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(decide).WithArgs(sqlmock.AnyArg(), deciderID, "checked", "APPROVED", &txID, sqlmock.AnyArg(), adjustmentID, "PENDING").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.DecideBalanceAdjustment(context.Background(), adjustmentID, "APPROVED", deciderID, "checked", &txID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already decided", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(decide).WithArgs(sqlmock.AnyArg(), deciderID, "", "REJECTED", sqlmock.AnyArg(), sqlmock.AnyArg(), adjustmentID, "PENDING").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		assert.ErrorIs(t, repo.DecideBalanceAdjustment(context.Background(), adjustmentID, "REJECTED", deciderID, "", nil), models.ErrNotFound)
+	})
+}
+
+func TestFindMatchingCampaigns(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	at := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "matching_campaigns" WHERE (status = $1 AND ',' || email_domains || ',' LIKE $2) AND ((starts_at IS NULL OR starts_at <= $3) AND (ends_at IS NULL OR ends_at > $4)) ORDER BY created_at asc`)).
+		WithArgs("ACTIVE", "%,acme.com,%", at, at).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization", "email_domains"}).AddRow(uuid.New(), "Acme", "acme.co.id,acme.com"))
+
+	campaigns, err := repo.FindMatchingCampaigns(context.Background(), "acme.com", at)
+	assert.NoError(t, err)
+	assert.Len(t, campaigns, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSpendMatchingBudget(t *testing.T) {
+	campaignID := uuid.New()
+	spend := regexp.QuoteMeta(`UPDATE "matching_campaigns" SET "spent_amount"=spent_amount + $1 WHERE id = $2 AND status = $3 AND spent_amount + $4 <= budget_amount`)
+
+	t.Run("within budget", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(spend).WithArgs(int64(150000), campaignID, "ACTIVE", int64(150000)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.SpendMatchingBudget(context.Background(), campaignID, 150000))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("budget used up", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewFinanceRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(spend).WithArgs(int64(150000), campaignID, "ACTIVE", int64(150000)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		assert.ErrorIs(t, repo.SpendMatchingBudget(context.Background(), campaignID, 150000), models.ErrLimitExceeded)
+	})
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"hash/fnv"
+	"log"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/scheduler"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type jobRunStore struct {
+	db *gorm.DB
+}
+
+// NewJobRunStore keeps the scheduler's run history in the job_runs table.
+func NewJobRunStore(db *gorm.DB) scheduler.Store {
+	return &jobRunStore{db: db}
+}
+
+func (s *jobRunStore) StartRun(ctx context.Context, run *scheduler.Run) (bool, error) {
+	row := models.JobRun{
+		ID:          uuid.New(),
+		JobName:     run.Job,
+		Trigger:     run.Trigger,
+		Status:      run.Status,
+		ScheduledAt: run.ScheduledAt,
+		StartedAt:   run.StartedAt,
+	}
+	res := s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&row)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	run.ID = row.ID.String()
+	return true, nil
+}
+
+func (s *jobRunStore) FinishRun(ctx context.Context, run *scheduler.Run) error {
+	return s.db.WithContext(ctx).Model(&models.JobRun{}).
+		Where("id = ?", run.ID).
+		Updates(map[string]interface{}{
+			"status":      run.Status,
+			"finished_at": run.FinishedAt,
+			"duration_ms": run.Duration.Milliseconds(),
+			"error":       run.Error,
+		}).Error
+}
+
+func (s *jobRunStore) ListRuns(ctx context.Context, job string, limit int) ([]scheduler.Run, error) {
+	var rows []models.JobRun
+	err := s.db.WithContext(ctx).
+		Where("job_name = ?", job).
+		Order("started_at desc").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]scheduler.Run, len(rows))
+	for i, r := range rows {
+		runs[i] = scheduler.Run{
+			ID:          r.ID.String(),
+			Job:         r.JobName,
+			Trigger:     r.Trigger,
+			Status:      r.Status,
+			ScheduledAt: r.ScheduledAt,
+			StartedAt:   r.StartedAt,
+			Duration:    time.Duration(r.DurationMs) * time.Millisecond,
+			Error:       r.Error,
+		}
+		if r.FinishedAt != nil {
+			runs[i].FinishedAt = *r.FinishedAt
+		}
+	}
+	return runs, nil
+}
+
+type advisoryLocker struct {
+	db *gorm.DB
+}
+
+// NewAdvisoryLocker locks with Postgres session advisory locks, which the
+// server releases by itself if the holding replica dies.
+func NewAdvisoryLocker(db *gorm.DB) scheduler.Locker {
+	return &advisoryLocker{db: db}
+}
+
+func (l *advisoryLocker) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	lockID := int64(h.Sum64())
+
+	sqlDB, err := l.db.DB()
+	if err != nil {
+		return nil, false, err
+	}
+	// Session locks belong to a connection, so the lock is taken and
+	// released on one connection held out of the pool meanwhile.
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var ok bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&ok); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !ok {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID); err != nil {
+			log.Printf("WARN: failed to release lock %s, dropping its connection: %v", key, err)
+			// Discard the connection rather than pool it, closing the
+			// session ends the lock.
+			conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}, true, nil
+}
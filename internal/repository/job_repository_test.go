@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"reforest/pkg/scheduler"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobRunStore_StartRun(t *testing.T) {
+	insert := regexp.QuoteMeta(`INSERT INTO "job_runs" ("job_name","trigger","status","scheduled_at","started_at","finished_at","duration_ms","error","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) ON CONFLICT DO NOTHING RETURNING "id"`)
+	scheduledAt := time.Date(2026, 3, 14, 10, 5, 0, 0, time.UTC)
+
+	t.Run("records the run", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		store := NewJobRunStore(db)
+		id := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(insert).
+			WithArgs("payment-expiry", "SCHEDULE", "RUNNING", scheduledAt, sqlmock.AnyArg(), nil, 0, "", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+		mock.ExpectCommit()
+
+		run := &scheduler.Run{Job: "payment-expiry", Trigger: "SCHEDULE", Status: "RUNNING", ScheduledAt: scheduledAt, StartedAt: time.Now()}
+		started, err := store.StartRun(context.Background(), run)
+		assert.NoError(t, err)
+		assert.True(t, started)
+		assert.NotEmpty(t, run.ID)
+	})
+
+	t.Run("activation already recorded", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		store := NewJobRunStore(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(insert).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		run := &scheduler.Run{Job: "payment-expiry", Trigger: "SCHEDULE", Status: "RUNNING", ScheduledAt: scheduledAt, StartedAt: time.Now()}
+		started, err := store.StartRun(context.Background(), run)
+		assert.NoError(t, err)
+		assert.False(t, started)
+		assert.Empty(t, run.ID)
+	})
+}
+
+func TestJobRunStore_FinishRun(t *testing.T) {
+	db, mock := setupMockDB(t)
+	store := NewJobRunStore(db)
+	id := uuid.New()
+	finished := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "job_runs" SET "duration_ms"=$1,"error"=$2,"finished_at"=$3,"status"=$4 WHERE id = $5`)).
+		WithArgs(int64(1500), "provider down", finished, "FAILED", id.String()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := store.FinishRun(context.Background(), &scheduler.Run{ID: id.String(), Status: "FAILED", FinishedAt: finished, Duration: 1500 * time.Millisecond, Error: "provider down"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRunStore_ListRuns(t *testing.T) {
+	db, mock := setupMockDB(t)
+	store := NewJobRunStore(db)
+	id := uuid.New()
+	finished := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "job_runs" WHERE job_name = $1 ORDER BY started_at desc LIMIT $2`)).
+		WithArgs("payment-expiry", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "job_name", "status", "finished_at", "duration_ms"}).
+			AddRow(id, "payment-expiry", "SUCCEEDED", finished, 250).
+			AddRow(uuid.New(), "payment-expiry", "RUNNING", nil, 0))
+
+	runs, err := store.ListRuns(context.Background(), "payment-expiry", 5)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 2)
+	assert.Equal(t, 250*time.Millisecond, runs[0].Duration)
+	assert.True(t, runs[0].FinishedAt.Equal(finished))
+	assert.True(t, runs[1].FinishedAt.IsZero())
+}
+
+func TestAdvisoryLocker_TryLock(t *testing.T) {
+	tryLock := regexp.QuoteMeta(`SELECT pg_try_advisory_lock($1)`)
+
+	t.Run("acquired and released", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		locker := NewAdvisoryLocker(db)
+
+		mock.ExpectQuery(tryLock).WithArgs(sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"ok"}).AddRow(true))
+		mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		unlock, ok, err := locker.TryLock(context.Background(), "scheduler:payment-expiry")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		unlock()
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("held elsewhere", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		locker := NewAdvisoryLocker(db)
+
+		mock.ExpectQuery(tryLock).WithArgs(sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"ok"}).AddRow(false))
+
+		unlock, ok, err := locker.TryLock(context.Background(), "scheduler:payment-expiry")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, unlock)
+	})
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reforest/internal/models"
+	"reforest/pkg/outbox"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type financeOutboxStore struct {
+	db *gorm.DB
+}
+
+// NewFinanceOutboxStore relays the outbox_events table written by
+// FinanceRepository.EnqueueEvent.
+func NewFinanceOutboxStore(db *gorm.DB) outbox.Store {
+	return &financeOutboxStore{db: db}
+}
+
+// Pending skips events another relay is claiming at the same moment rather
+// than waiting for it.
+func (s *financeOutboxStore) Pending(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+	var events []models.OutboxEvent
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL AND available_at <= ?", now).
+			Order("created_at asc").
+			Limit(limit).
+			Find(&events).Error
+		if err != nil || len(events) == 0 {
+			return err
+		}
+		ids := make([]uuid.UUID, len(events))
+		for i, e := range events {
+			ids[i] = e.ID
+		}
+		return tx.Model(&models.OutboxEvent{}).
+			Where("id IN ?", ids).
+			Update("available_at", now.Add(outbox.ClaimTimeout)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]outbox.Message, len(events))
+	for i, e := range events {
+		msgs[i] = outbox.Message{ID: e.ID.String(), RoutingKey: e.RoutingKey, Payload: []byte(e.Payload), Attempts: e.Attempts}
+	}
+	return msgs, nil
+}
+
+func (s *financeOutboxStore) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	eventID, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return s.db.WithContext(ctx).Model(&models.OutboxEvent{}).
+		Where("id = ?", eventID).
+		Update("sent_at", sentAt).Error
+}
+
+func (s *financeOutboxStore) MarkFailed(ctx context.Context, id string, lastErr string, retryAt time.Time) error {
+	eventID, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return s.db.WithContext(ctx).Model(&models.OutboxEvent{}).
+		Where("id = ?", eventID).
+		Updates(map[string]interface{}{
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   lastErr,
+			"available_at": retryAt,
+		}).Error
+}
+
+type treeOutboxStore struct {
+	db *mongo.Database
+}
+
+// NewTreeOutboxStore relays the outbox collection written by
+// TreeManagementRepository.EnqueueEvent.
+func NewTreeOutboxStore(db *mongo.Database) outbox.Store {
+	return &treeOutboxStore{db: db}
+}
+
+// Pending claims one document at a time, as MongoDB has no way to claim
+// several atomically.
+func (s *treeOutboxStore) Pending(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+	filter := bson.M{
+		"sent_at":      nil,
+		"available_at": bson.M{"$lte": now},
+	}
+	claim := bson.M{"$set": bson.M{"available_at": now.Add(outbox.ClaimTimeout)}}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	var msgs []outbox.Message
+	for len(msgs) < limit {
+		var d models.OutboxDocument
+		err := s.db.Collection(outboxCollection).FindOneAndUpdate(ctx, filter, claim, opts).Decode(&d)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, outbox.Message{ID: d.ID.Hex(), RoutingKey: d.RoutingKey, Payload: []byte(d.Payload), Attempts: d.Attempts})
+	}
+	return msgs, nil
+}
+
+func (s *treeOutboxStore) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	docID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Collection(outboxCollection).UpdateOne(ctx, bson.M{"_id": docID}, bson.M{"$set": bson.M{"sent_at": sentAt}})
+	return err
+}
+
+func (s *treeOutboxStore) MarkFailed(ctx context.Context, id string, lastErr string, retryAt time.Time) error {
+	docID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Collection(outboxCollection).UpdateOne(ctx, bson.M{"_id": docID}, bson.M{
+		"$set": bson.M{"last_error": lastErr, "available_at": retryAt},
+		"$inc": bson.M{"attempts": 1},
+	})
+	return err
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"reforest/pkg/outbox"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestFinanceOutboxStore_Pending(t *testing.T) {
+	db, mock := setupMockDB(t)
+	store := NewFinanceOutboxStore(db)
+	now := time.Now()
+
+	eventID := uuid.New()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "outbox_events" WHERE sent_at IS NULL AND available_at <= $1 ORDER BY created_at asc LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+		WithArgs(now, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "routing_key", "payload", "attempts"}).
+			AddRow(eventID, "payment.success", `{"reference_id":"abc"}`, 2))
+	// claimed rows are hidden from other relays until the claim runs out
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_events" SET "available_at"=$1 WHERE id IN ($2)`)).
+		WithArgs(now.Add(outbox.ClaimTimeout), eventID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	msgs, err := store.Pending(context.Background(), now, 50)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, eventID.String(), msgs[0].ID)
+	assert.Equal(t, `{"reference_id":"abc"}`, string(msgs[0].Payload))
+	assert.Equal(t, 2, msgs[0].Attempts)
+}
+
+func TestFinanceOutboxStore_MarkFailed(t *testing.T) {
+	db, mock := setupMockDB(t)
+	store := NewFinanceOutboxStore(db)
+	eventID := uuid.New()
+	retryAt := time.Now().Add(time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_events" SET "attempts"=attempts + 1,"available_at"=$1,"last_error"=$2 WHERE id = $3`)).
+		WithArgs(retryAt, "connection refused", eventID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := store.MarkFailed(context.Background(), eventID.String(), "connection refused", retryAt)
+	assert.NoError(t, err)
+}
+
+func TestEnqueueEvent_InTransaction(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewFinanceRepository(db)
+	txID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payments" SET "status"=$1,"updated_at"=$2 WHERE transaction_id = $3`)).
+		WithArgs("EXPIRED", sqlmock.AnyArg(), txID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox_events" ("routing_key","payload","attempts","last_error","available_at","sent_at","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+		WithArgs("payment.expired", `{"reference_id":"abc"}`, 0, "", sqlmock.AnyArg(), nil, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+	mock.ExpectCommit()
+
+	err := repo.WithTransaction(context.Background(), func(repo FinanceRepository) error {
+		if err := repo.UpdateTransactionStatus(context.Background(), txID, "EXPIRED"); err != nil {
+			return err
+		}
+		return repo.EnqueueEvent(context.Background(), "payment.expired", map[string]string{"reference_id": "abc"})
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTreeOutboxStore(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("pending", func(mt *mtest.T) {
+		store := NewTreeOutboxStore(mt.Client.Database("db"))
+		id := primitive.NewObjectID()
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+				{Key: "_id", Value: id},
+				{Key: "routing_key", Value: "tree.adopted"},
+				{Key: "payload", Value: `{"tree_id":"x"}`},
+				{Key: "attempts", Value: 1},
+			}}),
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}),
+		)
+
+		now := time.Now()
+		msgs, err := store.Pending(context.Background(), now, 10)
+		if err != nil {
+			t.Fatalf("Pending error: %v", err)
+		}
+		if len(msgs) != 1 || msgs[0].ID != id.Hex() || msgs[0].RoutingKey != "tree.adopted" || msgs[0].Attempts != 1 {
+			t.Fatalf("unexpected messages: %+v", msgs)
+		}
+		claim := mt.GetStartedEvent().Command.Lookup("update", "$set", "available_at").Time()
+		if !claim.Equal(now.Add(outbox.ClaimTimeout).Truncate(time.Millisecond)) {
+			t.Fatalf("message should be claimed until %v, got %v", now.Add(outbox.ClaimTimeout), claim)
+		}
+	})
+
+	mt.Run("mark sent", func(mt *mtest.T) {
+		store := NewTreeOutboxStore(mt.Client.Database("db"))
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}, bson.E{Key: "nModified", Value: int32(1)}))
+
+		if err := store.MarkSent(context.Background(), primitive.NewObjectID().Hex(), time.Now()); err != nil {
+			t.Fatalf("MarkSent error: %v", err)
+		}
+	})
+
+	mt.Run("invalid id", func(mt *mtest.T) {
+		store := NewTreeOutboxStore(mt.Client.Database("db"))
+		if err := store.MarkFailed(context.Background(), "not-an-id", "boom", time.Now()); err == nil {
+			t.Fatalf("expected error for invalid id")
+		}
+	})
+}
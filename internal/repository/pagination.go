@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"reforest/internal/models"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// pageCursor is the sort key of the last result of a page. Page tokens are
+// its BSON encoding, so the value keeps its type on the way back.
+type pageCursor struct {
+	SortBy     string             `bson:"s"`
+	Descending bool               `bson:"d"`
+	Value      bson.RawValue      `bson:"v"`
+	ID         primitive.ObjectID `bson:"i"`
+}
+
+func encodePageToken(c pageCursor) (string, error) {
+	b, err := bson.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePageToken(token string, opts models.ListOptions) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed page token", models.ErrInvalidInput)
+	}
+	var c pageCursor
+	if err := bson.Unmarshal(b, &c); err != nil || c.ID.IsZero() {
+		return nil, fmt.Errorf("%w: malformed page token", models.ErrInvalidInput)
+	}
+	if c.SortBy != opts.SortBy || c.Descending != opts.Descending {
+		return nil, fmt.Errorf("%w: page token belongs to a different sort order", models.ErrInvalidInput)
+	}
+	return &c, nil
+}
+
+// seek matches the documents that sort after the cursor. Documents without
+// the sort field sort as null, before every other value, but $gt and $lt
+// never match null, so those documents get clauses of their own.
+func (c *pageCursor) seek() bson.M {
+	op := "$gt"
+	if c.Descending {
+		op = "$lt"
+	}
+	if c.Value.Type == bson.TypeNull {
+		after := bson.A{bson.M{c.SortBy: nil, "_id": bson.M{op: c.ID}}}
+		if !c.Descending {
+			after = append(after, bson.M{c.SortBy: bson.M{"$ne": nil}})
+		}
+		return bson.M{"$or": after}
+	}
+	after := bson.A{
+		bson.M{c.SortBy: bson.M{op: c.Value}},
+		bson.M{c.SortBy: c.Value, "_id": bson.M{op: c.ID}},
+	}
+	if c.Descending {
+		after = append(after, bson.M{c.SortBy: nil})
+	}
+	return bson.M{"$or": after}
+}
+
+// findPage returns the page of documents in coll matching filter that opts
+// selects, using the page token as a keyset on (SortBy, _id) so pages stay
+// stable while documents are added. opts must already be validated.
+func findPage[T any](ctx context.Context, coll *mongo.Collection, filter bson.M, opts models.ListOptions) ([]*T, models.PageInfo, error) {
+	total, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, models.PageInfo{}, err
+	}
+
+	dir := 1
+	if opts.Descending {
+		dir = -1
+	}
+	query := filter
+	if opts.PageToken != "" {
+		after, err := decodePageToken(opts.PageToken, opts)
+		if err != nil {
+			return nil, models.PageInfo{}, err
+		}
+		query = bson.M{"$and": bson.A{filter, after.seek()}}
+	}
+
+	find := options.Find().
+		SetSort(bson.D{{Key: opts.SortBy, Value: dir}, {Key: "_id", Value: dir}}).
+		SetLimit(int64(opts.PageSize) + 1)
+	cursor, err := coll.Find(ctx, query, find)
+	if err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	defer cursor.Close(ctx)
+	var docs []bson.Raw
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, models.PageInfo{}, err
+	}
+
+	info := models.PageInfo{TotalCount: total}
+	if len(docs) > opts.PageSize {
+		docs = docs[:opts.PageSize]
+		last := docs[len(docs)-1]
+		value, err := last.LookupErr(opts.SortBy)
+		if err != nil {
+			// documents without the field sort as null
+			value = bson.RawValue{Type: bson.TypeNull}
+		}
+		info.NextPageToken, err = encodePageToken(pageCursor{
+			SortBy:     opts.SortBy,
+			Descending: opts.Descending,
+			Value:      value,
+			ID:         last.Lookup("_id").ObjectID(),
+		})
+		if err != nil {
+			return nil, models.PageInfo{}, err
+		}
+	}
+
+	items := make([]*T, len(docs))
+	for i, doc := range docs {
+		items[i] = new(T)
+		if err := bson.Unmarshal(doc, items[i]); err != nil {
+			return nil, models.PageInfo{}, err
+		}
+	}
+	return items, info, nil
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"reforest/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PartnerRepository interface {
+	// CreatePartner inserts a partner with its plot links. It fails with
+	// ErrAlreadyExists if one of the plots belongs to another partner.
+	CreatePartner(ctx context.Context, partner *models.Partner) error
+	// UpdatePartner replaces a partner's details and plot links.
+	UpdatePartner(ctx context.Context, partner *models.Partner) error
+	GetPartner(ctx context.Context, id uuid.UUID) (*models.Partner, error)
+	ListPartners(ctx context.Context) ([]models.Partner, error)
+	// CreatePayout inserts a payout with its lines. It fails with
+	// ErrAlreadyExists if the partner already has a payout for the period
+	// and currency.
+	CreatePayout(ctx context.Context, payout *models.Payout) error
+	GetPayout(ctx context.Context, id uuid.UUID) (*models.Payout, error)
+	ListPayouts(ctx context.Context, partnerID uuid.UUID, status string) ([]models.Payout, error)
+	// UpdatePayoutStatus settles a PENDING payout, failing with ErrNotFound
+	// if it is no longer pending.
+	UpdatePayoutStatus(ctx context.Context, id uuid.UUID, status, reference, note string, paidAt *time.Time) error
+}
+
+type partnerRepository struct {
+	db *gorm.DB
+}
+
+func NewPartnerRepository(db *gorm.DB) PartnerRepository {
+	return &partnerRepository{db: db}
+}
+
+func (r *partnerRepository) CreatePartner(ctx context.Context, partner *models.Partner) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkPlotsFree(tx, partner); err != nil {
+			return err
+		}
+		return tx.Create(partner).Error
+	})
+}
+
+func (r *partnerRepository) UpdatePartner(ctx context.Context, partner *models.Partner) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&models.Partner{}).
+			Where("id = ?", partner.ID).
+			Updates(map[string]interface{}{
+				"name":                partner.Name,
+				"email":               partner.Email,
+				"bank_name":           partner.BankName,
+				"bank_account_number": partner.BankAccountNumber,
+				"bank_account_name":   partner.BankAccountName,
+				"share_bps":           partner.ShareBps,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return models.ErrNotFound
+		}
+
+		if err := checkPlotsFree(tx, partner); err != nil {
+			return err
+		}
+		if err := tx.Where("partner_id = ?", partner.ID).Delete(&models.PartnerPlot{}).Error; err != nil {
+			return err
+		}
+		for i := range partner.Plots {
+			partner.Plots[i].PartnerID = partner.ID
+		}
+		if len(partner.Plots) == 0 {
+			return nil
+		}
+		return tx.Create(&partner.Plots).Error
+	})
+}
+
+// checkPlotsFree fails if any of the partner's plots is linked to a
+// different partner. The plot_id primary key still guards against two
+// partners claiming a plot at once.
+func checkPlotsFree(tx *gorm.DB, partner *models.Partner) error {
+	if len(partner.Plots) == 0 {
+		return nil
+	}
+	plotIDs := make([]string, len(partner.Plots))
+	for i, plot := range partner.Plots {
+		plotIDs[i] = plot.PlotID
+	}
+
+	var taken []models.PartnerPlot
+	err := tx.Where("plot_id IN ? AND partner_id <> ?", plotIDs, partner.ID).
+		Find(&taken).Error
+	if err != nil {
+		return err
+	}
+	if len(taken) > 0 {
+		return fmt.Errorf("%w: plot %s already belongs to partner %s", models.ErrAlreadyExists, taken[0].PlotID, taken[0].PartnerID)
+	}
+	return nil
+}
+
+func (r *partnerRepository) GetPartner(ctx context.Context, id uuid.UUID) (*models.Partner, error) {
+	var partner models.Partner
+	err := r.db.WithContext(ctx).Preload("Plots").First(&partner, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &partner, err
+}
+
+func (r *partnerRepository) ListPartners(ctx context.Context) ([]models.Partner, error) {
+	var partners []models.Partner
+	err := r.db.WithContext(ctx).Preload("Plots").Order("name").Find(&partners).Error
+	return partners, err
+}
+
+func (r *partnerRepository) CreatePayout(ctx context.Context, payout *models.Payout) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&models.Payout{}).
+			Where("partner_id = ? AND period_start = ? AND currency = ?", payout.PartnerID, payout.PeriodStart, payout.Currency).
+			Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return models.ErrAlreadyExists
+		}
+
+		if err := tx.Omit("Partner").Create(payout).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return models.ErrAlreadyExists
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+func (r *partnerRepository) GetPayout(ctx context.Context, id uuid.UUID) (*models.Payout, error) {
+	var payout models.Payout
+	err := r.db.WithContext(ctx).Preload("Partner").Preload("Lines").First(&payout, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	}
+	return &payout, err
+}
+
+func (r *partnerRepository) ListPayouts(ctx context.Context, partnerID uuid.UUID, status string) ([]models.Payout, error) {
+	var payouts []models.Payout
+	query := r.db.WithContext(ctx).Preload("Partner").Preload("Lines").Order("period_start desc, created_at desc")
+	if partnerID != uuid.Nil {
+		query = query.Where("partner_id = ?", partnerID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Find(&payouts).Error
+	return payouts, err
+}
+
+func (r *partnerRepository) UpdatePayoutStatus(ctx context.Context, id uuid.UUID, status, reference, note string, paidAt *time.Time) error {
+	res := r.db.WithContext(ctx).Model(&models.Payout{}).
+		Where("id = ? AND status = ?", id, "PENDING").
+		Updates(map[string]interface{}{
+			"status":    status,
+			"reference": reference,
+			"note":      note,
+			"paid_at":   paidAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: payout %s is no longer pending", models.ErrNotFound, id)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePartner_PlotTaken(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewPartnerRepository(db)
+	otherID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "partner_plots" WHERE plot_id IN ($1,$2) AND partner_id <> $3`)).
+		WithArgs("plot-a", "plot-b", uuid.Nil).
+		WillReturnRows(sqlmock.NewRows([]string{"plot_id", "partner_id", "share_bps"}).AddRow("plot-b", otherID, 0))
+	mock.ExpectRollback()
+
+	err := repo.CreatePartner(context.Background(), &models.Partner{
+		Name:     "Sari",
+		ShareBps: 2000,
+		Plots:    []models.PartnerPlot{{PlotID: "plot-a"}, {PlotID: "plot-b"}},
+	})
+	assert.ErrorIs(t, err, models.ErrAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePayout_AlreadyCalculated(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewPartnerRepository(db)
+	partnerID := uuid.New()
+	period := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "payouts" WHERE partner_id = $1 AND period_start = $2 AND currency = $3`)).
+		WithArgs(partnerID, period, "IDR").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	err := repo.CreatePayout(context.Background(), &models.Payout{PartnerID: partnerID, PeriodStart: period, Currency: "IDR"})
+	assert.ErrorIs(t, err, models.ErrAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePayoutStatus(t *testing.T) {
+	payoutID := uuid.New()
+	update := regexp.QuoteMeta(`UPDATE "payouts" SET "note"=$1,"paid_at"=$2,"reference"=$3,"status"=$4,"updated_at"=$5 WHERE id = $6 AND status = $7`)
+
+	t.Run("pays a pending payout", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewPartnerRepository(db)
+		paidAt := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(update).WithArgs("", &paidAt, "TRF-0901", "PAID", sqlmock.AnyArg(), payoutID, "PENDING").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.UpdatePayoutStatus(context.Background(), payoutID, "PAID", "TRF-0901", "", &paidAt))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no longer pending", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewPartnerRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(update).WithArgs("duplicate", sqlmock.AnyArg(), "", "CANCELLED", sqlmock.AnyArg(), payoutID, "PENDING").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		assert.ErrorIs(t, repo.UpdatePayoutStatus(context.Background(), payoutID, "CANCELLED", "", "duplicate", nil), models.ErrNotFound)
+	})
+}
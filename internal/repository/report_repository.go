@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"reforest/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// Revenue groupings accepted by SumRevenue.
+const (
+	RevenueByDay       = "day"
+	RevenueByMonth     = "month"
+	RevenueByType      = "type"
+	RevenueByReference = "reference"
+)
+
+var revenueGroupExprs = map[string]string{
+	RevenueByDay:       "to_char(transactions.created_at, 'YYYY-MM-DD')",
+	RevenueByMonth:     "to_char(transactions.created_at, 'YYYY-MM')",
+	RevenueByType:      "transactions.type",
+	RevenueByReference: "transactions.reference_id",
+}
+
+// RevenueRow totals the settled transactions sharing a group key and
+// currency. Label is filled in by the caller where the key needs resolving.
+type RevenueRow struct {
+	Key            string
+	Label          string
+	Currency       string
+	Count          int64
+	Amount         int64
+	DiscountAmount int64
+}
+
+// PaymentMethodRow totals the invoices paid with one payment method and
+// channel in a currency.
+type PaymentMethodRow struct {
+	Method    string
+	Channel   string
+	Currency  string
+	Count     int64
+	Amount    int64
+	FeeAmount int64
+}
+
+type CurrencyTotal struct {
+	Currency string
+	Count    int64
+	Amount   int64
+}
+
+type ReportRepository interface {
+	// SumRevenue totals settled ADOPT and CARE transactions created in
+	// [from, to), grouped by one of the RevenueBy groupings and currency.
+	SumRevenue(ctx context.Context, groupBy string, from, to time.Time) ([]RevenueRow, error)
+	ListPendingInvoices(ctx context.Context) ([]models.Transaction, error)
+	// ListJournalTransactions returns the transactions that may have
+	// accounting entries dated in [from, to): those created in the range and
+	// older ones whose payment changed since from. Transfers and top-ups
+	// held for review never reach the books and are left out.
+	ListJournalTransactions(ctx context.Context, from, to time.Time) ([]models.Transaction, error)
+	// SumPaymentMethods totals the invoices paid through the payment
+	// provider for transactions created in [from, to). Purchases paid from
+	// the wallet alone are left out.
+	SumPaymentMethods(ctx context.Context, from, to time.Time) ([]PaymentMethodRow, error)
+	SumWalletBalances(ctx context.Context) ([]CurrencyTotal, error)
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+func (r *reportRepository) SumRevenue(ctx context.Context, groupBy string, from, to time.Time) ([]RevenueRow, error) {
+	expr, ok := revenueGroupExprs[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown revenue grouping %q", models.ErrInvalidInput, groupBy)
+	}
+
+	var rows []RevenueRow
+	err := r.db.WithContext(ctx).
+		Table("transactions").
+		Select(expr+" AS key, transactions.currency, COUNT(*) AS count, SUM(transactions.amount) AS amount, SUM(transactions.discount_amount) AS discount_amount").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("payments.status = ? AND transactions.type IN ? AND transactions.created_at >= ? AND transactions.created_at < ?",
+			"SUCCESS", []string{"ADOPT", "CARE"}, from, to).
+		Group(expr + ", transactions.currency").
+		Order("key, transactions.currency").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// ListPendingInvoices returns the unpaid invoices still open at the payment
+// provider, soonest to expire first.
+func (r *reportRepository) ListPendingInvoices(ctx context.Context) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	err := r.db.WithContext(ctx).
+		Preload("Payment").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("payments.status = ? AND payments.payment_url <> ''", "PENDING").
+		Order("payments.expires_at asc").
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *reportRepository) ListJournalTransactions(ctx context.Context, from, to time.Time) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	err := r.db.WithContext(ctx).
+		Preload("Payment").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("transactions.type <> ? AND payments.status NOT IN ? AND transactions.created_at < ? AND (transactions.created_at >= ? OR payments.updated_at >= ?)",
+			"TRANSFER", []string{"REVIEW", "REJECTED"}, to, from, from).
+		Order("transactions.created_at, transactions.id").
+		Find(&txs).Error
+	return txs, err
+}
+
+func (r *reportRepository) SumPaymentMethods(ctx context.Context, from, to time.Time) ([]PaymentMethodRow, error) {
+	var rows []PaymentMethodRow
+	err := r.db.WithContext(ctx).
+		Table("transactions").
+		Select("payments.method, payments.channel, transactions.currency, COUNT(*) AS count, SUM(payments.amount) AS amount, SUM(payments.fee_amount) AS fee_amount").
+		Joins("JOIN payments ON payments.transaction_id = transactions.id").
+		Where("payments.status = ? AND payments.external_id <> '' AND transactions.created_at >= ? AND transactions.created_at < ?",
+			"SUCCESS", from, to).
+		Group("payments.method, payments.channel, transactions.currency").
+		Order("transactions.currency, amount desc").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// SumWalletBalances totals the balances owed to sponsors per wallet
+// currency, including funds held for unsettled payments. Count is the number
+// of wallets holding a balance.
+func (r *reportRepository) SumWalletBalances(ctx context.Context) ([]CurrencyTotal, error) {
+	held := r.db.Model(&models.BalanceHold{}).
+		Select("user_id, SUM(amount) AS amount").
+		Where("status = ?", "HELD").
+		Group("user_id")
+
+	var totals []CurrencyTotal
+	err := r.db.WithContext(ctx).
+		Table("profiles").
+		Select("profiles.currency, COUNT(*) AS count, SUM(profiles.balance + COALESCE(held.amount, 0)) AS amount").
+		Joins("LEFT JOIN (?) AS held ON held.user_id = profiles.id", held).
+		Where("profiles.balance + COALESCE(held.amount, 0) > 0").
+		Group("profiles.currency").
+		Order("profiles.currency").
+		Scan(&totals).Error
+	return totals, err
+}
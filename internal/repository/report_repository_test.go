@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumRevenue(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("by day", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		repo := NewReportRepository(db)
+
+		rows := sqlmock.NewRows([]string{"key", "currency", "count", "amount", "discount_amount"}).
+			AddRow("2026-01-02", "IDR", 3, int64(150000), int64(10000)).
+			AddRow("2026-01-05", "IDR", 1, int64(50000), int64(0))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT to_char(transactions.created_at, 'YYYY-MM-DD') AS key, transactions.currency, COUNT(*) AS count, SUM(transactions.amount) AS amount, SUM(transactions.discount_amount) AS discount_amount FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE payments.status = $1 AND transactions.type IN ($2,$3) AND transactions.created_at >= $4 AND transactions.created_at < $5 GROUP BY to_char(transactions.created_at, 'YYYY-MM-DD'), transactions.currency ORDER BY key, transactions.currency`)).
+			WithArgs("SUCCESS", "ADOPT", "CARE", from, to).
+			WillReturnRows(rows)
+
+		got, err := repo.SumRevenue(context.Background(), RevenueByDay, from, to)
+		assert.NoError(t, err)
+		assert.Equal(t, []RevenueRow{
+			{Key: "2026-01-02", Currency: "IDR", Count: 3, Amount: 150000, DiscountAmount: 10000},
+			{Key: "2026-01-05", Currency: "IDR", Count: 1, Amount: 50000},
+		}, got)
+	})
+
+	t.Run("unknown grouping", func(t *testing.T) {
+		db, _ := setupMockDB(t)
+		repo := NewReportRepository(db)
+
+		_, err := repo.SumRevenue(context.Background(), "week", from, to)
+		assert.ErrorIs(t, err, models.ErrInvalidInput)
+	})
+}
+
+func TestListPendingInvoices(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewReportRepository(db)
+	txID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transactions"."id","transactions"."user_id","transactions"."amount","transactions"."currency","transactions"."reference_id","transactions"."type","transactions"."original_amount","transactions"."original_currency","transactions"."exchange_rate","transactions"."voucher_code","transactions"."discount_amount","transactions"."direction","transactions"."counterparty_id","transactions"."created_at","transactions"."updated_at" FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE payments.status = $1 AND payments.payment_url <> '' ORDER BY payments.expires_at asc`)).
+		WithArgs("PENDING").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "currency", "type"}).AddRow(txID, int64(50000), "IDR", "DEPOSIT"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE "payments"."transaction_id" = $1`)).
+		WithArgs(txID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "amount", "status"}).AddRow(txID, int64(50000), "PENDING"))
+
+	txs, err := repo.ListPendingInvoices(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, "PENDING", txs[0].Payment.Status)
+}
+
+func TestListJournalTransactions(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewReportRepository(db)
+	txID := uuid.New()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "transactions"."id","transactions"."user_id","transactions"."amount","transactions"."currency","transactions"."reference_id","transactions"."type","transactions"."original_amount","transactions"."original_currency","transactions"."exchange_rate","transactions"."voucher_code","transactions"."discount_amount","transactions"."direction","transactions"."counterparty_id","transactions"."created_at","transactions"."updated_at" FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE transactions.type <> $1 AND payments.status NOT IN ($2,$3) AND transactions.created_at < $4 AND (transactions.created_at >= $5 OR payments.updated_at >= $6) ORDER BY transactions.created_at, transactions.id`)).
+		WithArgs("TRANSFER", "REVIEW", "REJECTED", to, from, from).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "currency", "type"}).AddRow(txID, int64(150000), "IDR", "CARE"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payments" WHERE "payments"."transaction_id" = $1`)).
+		WithArgs(txID).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "amount", "status"}).AddRow(txID, int64(150000), "PENDING"))
+
+	txs, err := repo.ListJournalTransactions(context.Background(), from, to)
+	assert.NoError(t, err)
+	assert.Len(t, txs, 1)
+	assert.Equal(t, "CARE", txs[0].Type)
+}
+
+func TestSumPaymentMethods(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewReportRepository(db)
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT payments.method, payments.channel, transactions.currency, COUNT(*) AS count, SUM(payments.amount) AS amount, SUM(payments.fee_amount) AS fee_amount FROM "transactions" JOIN payments ON payments.transaction_id = transactions.id WHERE payments.status = $1 AND payments.external_id <> '' AND transactions.created_at >= $2 AND transactions.created_at < $3 GROUP BY payments.method, payments.channel, transactions.currency ORDER BY transactions.currency, amount desc`)).
+		WithArgs("SUCCESS", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"method", "channel", "currency", "count", "amount", "fee_amount"}).
+			AddRow("VIRTUAL_ACCOUNT", "BCA", "IDR", 4, int64(600000), int64(17760)).
+			AddRow("QRIS", "QRIS", "IDR", 2, int64(100000), int64(700)))
+
+	rows, err := repo.SumPaymentMethods(context.Background(), from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, []PaymentMethodRow{
+		{Method: "VIRTUAL_ACCOUNT", Channel: "BCA", Currency: "IDR", Count: 4, Amount: 600000, FeeAmount: 17760},
+		{Method: "QRIS", Channel: "QRIS", Currency: "IDR", Count: 2, Amount: 100000, FeeAmount: 700},
+	}, rows)
+}
+
+func TestSumWalletBalances(t *testing.T) {
+	db, mock := setupMockDB(t)
+	repo := NewReportRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT profiles.currency, COUNT(*) AS count, SUM(profiles.balance + COALESCE(held.amount, 0)) AS amount FROM "profiles" LEFT JOIN (SELECT user_id, SUM(amount) AS amount FROM "balance_holds" WHERE status = $1 GROUP BY "user_id") AS held ON held.user_id = profiles.id WHERE profiles.balance + COALESCE(held.amount, 0) > 0 GROUP BY "profiles"."currency" ORDER BY profiles.currency`)).
+		WithArgs("HELD").
+		WillReturnRows(sqlmock.NewRows([]string{"currency", "count", "amount"}).
+			AddRow("IDR", 12, int64(2500000)).
+			AddRow("USD", 2, int64(4000)))
+
+	totals, err := repo.SumWalletBalances(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []CurrencyTotal{{Currency: "IDR", Count: 12, Amount: 2500000}, {Currency: "USD", Count: 2, Amount: 4000}}, totals)
+}
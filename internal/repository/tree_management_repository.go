@@ -2,12 +2,17 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"reforest/internal/models"
+	"reforest/pkg/money"
+	"regexp"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
@@ -16,25 +21,48 @@ const (
 	treeCollection    = "trees"
 	logCollection     = "logs"
 	intentCollection  = "adoption_intents"
+	outboxCollection  = "outbox"
+	giftCollection    = "gifts"
 )
 
 type TreeManagementRepository interface {
+	// WithTransaction runs fn in a multi-document transaction. Repository
+	// calls made with the context passed to fn take part in it.
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// EnqueueEvent stores an event in the outbox for the relay to publish.
+	EnqueueEvent(ctx context.Context, routingKey string, payload interface{}) error
+
 	CreateSpecies(ctx context.Context, species *models.Species) (*models.Species, error)
 	GetSpecies(ctx context.Context, id primitive.ObjectID) (*models.Species, error)
-	ListSpecies(ctx context.Context) ([]*models.Species, error)
+	ListSpecies(ctx context.Context, filter models.SpeciesFilter, opts models.ListOptions) ([]*models.Species, models.PageInfo, error)
 	UpdateSpecies(ctx context.Context, species *models.Species) (*models.Species, error)
 	DeleteSpecies(ctx context.Context, id primitive.ObjectID) error
 
 	CreatePlot(ctx context.Context, plot *models.Plot) (*models.Plot, error)
 	GetPlot(ctx context.Context, id primitive.ObjectID) (*models.Plot, error)
-	ListPlots(ctx context.Context) ([]*models.Plot, error)
+	ListPlots(ctx context.Context, filter models.PlotFilter, opts models.ListOptions) ([]*models.Plot, models.PageInfo, error)
+	// FindPlotsNear returns up to limit plots located within radius metres
+	// of point, nearest first.
+	FindPlotsNear(ctx context.Context, point *models.GeoPoint, radiusMeters float64, limit int) ([]*models.Plot, error)
+	// FindPlotsWithin returns up to limit plots whose boundary or, lacking
+	// one, location falls in area.
+	FindPlotsWithin(ctx context.Context, area *models.GeoPolygon, limit int) ([]*models.Plot, error)
+	// FindPlotsIntersecting returns the plots other than excludeID whose
+	// boundary touches or overlaps boundary.
+	FindPlotsIntersecting(ctx context.Context, boundary *models.GeoPolygon, excludeID primitive.ObjectID) ([]*models.Plot, error)
 	UpdatePlot(ctx context.Context, plot *models.Plot) (*models.Plot, error)
 	DeletePlot(ctx context.Context, id primitive.ObjectID) error
 
 	CreateTree(ctx context.Context, tree *models.Tree) (*models.Tree, error)
 	GetTree(ctx context.Context, id primitive.ObjectID) (*models.Tree, error)
-	ListTrees(ctx context.Context) ([]*models.Tree, error)
+	ListTrees(ctx context.Context, filter models.TreeFilter, opts models.ListOptions) ([]*models.Tree, models.PageInfo, error)
+	// CountTreesByMatchID counts the trees already planted for a campaign
+	// match.
+	CountTreesByMatchID(ctx context.Context, matchID string) (int64, error)
 	UpdateTree(ctx context.Context, tree *models.Tree) (*models.Tree, error)
+	// UpdateTreePlacement sets the location and grid position of a tree,
+	// leaving either unchanged when nil.
+	UpdateTreePlacement(ctx context.Context, id primitive.ObjectID, location *models.GeoPoint, position *models.GridPosition) error
 	DeleteTree(ctx context.Context, id primitive.ObjectID) error
 
 	CreateLog(ctx context.Context, log *models.LogEntry) (*models.LogEntry, error)
@@ -45,7 +73,19 @@ type TreeManagementRepository interface {
 
 	CreateAdoptionIntent(ctx context.Context, intent *models.AdoptionIntent) (*models.AdoptionIntent, error)
 	GetAdoptionIntent(ctx context.Context, id primitive.ObjectID) (*models.AdoptionIntent, error)
+	ListAdoptionIntentsByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*models.AdoptionIntent, error)
+	// ListAdoptionIntentsBySponsor returns a sponsor's intents with the given
+	// status, newest first.
+	ListAdoptionIntentsBySponsor(ctx context.Context, sponsorID, status string) ([]*models.AdoptionIntent, error)
 	UpdateAdoptionIntentStatus(ctx context.Context, id primitive.ObjectID, status string) error
+
+	CreateGift(ctx context.Context, gift *models.Gift) (*models.Gift, error)
+	GetGiftByIntentID(ctx context.Context, intentID primitive.ObjectID) (*models.Gift, error)
+	GetGiftByClaimCode(ctx context.Context, code string) (*models.Gift, error)
+	UpdateGiftStatus(ctx context.Context, id primitive.ObjectID, status string) error
+	// MarkGiftClaimed claims a SENT gift for recipientID. It returns
+	// ErrNotFound if the gift was claimed concurrently.
+	MarkGiftClaimed(ctx context.Context, id primitive.ObjectID, recipientID string, claimedAt time.Time) error
 }
 
 type treeManagementRepository struct {
@@ -56,6 +96,44 @@ func NewTreeManagementRepository(db *mongo.Database) TreeManagementRepository {
 	return &treeManagementRepository{db: db}
 }
 
+// EnsureTreeIndexes creates the indexes the tree repository's queries rely
+// on. It is safe to call on every start.
+func EnsureTreeIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection(plotCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}}},
+		{Keys: bson.D{{Key: "boundary", Value: "2dsphere"}}},
+	})
+	return err
+}
+
+func (r *treeManagementRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := r.db.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+func (r *treeManagementRepository) EnqueueEvent(ctx context.Context, routingKey string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	_, err = r.db.Collection(outboxCollection).InsertOne(ctx, &models.OutboxDocument{
+		RoutingKey:  routingKey,
+		Payload:     string(body),
+		AvailableAt: now,
+		CreatedAt:   now,
+	})
+	return err
+}
+
 func (r *treeManagementRepository) CreateSpecies(ctx context.Context, species *models.Species) (*models.Species, error) {
 	res, err := r.db.Collection(speciesCollection).InsertOne(ctx, species)
 	if err != nil {
@@ -80,18 +158,30 @@ func (r *treeManagementRepository) GetSpecies(ctx context.Context, id primitive.
 	return &species, nil
 }
 
-func (r *treeManagementRepository) ListSpecies(ctx context.Context) ([]*models.Species, error) {
-	cursor, err := r.db.Collection(speciesCollection).Find(ctx, bson.M{})
-	if err != nil {
-		return nil, err
+func (r *treeManagementRepository) ListSpecies(ctx context.Context, filter models.SpeciesFilter, opts models.ListOptions) ([]*models.Species, models.PageInfo, error) {
+	query := bson.M{}
+	price := bson.M{}
+	if filter.MinPrice > 0 {
+		price["$gte"] = filter.MinPrice
 	}
-	defer cursor.Close(ctx)
-
-	var speciesList []*models.Species
-	if err = cursor.All(ctx, &speciesList); err != nil {
-		return nil, err
+	if filter.MaxPrice > 0 {
+		price["$lte"] = filter.MaxPrice
 	}
-	return speciesList, nil
+	if len(price) > 0 {
+		query["price"] = price
+	}
+	switch filter.Currency {
+	case "":
+	case money.DefaultCurrency:
+		// species created before prices carried a currency are priced in IDR
+		query["currency"] = bson.M{"$in": bson.A{filter.Currency, "", nil}}
+	default:
+		query["currency"] = filter.Currency
+	}
+	if filter.MaxSpaceRequiredM2 > 0 {
+		query["space_required_m2"] = bson.M{"$lte": filter.MaxSpaceRequiredM2}
+	}
+	return findPage[models.Species](ctx, r.db.Collection(speciesCollection), query, opts)
 }
 
 func (r *treeManagementRepository) UpdateSpecies(ctx context.Context, species *models.Species) (*models.Species, error) {
@@ -100,6 +190,7 @@ func (r *treeManagementRepository) UpdateSpecies(ctx context.Context, species *m
 			"common_name":       species.CommonName,
 			"space_required_m2": species.SpaceRequiredM2,
 			"price":             species.Price,
+			"currency":          species.Currency,
 		},
 	}
 	res, err := r.db.Collection(speciesCollection).UpdateOne(ctx, bson.M{"_id": species.ID}, update)
@@ -147,6 +238,33 @@ func (r *treeManagementRepository) GetAdoptionIntent(ctx context.Context, id pri
 	return &intent, nil
 }
 
+func (r *treeManagementRepository) ListAdoptionIntentsByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*models.AdoptionIntent, error) {
+	cursor, err := r.db.Collection(intentCollection).Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var intents []*models.AdoptionIntent
+	if err = cursor.All(ctx, &intents); err != nil {
+		return nil, err
+	}
+	return intents, nil
+}
+
+func (r *treeManagementRepository) ListAdoptionIntentsBySponsor(ctx context.Context, sponsorID, status string) ([]*models.AdoptionIntent, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.db.Collection(intentCollection).Find(ctx, bson.M{"sponsor_id": sponsorID, "status": status}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var intents []*models.AdoptionIntent
+	if err = cursor.All(ctx, &intents); err != nil {
+		return nil, err
+	}
+	return intents, nil
+}
+
 func (r *treeManagementRepository) UpdateAdoptionIntentStatus(ctx context.Context, id primitive.ObjectID, status string) error {
 	_, err := r.db.Collection(intentCollection).UpdateOne(
 		ctx,
@@ -156,6 +274,62 @@ func (r *treeManagementRepository) UpdateAdoptionIntentStatus(ctx context.Contex
 	return err
 }
 
+func (r *treeManagementRepository) CreateGift(ctx context.Context, gift *models.Gift) (*models.Gift, error) {
+	res, err := r.db.Collection(giftCollection).InsertOne(ctx, gift)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, models.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	gift.ID = res.InsertedID.(primitive.ObjectID)
+	return gift, nil
+}
+
+func (r *treeManagementRepository) GetGiftByIntentID(ctx context.Context, intentID primitive.ObjectID) (*models.Gift, error) {
+	return r.findGift(ctx, bson.M{"intent_id": intentID})
+}
+
+func (r *treeManagementRepository) GetGiftByClaimCode(ctx context.Context, code string) (*models.Gift, error) {
+	return r.findGift(ctx, bson.M{"claim_code": code})
+}
+
+func (r *treeManagementRepository) findGift(ctx context.Context, filter bson.M) (*models.Gift, error) {
+	var gift models.Gift
+	err := r.db.Collection(giftCollection).FindOne(ctx, filter).Decode(&gift)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+	return &gift, nil
+}
+
+func (r *treeManagementRepository) UpdateGiftStatus(ctx context.Context, id primitive.ObjectID, status string) error {
+	_, err := r.db.Collection(giftCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": id},
+		bson.M{"$set": bson.M{"status": status}},
+	)
+	return err
+}
+
+func (r *treeManagementRepository) MarkGiftClaimed(ctx context.Context, id primitive.ObjectID, recipientID string, claimedAt time.Time) error {
+	res, err := r.db.Collection(giftCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": id, "status": "SENT"},
+		bson.M{"$set": bson.M{"status": "CLAIMED", "recipient_id": recipientID, "claimed_at": claimedAt}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
 func (r *treeManagementRepository) CreatePlot(ctx context.Context, plot *models.Plot) (*models.Plot, error) {
 	res, err := r.db.Collection(plotCollection).InsertOne(ctx, plot)
 	if err != nil {
@@ -180,8 +354,47 @@ func (r *treeManagementRepository) GetPlot(ctx context.Context, id primitive.Obj
 	return &plot, nil
 }
 
-func (r *treeManagementRepository) ListPlots(ctx context.Context) ([]*models.Plot, error) {
-	cursor, err := r.db.Collection(plotCollection).Find(ctx, bson.M{})
+func (r *treeManagementRepository) ListPlots(ctx context.Context, filter models.PlotFilter, opts models.ListOptions) ([]*models.Plot, models.PageInfo, error) {
+	query := bson.M{}
+	if filter.Location != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(filter.Location), Options: "i"}
+		query["$or"] = bson.A{
+			bson.M{"location_name": pattern},
+			bson.M{"address": pattern},
+		}
+	}
+	if filter.MinAvailableSpaceM2 > 0 {
+		query["available_space_m2"] = bson.M{"$gte": filter.MinAvailableSpaceM2}
+	}
+	return findPage[models.Plot](ctx, r.db.Collection(plotCollection), query, opts)
+}
+
+func (r *treeManagementRepository) FindPlotsNear(ctx context.Context, point *models.GeoPoint, radiusMeters float64, limit int) ([]*models.Plot, error) {
+	filter := bson.M{"location": bson.M{"$nearSphere": bson.M{
+		"$geometry":    point,
+		"$maxDistance": radiusMeters,
+	}}}
+	return r.findPlots(ctx, filter, options.Find().SetLimit(int64(limit)))
+}
+
+func (r *treeManagementRepository) FindPlotsWithin(ctx context.Context, area *models.GeoPolygon, limit int) ([]*models.Plot, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"boundary": bson.M{"$geoIntersects": bson.M{"$geometry": area}}},
+		bson.M{"boundary": nil, "location": bson.M{"$geoWithin": bson.M{"$geometry": area}}},
+	}}
+	return r.findPlots(ctx, filter, options.Find().SetLimit(int64(limit)))
+}
+
+func (r *treeManagementRepository) FindPlotsIntersecting(ctx context.Context, boundary *models.GeoPolygon, excludeID primitive.ObjectID) ([]*models.Plot, error) {
+	filter := bson.M{
+		"_id":      bson.M{"$ne": excludeID},
+		"boundary": bson.M{"$geoIntersects": bson.M{"$geometry": boundary}},
+	}
+	return r.findPlots(ctx, filter, options.Find())
+}
+
+func (r *treeManagementRepository) findPlots(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*models.Plot, error) {
+	cursor, err := r.db.Collection(plotCollection).Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -194,12 +407,25 @@ func (r *treeManagementRepository) ListPlots(ctx context.Context) ([]*models.Plo
 }
 
 func (r *treeManagementRepository) UpdatePlot(ctx context.Context, plot *models.Plot) (*models.Plot, error) {
-	update := bson.M{
-		"$set": bson.M{
-			"location_name":      plot.LocationName,
-			"address":            plot.Address,
-			"available_space_m2": plot.AvailableSpaceM2,
-		},
+	set := bson.M{
+		"location_name":      plot.LocationName,
+		"address":            plot.Address,
+		"available_space_m2": plot.AvailableSpaceM2,
+	}
+	unset := bson.M{}
+	if plot.Location != nil {
+		set["location"] = plot.Location
+	} else {
+		unset["location"] = ""
+	}
+	if plot.Boundary != nil {
+		set["boundary"] = plot.Boundary
+	} else {
+		unset["boundary"] = ""
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
 	}
 	res, err := r.db.Collection(plotCollection).UpdateOne(ctx, bson.M{"_id": plot.ID}, update)
 	if err != nil {
@@ -249,29 +475,57 @@ func (r *treeManagementRepository) GetTree(ctx context.Context, id primitive.Obj
 	return &tree, nil
 }
 
-func (r *treeManagementRepository) ListTrees(ctx context.Context) ([]*models.Tree, error) {
-	cursor, err := r.db.Collection(treeCollection).Find(ctx, bson.M{})
-	if err != nil {
-		return nil, err
+func (r *treeManagementRepository) ListTrees(ctx context.Context, filter models.TreeFilter, opts models.ListOptions) ([]*models.Tree, models.PageInfo, error) {
+	query := bson.M{}
+	if filter.SponsorID != "" {
+		query["sponsor_id"] = filter.SponsorID
 	}
-	defer cursor.Close(ctx)
-	var trees []*models.Tree
-	if err = cursor.All(ctx, &trees); err != nil {
-		return nil, err
+	if !filter.SpeciesID.IsZero() {
+		query["species_id"] = filter.SpeciesID
+	}
+	if !filter.PlotID.IsZero() {
+		query["plot_id"] = filter.PlotID
+	}
+	adopted := bson.M{}
+	if !filter.AdoptedFrom.IsZero() {
+		adopted["$gte"] = filter.AdoptedFrom
+	}
+	if !filter.AdoptedTo.IsZero() {
+		adopted["$lt"] = filter.AdoptedTo
 	}
-	return trees, nil
+	if len(adopted) > 0 {
+		query["adopted_at"] = adopted
+	}
+	return findPage[models.Tree](ctx, r.db.Collection(treeCollection), query, opts)
+}
+
+func (r *treeManagementRepository) CountTreesByMatchID(ctx context.Context, matchID string) (int64, error) {
+	return r.db.Collection(treeCollection).CountDocuments(ctx, bson.M{"match_id": matchID})
 }
 
 func (r *treeManagementRepository) UpdateTree(ctx context.Context, tree *models.Tree) (*models.Tree, error) {
-	update := bson.M{
-		"$set": bson.M{
-			"sponsor_id":            tree.SponsorID,
-			"species_id":            tree.SpeciesID,
-			"plot_id":               tree.PlotID,
-			"custom_name":           tree.CustomName,
-			"last_care_date":        tree.LastCareDate,
-			"adopted_at":            tree.AdoptedAt,
-		},
+	set := bson.M{
+		"sponsor_id":     tree.SponsorID,
+		"species_id":     tree.SpeciesID,
+		"plot_id":        tree.PlotID,
+		"custom_name":    tree.CustomName,
+		"last_care_date": tree.LastCareDate,
+		"adopted_at":     tree.AdoptedAt,
+	}
+	unset := bson.M{}
+	if tree.Location != nil {
+		set["location"] = tree.Location
+	} else {
+		unset["location"] = ""
+	}
+	if tree.Position != nil {
+		set["position"] = tree.Position
+	} else {
+		unset["position"] = ""
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
 	}
 	res, err := r.db.Collection(treeCollection).UpdateOne(ctx, bson.M{"_id": tree.ID}, update)
 	if err != nil {
@@ -286,6 +540,27 @@ func (r *treeManagementRepository) UpdateTree(ctx context.Context, tree *models.
 	return r.GetTree(ctx, tree.ID)
 }
 
+func (r *treeManagementRepository) UpdateTreePlacement(ctx context.Context, id primitive.ObjectID, location *models.GeoPoint, position *models.GridPosition) error {
+	set := bson.M{}
+	if location != nil {
+		set["location"] = location
+	}
+	if position != nil {
+		set["position"] = position
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	res, err := r.db.Collection(treeCollection).UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
+
 func (r *treeManagementRepository) DeleteTree(ctx context.Context, id primitive.ObjectID) error {
 	res, err := r.db.Collection(treeCollection).DeleteOne(ctx, bson.M{"_id": id})
 	if err != nil {
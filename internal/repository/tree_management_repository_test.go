@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -62,14 +64,103 @@ func TestListSpecies(t *testing.T) {
 		repo := NewTreeManagementRepository(mt.Client.Database("db"))
 		doc1 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "common_name", Value: "Jati"}, {Key: "space_required_m2", Value: 5.0}, {Key: "price", Value: 100}}
 		doc2 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "common_name", Value: "Mahoni"}, {Key: "space_required_m2", Value: 6.0}, {Key: "price", Value: 120}}
-		mt.AddMockResponses(mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, doc1, doc2))
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(2)}}),
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, doc1, doc2),
+		)
 
-		list, err := repo.ListSpecies(context.Background())
+		list, page, err := repo.ListSpecies(context.Background(), models.SpeciesFilter{}, models.ListOptions{PageSize: 50, SortBy: "common_name"})
 		if err != nil {
 			t.Fatalf("ListSpecies error: %v", err)
 		}
-		if len(list) != 2 {
-			t.Fatalf("expected 2 species, got %d", len(list))
+		if len(list) != 2 || page.TotalCount != 2 || page.NextPageToken != "" {
+			t.Fatalf("expected 2 species on one page, got %d, %+v", len(list), page)
+		}
+	})
+
+	mt.Run("next page", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		lastID := primitive.NewObjectID()
+		doc1 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "common_name", Value: "Jati"}, {Key: "price", Value: int32(100)}}
+		doc2 := bson.D{{Key: "_id", Value: lastID}, {Key: "common_name", Value: "Mahoni"}, {Key: "price", Value: int32(120)}}
+		doc3 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "common_name", Value: "Trembesi"}, {Key: "price", Value: int32(150)}}
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(5)}}),
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, doc1, doc2, doc3),
+		)
+
+		opts := models.ListOptions{PageSize: 2, SortBy: "price"}
+		list, page, err := repo.ListSpecies(context.Background(), models.SpeciesFilter{MinPrice: 100}, opts)
+		if err != nil {
+			t.Fatalf("ListSpecies error: %v", err)
+		}
+		if len(list) != 2 || page.TotalCount != 5 || page.NextPageToken == "" {
+			t.Fatalf("expected a full page with a token, got %d, %+v", len(list), page)
+		}
+		mt.GetStartedEvent() // the count
+		if limit, _ := mt.GetStartedEvent().Command.Lookup("limit").AsInt64OK(); limit != 3 {
+			t.Fatalf("expected one extra result fetched to detect the next page, got limit %d", limit)
+		}
+
+		after, err := decodePageToken(page.NextPageToken, opts)
+		if err != nil || after.ID != lastID || after.Value.Int32() != 120 {
+			t.Fatalf("token should resume after the last result, got %+v, %v", after, err)
+		}
+		if _, err := decodePageToken(page.NextPageToken, models.ListOptions{SortBy: "price", Descending: true}); !errors.Is(err, models.ErrInvalidInput) {
+			t.Fatalf("a token must not be reused with another sort, got %v", err)
+		}
+	})
+
+	mt.Run("document missing the sort field", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		lastID := primitive.NewObjectID()
+		doc1 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "common_name", Value: "Jati"}}
+		doc2 := bson.D{{Key: "_id", Value: lastID}, {Key: "common_name", Value: "Mahoni"}}
+		doc3 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "common_name", Value: "Trembesi"}, {Key: "price", Value: int32(150)}}
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(3)}}),
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, doc1, doc2, doc3),
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(3)}}),
+			mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, doc3),
+		)
+
+		opts := models.ListOptions{PageSize: 2, SortBy: "price"}
+		_, page, err := repo.ListSpecies(context.Background(), models.SpeciesFilter{}, opts)
+		if err != nil {
+			t.Fatalf("ListSpecies error: %v", err)
+		}
+		after, err := decodePageToken(page.NextPageToken, opts)
+		if err != nil || after.ID != lastID || after.Value.Type != bson.TypeNull {
+			t.Fatalf("token should resume after a null price, got %+v, %v", after, err)
+		}
+
+		opts.PageToken = page.NextPageToken
+		list, _, err := repo.ListSpecies(context.Background(), models.SpeciesFilter{}, opts)
+		if err != nil || len(list) != 1 {
+			t.Fatalf("expected the priced species on the next page, got %d, %v", len(list), err)
+		}
+		mt.GetStartedEvent()
+		mt.GetStartedEvent()
+		mt.GetStartedEvent() // the count
+		filter := mt.GetStartedEvent().Command.Lookup("filter").Document().String()
+		// the rest of the unpriced species, then every priced one
+		for _, clause := range []string{
+			`{"price": null,"_id": {"$gt": {"$oid":"` + lastID.Hex() + `"}}}`,
+			`{"price": {"$ne": null}}`,
+		} {
+			if !strings.Contains(filter, clause) {
+				t.Fatalf("expected %s in the filter, got %s", clause, filter)
+			}
+		}
+	})
+
+	mt.Run("malformed token", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "db.species", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(5)}}))
+
+		_, _, err := repo.ListSpecies(context.Background(), models.SpeciesFilter{}, models.ListOptions{PageSize: 2, PageToken: "not-a-token", SortBy: "price"})
+		if !errors.Is(err, models.ErrInvalidInput) {
+			t.Fatalf("expected ErrInvalidInput, got %v", err)
 		}
 	})
 }
@@ -82,15 +173,19 @@ func TestUpdateSpecies(t *testing.T) {
 		id := primitive.NewObjectID()
 		mt.AddMockResponses(
 			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}, bson.E{Key: "nModified", Value: int32(1)}, bson.E{Key: "ok", Value: 1}),
-			mtest.CreateCursorResponse(1, "db.species", mtest.FirstBatch, bson.D{{Key: "_id", Value: id}, {Key: "common_name", Value: "Jati"}, {Key: "space_required_m2", Value: 5.0}, {Key: "price", Value: 100}}),
+			mtest.CreateCursorResponse(1, "db.species", mtest.FirstBatch, bson.D{{Key: "_id", Value: id}, {Key: "common_name", Value: "Jati"}, {Key: "space_required_m2", Value: 5.0}, {Key: "price", Value: 100}, {Key: "currency", Value: "USD"}}),
 		)
 
-		got, err := repo.UpdateSpecies(context.Background(), &models.Species{ID: id, CommonName: "Jati"})
+		got, err := repo.UpdateSpecies(context.Background(), &models.Species{ID: id, CommonName: "Jati", Price: 100, Currency: "USD"})
 		if err != nil {
 			t.Fatalf("UpdateSpecies error: %v", err)
 		}
-		if got.ID != id {
-			t.Fatalf("expected id %v, got %v", id, got.ID)
+		if got.ID != id || got.Currency != "USD" {
+			t.Fatalf("expected USD species %v, got %+v", id, got)
+		}
+		set := mt.GetStartedEvent().Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u", "$set")
+		if currency, _ := set.Document().Lookup("currency").StringValueOK(); currency != "USD" {
+			t.Fatalf("currency should be written, got update %v", set)
 		}
 	})
 
@@ -152,9 +247,12 @@ func TestListPlots(t *testing.T) {
 		repo := NewTreeManagementRepository(mt.Client.Database("db"))
 		doc1 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "location_name", Value: "A"}, {Key: "address", Value: "Addr"}, {Key: "available_space_m2", Value: 10}}
 		doc2 := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "location_name", Value: "B"}, {Key: "address", Value: "Addr2"}, {Key: "available_space_m2", Value: 20}}
-		mt.AddMockResponses(mtest.CreateCursorResponse(0, "db.plots", mtest.FirstBatch, doc1, doc2))
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "db.plots", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(2)}}),
+			mtest.CreateCursorResponse(0, "db.plots", mtest.FirstBatch, doc1, doc2),
+		)
 
-		list, err := repo.ListPlots(context.Background())
+		list, _, err := repo.ListPlots(context.Background(), models.PlotFilter{}, models.ListOptions{PageSize: 50, SortBy: "location_name"})
 		if err != nil {
 			t.Fatalf("ListPlots returned error: %v", err)
 		}
@@ -263,6 +361,27 @@ func TestGetAdoptionIntent(t *testing.T) {
 	})
 }
 
+func TestListAdoptionIntentsByIDs(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("returns list", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		id1, id2 := primitive.NewObjectID(), primitive.NewObjectID()
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "db.adoption_intents", mtest.FirstBatch,
+			bson.D{{Key: "_id", Value: id1}, {Key: "species_id", Value: primitive.NewObjectID()}},
+			bson.D{{Key: "_id", Value: id2}, {Key: "species_id", Value: primitive.NewObjectID()}},
+		))
+
+		intents, err := repo.ListAdoptionIntentsByIDs(context.Background(), []primitive.ObjectID{id1, id2})
+		if err != nil {
+			t.Fatalf("ListAdoptionIntentsByIDs error: %v", err)
+		}
+		if len(intents) != 2 || intents[0].ID != id1 {
+			t.Fatalf("unexpected intents: %+v", intents)
+		}
+	})
+}
+
 func TestUpdateAdoptionIntentStatus(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 
@@ -276,6 +395,55 @@ func TestUpdateAdoptionIntentStatus(t *testing.T) {
 	})
 }
 
+func TestGiftOperations(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("get by claim code", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		id := primitive.NewObjectID()
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, "db.gifts", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: id},
+			{Key: "claim_code", Value: "GIFT-ABCD-EFGH"},
+			{Key: "status", Value: "SENT"},
+		}))
+
+		gift, err := repo.GetGiftByClaimCode(context.Background(), "GIFT-ABCD-EFGH")
+		if err != nil {
+			t.Fatalf("GetGiftByClaimCode error: %v", err)
+		}
+		if gift.ID != id || gift.Status != "SENT" {
+			t.Fatalf("unexpected gift: %+v", gift)
+		}
+	})
+
+	mt.Run("get by intent not found", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "db.gifts", mtest.FirstBatch))
+
+		if _, err := repo.GetGiftByIntentID(context.Background(), primitive.NewObjectID()); err != models.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	mt.Run("mark claimed", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(1)}, bson.E{Key: "ok", Value: 1}))
+
+		if err := repo.MarkGiftClaimed(context.Background(), primitive.NewObjectID(), "recipient-1", time.Now()); err != nil {
+			t.Fatalf("MarkGiftClaimed error: %v", err)
+		}
+	})
+
+	mt.Run("mark claimed twice", func(mt *mtest.T) {
+		repo := NewTreeManagementRepository(mt.Client.Database("db"))
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: int32(0)}, bson.E{Key: "ok", Value: 1}))
+
+		if err := repo.MarkGiftClaimed(context.Background(), primitive.NewObjectID(), "recipient-1", time.Now()); err != models.ErrNotFound {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
 func TestGetTree_NotFound(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 
@@ -336,9 +504,12 @@ func TestTreeOperations(t *testing.T) {
 	mt.Run("list trees", func(mt *mtest.T) {
 		repo := NewTreeManagementRepository(mt.Client.Database("db"))
 		doc := bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "custom_name", Value: "T"}, {Key: "plot_id", Value: primitive.NewObjectID()}, {Key: "species_id", Value: primitive.NewObjectID()}}
-		mt.AddMockResponses(mtest.CreateCursorResponse(0, "db.trees", mtest.FirstBatch, doc))
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "db.trees", mtest.FirstBatch, bson.D{{Key: "n", Value: int32(1)}}),
+			mtest.CreateCursorResponse(0, "db.trees", mtest.FirstBatch, doc),
+		)
 
-		list, err := repo.ListTrees(context.Background())
+		list, _, err := repo.ListTrees(context.Background(), models.TreeFilter{}, models.ListOptions{PageSize: 50, SortBy: "adopted_at"})
 		if err != nil || len(list) != 1 {
 			t.Fatalf("ListTrees failed: %v", err)
 		}
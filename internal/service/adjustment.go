@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+// RequestBalanceAdjustment records an admin's request to correct a wallet.
+// Nothing is posted until a different admin approves it. The amount is in
+// minor units of the wallet currency; negative amounts debit the wallet.
+func (s *financeService) RequestBalanceAdjustment(ctx context.Context, requesterID uuid.UUID, req *pb.BalanceAdjustmentRequest) (*models.BalanceAdjustment, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid user id", models.ErrInvalidInput)
+	}
+	if req.Amount == 0 {
+		return nil, fmt.Errorf("%w: amount must not be zero", models.ErrInvalidInput)
+	}
+	reason := strings.TrimSpace(req.Reason)
+	ticket := strings.TrimSpace(req.TicketReference)
+	if reason == "" || ticket == "" {
+		return nil, fmt.Errorf("%w: reason and ticket reference are required", models.ErrInvalidInput)
+	}
+	if userID == requesterID {
+		return nil, fmt.Errorf("%w: cannot adjust your own wallet", models.ErrInvalidInput)
+	}
+
+	_, currency, err := s.repo.GetUserBalance(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user wallet: %w", err)
+	}
+
+	adjustment := &models.BalanceAdjustment{
+		UserID:          userID,
+		Amount:          req.Amount,
+		Currency:        currency,
+		Reason:          reason,
+		TicketReference: ticket,
+		Status:          "PENDING",
+		RequestedBy:     requesterID,
+	}
+	if err := s.repo.CreateBalanceAdjustment(ctx, adjustment); err != nil {
+		return nil, err
+	}
+	return adjustment, nil
+}
+
+// ApproveBalanceAdjustment posts a pending adjustment to the wallet as an
+// ADJUSTMENT transaction. The approver must be neither the admin who
+// requested it nor the wallet's owner. A debit fails with
+// ErrInsufficientBalance rather than overdrawing.
+func (s *financeService) ApproveBalanceAdjustment(ctx context.Context, approverID, adjustmentID uuid.UUID, note string) (*models.BalanceAdjustment, error) {
+	adjustment, err := s.pendingAdjustment(ctx, adjustmentID)
+	if err != nil {
+		return nil, err
+	}
+	if adjustment.RequestedBy == approverID {
+		return nil, fmt.Errorf("%w: an adjustment must be approved by a different admin", models.ErrInvalidInput)
+	}
+	if adjustment.UserID == approverID {
+		return nil, fmt.Errorf("%w: cannot approve an adjustment to your own wallet", models.ErrInvalidInput)
+	}
+
+	amount, direction := adjustment.Amount, "IN"
+	if amount < 0 {
+		amount, direction = -amount, "OUT"
+	}
+	tx := &models.Transaction{
+		ID:          uuid.New(),
+		UserID:      adjustment.UserID,
+		Amount:      amount,
+		Currency:    adjustment.Currency,
+		Type:        "ADJUSTMENT",
+		Direction:   direction,
+		ReferenceID: adjustment.ID.String(),
+		Payment:     models.Payment{Amount: amount, Status: "SUCCESS"},
+	}
+	err = s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		if err := repo.DecideBalanceAdjustment(ctx, adjustment.ID, "APPROVED", approverID, note, &tx.ID); err != nil {
+			return err
+		}
+		if direction == "OUT" {
+			if err := repo.DebitWallet(ctx, tx.UserID, amount, tx.Currency); err != nil {
+				return err
+			}
+		} else if err := repo.UpdateWalletBalance(ctx, tx.UserID, amount, tx.Currency); err != nil {
+			return err
+		}
+		return repo.CreateTransaction(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	decideAdjustment(adjustment, "APPROVED", approverID, note)
+	adjustment.TransactionID = &tx.ID
+
+	data := walletNoticeData{Reason: adjustment.Reason}
+	if direction == "OUT" {
+		data.Debited = money.Format(amount, tx.Currency)
+	} else {
+		data.Credited = money.Format(amount, tx.Currency)
+	}
+	s.notify(ctx, noticeBalanceAdjusted, tx, data, nil)
+	return adjustment, nil
+}
+
+// RejectBalanceAdjustment closes a pending adjustment without touching the
+// wallet. Requesters may withdraw their own requests this way.
+func (s *financeService) RejectBalanceAdjustment(ctx context.Context, deciderID, adjustmentID uuid.UUID, note string) (*models.BalanceAdjustment, error) {
+	adjustment, err := s.pendingAdjustment(ctx, adjustmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.DecideBalanceAdjustment(ctx, adjustment.ID, "REJECTED", deciderID, note, nil); err != nil {
+		return nil, err
+	}
+	decideAdjustment(adjustment, "REJECTED", deciderID, note)
+	return adjustment, nil
+}
+
+func (s *financeService) ListBalanceAdjustments(ctx context.Context, status string) ([]models.BalanceAdjustment, error) {
+	return s.repo.ListBalanceAdjustments(ctx, strings.ToUpper(strings.TrimSpace(status)))
+}
+
+func (s *financeService) pendingAdjustment(ctx context.Context, adjustmentID uuid.UUID) (*models.BalanceAdjustment, error) {
+	adjustment, err := s.repo.GetBalanceAdjustment(ctx, adjustmentID)
+	if err != nil {
+		return nil, err
+	}
+	if adjustment.Status != "PENDING" {
+		return nil, fmt.Errorf("%w: adjustment is already %s", models.ErrInvalidInput, adjustment.Status)
+	}
+	return adjustment, nil
+}
+
+func decideAdjustment(adjustment *models.BalanceAdjustment, status string, deciderID uuid.UUID, note string) {
+	now := time.Now()
+	adjustment.Status = status
+	adjustment.DecidedBy = &deciderID
+	adjustment.DecisionNote = note
+	adjustment.DecidedAt = &now
+}
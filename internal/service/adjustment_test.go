@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+func TestFinanceService_RequestBalanceAdjustment(t *testing.T) {
+	repo := &mockFinanceRepo{}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	requesterID, userID := uuid.New(), uuid.New()
+	adjustment, err := svc.RequestBalanceAdjustment(context.Background(), requesterID, &pb.BalanceAdjustmentRequest{
+		UserId:          userID.String(),
+		Amount:          -25000,
+		Reason:          " double credited top up ",
+		TicketReference: "SUP-1024",
+	})
+	if err != nil {
+		t.Fatalf("RequestBalanceAdjustment() error = %v", err)
+	}
+
+	if repo.createdAdjustment != adjustment || adjustment.Status != "PENDING" || adjustment.RequestedBy != requesterID {
+		t.Fatalf("expected a pending adjustment by the requester, got %+v", adjustment)
+	}
+	if adjustment.UserID != userID || adjustment.Amount != -25000 || adjustment.Currency != "IDR" || adjustment.Reason != "double credited top up" {
+		t.Fatalf("unexpected adjustment: %+v", adjustment)
+	}
+	if repo.balanceDelta != 0 || repo.debitAmount != 0 || repo.createTx != nil {
+		t.Fatalf("nothing may be posted before approval")
+	}
+}
+
+func TestFinanceService_RequestBalanceAdjustment_Invalid(t *testing.T) {
+	requesterID := uuid.New()
+	tests := []struct {
+		name string
+		req  *pb.BalanceAdjustmentRequest
+	}{
+		{"bad user", &pb.BalanceAdjustmentRequest{UserId: "nope", Amount: 100, Reason: "r", TicketReference: "t"}},
+		{"zero amount", &pb.BalanceAdjustmentRequest{UserId: uuid.NewString(), Reason: "r", TicketReference: "t"}},
+		{"no reason", &pb.BalanceAdjustmentRequest{UserId: uuid.NewString(), Amount: 100, Reason: " ", TicketReference: "t"}},
+		{"no ticket", &pb.BalanceAdjustmentRequest{UserId: uuid.NewString(), Amount: 100, Reason: "r"}},
+		{"own wallet", &pb.BalanceAdjustmentRequest{UserId: requesterID.String(), Amount: 100, Reason: "r", TicketReference: "t"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockFinanceRepo{}
+			svc := NewFinanceService(repo, "x", nil, nil)
+
+			if _, err := svc.RequestBalanceAdjustment(context.Background(), requesterID, tt.req); !errors.Is(err, models.ErrInvalidInput) {
+				t.Fatalf("RequestBalanceAdjustment() error = %v, want ErrInvalidInput", err)
+			}
+			if repo.createdAdjustment != nil {
+				t.Fatalf("no adjustment should be stored")
+			}
+		})
+	}
+}
+
+func testBalanceAdjustment(amount int64) *models.BalanceAdjustment {
+	return &models.BalanceAdjustment{
+		ID:              uuid.New(),
+		UserID:          uuid.New(),
+		Amount:          amount,
+		Currency:        "IDR",
+		Reason:          "goodwill credit",
+		TicketReference: "SUP-1024",
+		Status:          "PENDING",
+		RequestedBy:     uuid.New(),
+	}
+}
+
+func TestFinanceService_ApproveBalanceAdjustment_Credit(t *testing.T) {
+	adjustment := testBalanceAdjustment(15000)
+	repo := &mockFinanceRepo{adjustmentByID: adjustment, getEmail: "sponsor@example.com", getEmailBalanceVal: 65000}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	approverID := uuid.New()
+	approved, err := svc.ApproveBalanceAdjustment(context.Background(), approverID, adjustment.ID, "ok")
+	if err != nil {
+		t.Fatalf("ApproveBalanceAdjustment() error = %v", err)
+	}
+
+	tx := repo.createTx
+	if tx == nil || tx.Type != "ADJUSTMENT" || tx.Direction != "IN" || tx.Amount != 15000 || tx.ReferenceID != adjustment.ID.String() {
+		t.Fatalf("expected an incoming ADJUSTMENT transaction, got %+v", tx)
+	}
+	if repo.balanceUserID != adjustment.UserID || repo.balanceDelta != 15000 {
+		t.Fatalf("wallet should be credited 15000, got %d", repo.balanceDelta)
+	}
+	if repo.adjustmentStatus != "APPROVED" || repo.adjustmentDecider != approverID || repo.adjustmentTxID == nil || *repo.adjustmentTxID != tx.ID {
+		t.Fatalf("adjustment should be approved with its transaction, got %q", repo.adjustmentStatus)
+	}
+	if approved.DecidedBy == nil || *approved.DecidedBy != approverID || approved.TransactionID == nil || approved.DecidedAt == nil {
+		t.Fatalf("audit trail missing from %+v", approved)
+	}
+	if sender.subject != "Penyesuaian Saldo" || !strings.Contains(sender.body, "bertambah IDR 15.000") || !strings.Contains(sender.body, "goodwill credit") {
+		t.Fatalf("expected an adjustment mail, got %q: %q", sender.subject, sender.body)
+	}
+}
+
+func TestFinanceService_ApproveBalanceAdjustment_Debit(t *testing.T) {
+	adjustment := testBalanceAdjustment(-25000)
+	repo := &mockFinanceRepo{adjustmentByID: adjustment}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if _, err := svc.ApproveBalanceAdjustment(context.Background(), uuid.New(), adjustment.ID, ""); err != nil {
+		t.Fatalf("ApproveBalanceAdjustment() error = %v", err)
+	}
+	if repo.debitUserID != adjustment.UserID || repo.debitAmount != 25000 || repo.balanceDelta != 0 {
+		t.Fatalf("wallet should be debited 25000, got %d", repo.debitAmount)
+	}
+	if repo.createTx == nil || repo.createTx.Direction != "OUT" || repo.createTx.Amount != 25000 {
+		t.Fatalf("expected an outgoing ADJUSTMENT transaction, got %+v", repo.createTx)
+	}
+}
+
+func TestFinanceService_ApproveBalanceAdjustment_InsufficientBalance(t *testing.T) {
+	adjustment := testBalanceAdjustment(-25000)
+	repo := &mockFinanceRepo{adjustmentByID: adjustment, debitErr: models.ErrInsufficientBalance}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if _, err := svc.ApproveBalanceAdjustment(context.Background(), uuid.New(), adjustment.ID, ""); !errors.Is(err, models.ErrInsufficientBalance) {
+		t.Fatalf("ApproveBalanceAdjustment() error = %v, want ErrInsufficientBalance", err)
+	}
+	if adjustment.Status != "PENDING" {
+		t.Fatalf("adjustment should stay pending, got %q", adjustment.Status)
+	}
+}
+
+func TestFinanceService_ApproveBalanceAdjustment_SameAdmin(t *testing.T) {
+	adjustment := testBalanceAdjustment(15000)
+	repo := &mockFinanceRepo{adjustmentByID: adjustment}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if _, err := svc.ApproveBalanceAdjustment(context.Background(), adjustment.RequestedBy, adjustment.ID, ""); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("ApproveBalanceAdjustment() error = %v, want ErrInvalidInput", err)
+	}
+	if repo.adjustmentStatus != "" || repo.createTx != nil {
+		t.Fatalf("requester must not be able to approve their own adjustment")
+	}
+}
+
+func TestFinanceService_ApproveBalanceAdjustment_OwnWallet(t *testing.T) {
+	adjustment := testBalanceAdjustment(15000)
+	repo := &mockFinanceRepo{adjustmentByID: adjustment}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if _, err := svc.ApproveBalanceAdjustment(context.Background(), adjustment.UserID, adjustment.ID, ""); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("ApproveBalanceAdjustment() error = %v, want ErrInvalidInput", err)
+	}
+	if repo.adjustmentStatus != "" || repo.createTx != nil || repo.balanceDelta != 0 {
+		t.Fatalf("an admin must not be able to approve a credit to their own wallet")
+	}
+}
+
+func TestFinanceService_RejectBalanceAdjustment(t *testing.T) {
+	adjustment := testBalanceAdjustment(15000)
+	repo := &mockFinanceRepo{adjustmentByID: adjustment}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	rejected, err := svc.RejectBalanceAdjustment(context.Background(), adjustment.RequestedBy, adjustment.ID, "withdrawn")
+	if err != nil {
+		t.Fatalf("RejectBalanceAdjustment() error = %v", err)
+	}
+	if repo.adjustmentStatus != "REJECTED" || repo.adjustmentTxID != nil || rejected.DecisionNote != "withdrawn" {
+		t.Fatalf("adjustment should be rejected, got %q", repo.adjustmentStatus)
+	}
+	if repo.createTx != nil || repo.balanceDelta != 0 {
+		t.Fatalf("a rejected adjustment must not touch the wallet")
+	}
+}
+
+func TestFinanceService_DecideBalanceAdjustment_AlreadyDecided(t *testing.T) {
+	adjustment := testBalanceAdjustment(15000)
+	adjustment.Status = "APPROVED"
+	repo := &mockFinanceRepo{adjustmentByID: adjustment}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if _, err := svc.RejectBalanceAdjustment(context.Background(), uuid.New(), adjustment.ID, ""); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("RejectBalanceAdjustment() error = %v, want ErrInvalidInput", err)
+	}
+	if repo.adjustmentStatus != "" {
+		t.Fatalf("decided adjustment must be left alone")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"reforest/internal/models"
 	"reforest/internal/repository"
+	"reforest/pkg/money"
 	"reforest/pkg/pb"
 	"reforest/pkg/utils"
 	"time"
@@ -17,6 +18,7 @@ import (
 type AuthService interface {
 	Register(ctx context.Context, req *pb.RegisterRequest) (*models.User, error)
 	Login(ctx context.Context, req *pb.LoginRequest) (string, *models.User, error)
+	GetUser(ctx context.Context, id uuid.UUID) (*models.User, error)
 }
 
 type authService struct {
@@ -34,6 +36,13 @@ func NewAuthService(repo repository.AuthRepository, jwt *utils.JWTProvider, emai
 }
 
 func (s *authService) Register(ctx context.Context, req *pb.RegisterRequest) (*models.User, error) {
+	// The wallet currency is fixed at sign-up; every balance movement must
+	// match it.
+	currency, err := money.Normalize(req.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, models.ErrInternal
@@ -57,6 +66,7 @@ func (s *authService) Register(ctx context.Context, req *pb.RegisterRequest) (*m
 			DateOfBirth: dob,
 			Age:         age,
 			Balance:     0,
+			Currency:    currency,
 		},
 	}
 
@@ -92,3 +102,7 @@ func (s *authService) Login(ctx context.Context, req *pb.LoginRequest) (string,
 	}
 	return token, user, nil
 }
+
+func (s *authService) GetUser(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	return s.repo.GetByID(ctx, id)
+}
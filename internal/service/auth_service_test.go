@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"reforest/internal/models"
@@ -20,17 +21,25 @@ type mockAuthRepo struct {
 }
 
 type mockEmailSender struct {
-	to      string
-	subject string
-	body    string
-	err     error
+	to          string
+	subject     string
+	body        string
+	attachments []Attachment
+	recipients  []string
+	err         error
 }
 
 func (m *mockEmailSender) Send(to, subject, body string) error {
 	m.to, m.subject, m.body = to, subject, body
+	m.recipients = append(m.recipients, to)
 	return m.err
 }
 
+func (m *mockEmailSender) SendWithAttachments(to, subject, body string, attachments ...Attachment) error {
+	m.attachments = attachments
+	return m.Send(to, subject, body)
+}
+
 func (m *mockAuthRepo) CreateUser(ctx context.Context, user *models.User) (*models.User, error) {
 	m.createdUser = user
 	return user, m.createErr
@@ -40,6 +49,10 @@ func (m *mockAuthRepo) GetByEmail(ctx context.Context, email string) (*models.Us
 	return m.getUser, m.getErr
 }
 
+func (m *mockAuthRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	return m.getUser, m.getErr
+}
+
 func TestAuthService_Register_Success(t *testing.T) {
 	repo := &mockAuthRepo{}
 	jwtProvider := utils.NewJWTProvider("secret")
@@ -75,11 +88,32 @@ func TestAuthService_Register_Success(t *testing.T) {
 		t.Fatalf("Profile ID should match User ID")
 	}
 
+	if user.Profile.Currency != "IDR" {
+		t.Fatalf("wallet currency should default to IDR, got %q", user.Profile.Currency)
+	}
+
 	if emailSender.to != req.Email {
 		t.Fatalf("expected welcome email sent to %s, got %s", req.Email, emailSender.to)
 	}
 }
 
+func TestAuthService_Register_UnsupportedCurrency(t *testing.T) {
+	repo := &mockAuthRepo{}
+	svc := NewAuthService(repo, utils.NewJWTProvider("secret"), nil)
+
+	_, err := svc.Register(context.Background(), &pb.RegisterRequest{
+		Email:    "user@example.com",
+		Password: "Password1",
+		Currency: "XYZ",
+	})
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+	if repo.createdUser != nil {
+		t.Fatalf("user should not be created")
+	}
+}
+
 func TestAuthService_Login_Success(t *testing.T) {
 	repo := &mockAuthRepo{}
 	jwtProvider := utils.NewJWTProvider("secret")
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+	"reforest/pkg/pb"
+	"reforest/pkg/pdf"
+
+	"github.com/google/uuid"
+)
+
+type CertificateService interface {
+	IssueCertificate(ctx context.Context, userID uuid.UUID, req *pb.CertificateRequest) (*models.Document, error)
+	VerifyCertificate(ctx context.Context, code string) (*models.DonationCertificate, bool, error)
+}
+
+type certificateService struct {
+	repo       repository.CertificateRepository
+	signingKey []byte
+	verifyURL  string
+	now        func() time.Time
+}
+
+func NewCertificateService(repo repository.CertificateRepository, signingKey, publicAPIURL string) CertificateService {
+	return &certificateService{
+		repo:       repo,
+		signingKey: []byte(signingKey),
+		verifyURL:  strings.TrimRight(publicAPIURL, "/") + "/certificates/verify/",
+		now:        time.Now,
+	}
+}
+
+func (s *certificateService) IssueCertificate(ctx context.Context, userID uuid.UUID, req *pb.CertificateRequest) (*models.Document, error) {
+	year := int(req.Year)
+	if year < 2000 || year >= s.now().Year() {
+		return nil, fmt.Errorf("%w: certificates can only be issued for completed years", models.ErrInvalidInput)
+	}
+
+	existing, err := s.repo.GetCertificateByUserAndYear(ctx, userID, year)
+	if err == nil {
+		return s.render(existing), nil
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	totals, err := s.repo.GetDonationTotals(ctx, userID, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+	if totals.TransactionCount == 0 {
+		return nil, fmt.Errorf("%w: no adoption or care donations recorded in %d", models.ErrInvalidInput, year)
+	}
+
+	name, err := s.repo.GetRecipientName(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipient name: %w", err)
+	}
+
+	cert := &models.DonationCertificate{
+		UserID:           userID,
+		Year:             year,
+		RecipientName:    name,
+		OrganizationName: strings.TrimSpace(req.OrganizationName),
+		TaxID:            strings.TrimSpace(req.TaxId),
+		Currency:         totals.Currency,
+		AdoptTotal:       totals.AdoptTotal,
+		CareTotal:        totals.CareTotal,
+		TotalAmount:      totals.AdoptTotal + totals.CareTotal,
+		TransactionCount: totals.TransactionCount,
+		IssuedAt:         s.now().UTC().Truncate(time.Second),
+	}
+
+	if err := s.repo.CreateCertificate(ctx, cert, s.sign); err != nil {
+		if errors.Is(err, models.ErrAlreadyExists) {
+			// Issued concurrently by another request; return that one.
+			existing, err := s.repo.GetCertificateByUserAndYear(ctx, userID, year)
+			if err != nil {
+				return nil, err
+			}
+			return s.render(existing), nil
+		}
+		return nil, err
+	}
+
+	return s.render(cert), nil
+}
+
+func (s *certificateService) VerifyCertificate(ctx context.Context, code string) (*models.DonationCertificate, bool, error) {
+	cert, err := s.repo.GetCertificateByVerificationCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, false, err
+	}
+
+	expected := *cert
+	s.sign(&expected)
+	valid := hmac.Equal([]byte(expected.Signature), []byte(cert.Signature)) &&
+		expected.VerificationCode == cert.VerificationCode
+
+	return cert, valid, nil
+}
+
+// sign computes an HMAC over every printed field of the certificate. The
+// verification code is derived from the same MAC so it cannot be guessed
+// without the signing key.
+func (s *certificateService) sign(cert *models.DonationCertificate) {
+	payload := strings.Join([]string{
+		cert.Number,
+		cert.UserID.String(),
+		strconv.Itoa(cert.Year),
+		cert.RecipientName,
+		cert.OrganizationName,
+		cert.TaxID,
+		cert.Currency,
+		strconv.FormatInt(cert.AdoptTotal, 10),
+		strconv.FormatInt(cert.CareTotal, 10),
+		strconv.FormatInt(cert.TotalAmount, 10),
+		strconv.FormatInt(cert.IssuedAt.Unix(), 10),
+	}, "|")
+
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(payload))
+	sum := mac.Sum(nil)
+
+	code := base32.StdEncoding.EncodeToString(sum[:10])
+	cert.Signature = hex.EncodeToString(sum)
+	cert.VerificationCode = strings.Join([]string{code[0:4], code[4:8], code[8:12], code[12:16]}, "-")
+}
+
+func (s *certificateService) render(cert *models.DonationCertificate) *models.Document {
+	doc := pdf.New("ReForest Donation Certificate " + cert.Number)
+	doc.Heading("ReForest - Annual Donation Certificate")
+	doc.Blank()
+	doc.Text("Certificate No : " + cert.Number)
+	doc.Text("Tax Year       : " + strconv.Itoa(cert.Year))
+	doc.Text("Issued On      : " + cert.IssuedAt.Format("02 Jan 2006"))
+	doc.Blank()
+	doc.Text("Issued To      : " + cert.RecipientName)
+	if cert.OrganizationName != "" {
+		doc.Text("Organization   : " + cert.OrganizationName)
+	}
+	if cert.TaxID != "" {
+		doc.Text("Tax ID (NPWP)  : " + cert.TaxID)
+	}
+	doc.Blank()
+	doc.Text("Tree Adoptions : " + money.Format(cert.AdoptTotal, cert.Currency))
+	doc.Text("Tree Care      : " + money.Format(cert.CareTotal, cert.Currency))
+	doc.Bold("Total Donated  : " + money.Format(cert.TotalAmount, cert.Currency))
+	doc.Text("Transactions   : " + strconv.Itoa(cert.TransactionCount))
+	doc.Blank()
+	doc.Text("This certifies that the donations above were received by ReForest")
+	doc.Text("for the adoption and care of trees during the stated tax year.")
+	doc.Blank()
+	doc.Bold("Verification Code : " + cert.VerificationCode)
+	doc.Text("Verify at " + s.verifyURL + cert.VerificationCode)
+	doc.Text("Signature (HMAC-SHA256): " + cert.Signature)
+
+	return &models.Document{
+		FileName:    fmt.Sprintf("donation-certificate-%s.pdf", cert.Number),
+		ContentType: "application/pdf",
+		Content:     doc.Bytes(),
+	}
+}
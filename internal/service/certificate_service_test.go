@@ -0,0 +1,168 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+type mockCertificateRepo struct {
+	totals       *repository.DonationTotals
+	name         string
+	existing     *models.DonationCertificate
+	byCode       map[string]*models.DonationCertificate
+	created      *models.DonationCertificate
+	lastSequence int
+}
+
+func (m *mockCertificateRepo) GetDonationTotals(ctx context.Context, userID uuid.UUID, from, to time.Time) (*repository.DonationTotals, error) {
+	if m.totals == nil {
+		return &repository.DonationTotals{}, nil
+	}
+	return m.totals, nil
+}
+
+func (m *mockCertificateRepo) GetRecipientName(ctx context.Context, userID uuid.UUID) (string, error) {
+	return m.name, nil
+}
+
+func (m *mockCertificateRepo) GetCertificateByUserAndYear(ctx context.Context, userID uuid.UUID, year int) (*models.DonationCertificate, error) {
+	if m.existing != nil {
+		return m.existing, nil
+	}
+	return nil, models.ErrNotFound
+}
+
+func (m *mockCertificateRepo) GetCertificateByVerificationCode(ctx context.Context, code string) (*models.DonationCertificate, error) {
+	if cert, ok := m.byCode[code]; ok {
+		return cert, nil
+	}
+	return nil, models.ErrNotFound
+}
+
+func (m *mockCertificateRepo) CreateCertificate(ctx context.Context, cert *models.DonationCertificate, sign func(*models.DonationCertificate)) error {
+	m.lastSequence++
+	cert.Sequence = m.lastSequence
+	cert.Number = fmt.Sprintf("RF-%d-%06d", cert.Year, cert.Sequence)
+	sign(cert)
+	m.created = cert
+	if m.byCode == nil {
+		m.byCode = map[string]*models.DonationCertificate{}
+	}
+	m.byCode[cert.VerificationCode] = cert
+	return nil
+}
+
+func newTestCertificateService(repo *mockCertificateRepo) *certificateService {
+	svc := NewCertificateService(repo, "signing-key", "http://api.test").(*certificateService)
+	svc.now = func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) }
+	return svc
+}
+
+func TestCertificateService_IssueCertificate_Success(t *testing.T) {
+	repo := &mockCertificateRepo{
+		name:   "PT Hijau Lestari",
+		totals: &repository.DonationTotals{AdoptTotal: 300000, CareTotal: 50000, TransactionCount: 4},
+	}
+	svc := newTestCertificateService(repo)
+
+	doc, err := svc.IssueCertificate(context.Background(), uuid.New(), &pb.CertificateRequest{
+		Year:             2025,
+		OrganizationName: " PT Hijau Lestari ",
+		TaxId:            "01.234.567.8-901.000",
+	})
+	if err != nil {
+		t.Fatalf("IssueCertificate() error = %v", err)
+	}
+
+	if repo.created == nil || repo.created.TotalAmount != 350000 {
+		t.Fatalf("certificate not created with aggregated total: %+v", repo.created)
+	}
+	if repo.created.OrganizationName != "PT Hijau Lestari" {
+		t.Fatalf("organization name should be trimmed, got %q", repo.created.OrganizationName)
+	}
+	if repo.created.Signature == "" || len(repo.created.VerificationCode) != 19 {
+		t.Fatalf("certificate should be signed, got signature %q code %q", repo.created.Signature, repo.created.VerificationCode)
+	}
+	if !bytes.Contains(doc.Content, []byte(repo.created.VerificationCode)) {
+		t.Fatalf("PDF should print the verification code")
+	}
+	if !bytes.Contains(doc.Content, []byte("http://api.test/certificates/verify/")) {
+		t.Fatalf("PDF should print the verification URL")
+	}
+}
+
+func TestCertificateService_IssueCertificate_CurrentYearRejected(t *testing.T) {
+	svc := newTestCertificateService(&mockCertificateRepo{})
+
+	_, err := svc.IssueCertificate(context.Background(), uuid.New(), &pb.CertificateRequest{Year: 2026})
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for an unfinished year, got %v", err)
+	}
+}
+
+func TestCertificateService_IssueCertificate_NoDonations(t *testing.T) {
+	svc := newTestCertificateService(&mockCertificateRepo{})
+
+	_, err := svc.IssueCertificate(context.Background(), uuid.New(), &pb.CertificateRequest{Year: 2025})
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput when nothing was donated, got %v", err)
+	}
+}
+
+func TestCertificateService_IssueCertificate_ReturnsExisting(t *testing.T) {
+	existing := &models.DonationCertificate{Number: "RF-2025-000007", Year: 2025, VerificationCode: "AAAA-BBBB-CCCC-DDDD"}
+	repo := &mockCertificateRepo{existing: existing}
+	svc := newTestCertificateService(repo)
+
+	doc, err := svc.IssueCertificate(context.Background(), uuid.New(), &pb.CertificateRequest{Year: 2025})
+	if err != nil {
+		t.Fatalf("IssueCertificate() error = %v", err)
+	}
+	if repo.created != nil {
+		t.Fatalf("should not issue a second certificate for the same year")
+	}
+	if doc.FileName != "donation-certificate-RF-2025-000007.pdf" {
+		t.Fatalf("unexpected file name %s", doc.FileName)
+	}
+}
+
+func TestCertificateService_VerifyCertificate(t *testing.T) {
+	repo := &mockCertificateRepo{
+		name:   "Budi",
+		totals: &repository.DonationTotals{AdoptTotal: 100000, TransactionCount: 1},
+	}
+	svc := newTestCertificateService(repo)
+
+	if _, err := svc.IssueCertificate(context.Background(), uuid.New(), &pb.CertificateRequest{Year: 2025}); err != nil {
+		t.Fatalf("IssueCertificate() error = %v", err)
+	}
+	code := repo.created.VerificationCode
+
+	cert, valid, err := svc.VerifyCertificate(context.Background(), " "+code+" ")
+	if err != nil {
+		t.Fatalf("VerifyCertificate() error = %v", err)
+	}
+	if !valid || cert.Number != repo.created.Number {
+		t.Fatalf("expected valid certificate %s", repo.created.Number)
+	}
+
+	// Tampering with the stored amount must invalidate the signature.
+	repo.created.TotalAmount = 999999999
+	if _, valid, _ := svc.VerifyCertificate(context.Background(), code); valid {
+		t.Fatalf("tampered certificate should not verify")
+	}
+
+	if _, _, err := svc.VerifyCertificate(context.Background(), "NOPE-NOPE-NOPE-NOPE"); !errors.Is(err, models.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown code, got %v", err)
+	}
+}
@@ -1,9 +1,13 @@
 package service
 
 import (
+	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"strings"
 
 	"reforest/config"
@@ -11,6 +15,13 @@ import (
 
 type EmailSender interface {
 	Send(to, subject, body string) error
+	SendWithAttachments(to, subject, body string, attachments ...Attachment) error
+}
+
+type Attachment struct {
+	FileName    string
+	ContentType string
+	Content     []byte
 }
 
 type MailtrapSender struct {
@@ -72,6 +83,66 @@ func (s *MailtrapSender) Send(to, subject, body string) error {
 	return sendMail(addr, auth, s.From, []string{to}, msg)
 }
 
+func (s *MailtrapSender) SendWithAttachments(to, subject, body string, attachments ...Attachment) error {
+	if len(attachments) == 0 {
+		return s.Send(to, subject, body)
+	}
+	if err := s.validate(to, subject, body); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf,
+		"From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: multipart/mixed; boundary=%q\r\n\r\n",
+		s.From, to, subject, mw.Boundary())
+
+	textPart, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {`text/plain; charset="UTF-8"`},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := textPart.Write([]byte(body + "\r\n")); err != nil {
+		return err
+	}
+
+	for _, a := range attachments {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {fmt.Sprintf("%s; name=%q", a.ContentType, a.FileName)},
+			"Content-Disposition":       {fmt.Sprintf("attachment; filename=%q", a.FileName)},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := part.Write([]byte(wrapBase64(a.Content))); err != nil {
+			return err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return err
+	}
+
+	addr := fmt.Sprintf("%s:%s", s.Host, s.Port)
+	auth := smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	return sendMail(addr, auth, s.From, []string{to}, buf.Bytes())
+}
+
+// wrapBase64 encodes content with 76 character lines as required by RFC 2045.
+func wrapBase64(content []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	var b strings.Builder
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76])
+		b.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
 func (s *MailtrapSender) validate(to, subject, body string) error {
 	if s == nil {
 		return errors.New("email sender is nil")
@@ -107,3 +107,37 @@ func TestMailtrapSender_SendValidationError(t *testing.T) {
 		t.Fatalf("expected validation error when recipient is empty")
 	}
 }
+
+func TestMailtrapSender_SendWithAttachments(t *testing.T) {
+	origSend := sendMail
+	defer func() { sendMail = origSend }()
+
+	var capturedMsg string
+	sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		capturedMsg = string(msg)
+		return nil
+	}
+
+	s := &MailtrapSender{Host: "smtp.test", Port: "2525", Username: "u", Password: "p", From: "from@example.com"}
+	err := s.SendWithAttachments("to@example.com", "Receipt", "See attached", Attachment{
+		FileName:    "receipt.pdf",
+		ContentType: "application/pdf",
+		Content:     []byte("%PDF-1.4"),
+	})
+	if err != nil {
+		t.Fatalf("SendWithAttachments() error = %v", err)
+	}
+
+	if !strings.Contains(capturedMsg, "Content-Type: multipart/mixed") {
+		t.Fatalf("expected multipart message, got %q", capturedMsg)
+	}
+	if !strings.Contains(capturedMsg, `filename="receipt.pdf"`) {
+		t.Fatalf("attachment filename not found in payload")
+	}
+	if !strings.Contains(capturedMsg, "JVBERi0xLjQ=") {
+		t.Fatalf("attachment content not base64 encoded in payload")
+	}
+	if !strings.Contains(capturedMsg, "See attached") {
+		t.Fatalf("message body not found in payload")
+	}
+}
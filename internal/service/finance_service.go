@@ -8,9 +8,10 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"reforest/internal/models"
 	"reforest/internal/repository"
-	"reforest/pkg/mq"
+	"reforest/pkg/money"
 	"reforest/pkg/pb"
 	"time"
 
@@ -19,18 +20,44 @@ import (
 
 type FinanceService interface {
 	CreateTransaction(ctx context.Context, req *pb.TransactionRequest) (*models.Transaction, error)
-	TopUpWallet(ctx context.Context, userID uuid.UUID, amount int64, duration int32) (*models.Transaction, error)
+	TopUpWallet(ctx context.Context, userID uuid.UUID, amount int64, currency string, duration int32, voucherCode string, method models.PaymentMethod) (*models.Transaction, error)
+	TransferBalance(ctx context.Context, senderID uuid.UUID, recipientEmail string, amount int64) (*models.Transaction, error)
 	HandleWalletWebhook(ctx context.Context, event string, data []byte) error
-	GetBalance(ctx context.Context, userID uuid.UUID) (int64, error)
+	GetBalance(ctx context.Context, userID uuid.UUID, displayCurrency string) (*models.WalletBalance, error)
+	GetExchangeRates(ctx context.Context) (string, []money.Rate)
 	GetTransactionHistory(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error)
 	CheckPaymentExpiry(ctx context.Context) error
+	SendInvoiceReminders(ctx context.Context, offsets []time.Duration) error
+	CancelPayment(ctx context.Context, userID, txID uuid.UUID) (*models.Transaction, error)
+	CreateBalanceHold(ctx context.Context, userID uuid.UUID, req *pb.CreateBalanceHoldRequest) (*models.BalanceHold, error)
+	CaptureBalanceHold(ctx context.Context, holdID uuid.UUID) (*models.BalanceHold, error)
+	ReleaseBalanceHold(ctx context.Context, userID uuid.UUID, asAdmin bool, holdID uuid.UUID) (*models.BalanceHold, error)
+	ListBalanceHolds(ctx context.Context, userID uuid.UUID) ([]models.BalanceHold, error)
+	ExpireBalanceHolds(ctx context.Context) error
+	GetReceipt(ctx context.Context, userID, txID uuid.UUID, format pb.DocumentFormat) (*models.Document, error)
+	GetStatement(ctx context.Context, userID uuid.UUID, req *pb.StatementRequest) (*models.Document, error)
+	ReconcilePayments(ctx context.Context) (*models.ReconciliationReport, error)
+	ListReconciliationReports(ctx context.Context, limit int) ([]models.ReconciliationReport, error)
+	CreateVoucher(ctx context.Context, v *models.Voucher) (*models.Voucher, error)
+	ListVouchers(ctx context.Context) ([]models.Voucher, error)
+	ListTopUpReviews(ctx context.Context, status string) ([]models.TopUpReview, error)
+	ApproveTopUpReview(ctx context.Context, reviewerID, reviewID uuid.UUID, note string) (*models.TopUpReview, error)
+	RejectTopUpReview(ctx context.Context, reviewerID, reviewID uuid.UUID, note string) (*models.TopUpReview, error)
+	RequestBalanceAdjustment(ctx context.Context, requesterID uuid.UUID, req *pb.BalanceAdjustmentRequest) (*models.BalanceAdjustment, error)
+	ApproveBalanceAdjustment(ctx context.Context, approverID, adjustmentID uuid.UUID, note string) (*models.BalanceAdjustment, error)
+	RejectBalanceAdjustment(ctx context.Context, deciderID, adjustmentID uuid.UUID, note string) (*models.BalanceAdjustment, error)
+	ListBalanceAdjustments(ctx context.Context, status string) ([]models.BalanceAdjustment, error)
+	CreateMatchingCampaign(ctx context.Context, creatorID uuid.UUID, c *models.MatchingCampaign) (*models.MatchingCampaign, error)
+	ListMatchingCampaigns(ctx context.Context) ([]models.MatchingCampaign, error)
+	UpdateMatchingCampaignStatus(ctx context.Context, id uuid.UUID, status string) (*models.MatchingCampaign, error)
+	ListCampaignMatches(ctx context.Context, campaignID uuid.UUID) ([]models.CampaignMatch, error)
 }
 
 type financeService struct {
 	repo         repository.FinanceRepository
 	xenditAPIKey string
-	mqClient     *mq.Client
 	emailSender  EmailSender
+	rates        *money.Rates
 }
 
 type xenditInvoiceRequest struct {
@@ -39,20 +66,30 @@ type xenditInvoiceRequest struct {
 	Description     string  `json:"description"`
 	InvoiceDuration int     `json:"invoice_duration"`
 	Currency        string  `json:"currency"`
+	// Channel codes to offer; all of them when empty.
+	PaymentMethods []string `json:"payment_methods,omitempty"`
 }
 
 type xenditInvoiceResponse struct {
-	InvoiceURL string `json:"invoice_url"`
-	Status     string `json:"status"`
-	ExpiryDate string `json:"expiry_date"`
+	ID         string  `json:"id"`
+	ExternalID string  `json:"external_id"`
+	Amount     float64 `json:"amount"`
+	Currency   string  `json:"currency"`
+	InvoiceURL string  `json:"invoice_url"`
+	Status     string  `json:"status"`
+	ExpiryDate string  `json:"expiry_date"`
+	// Set once the invoice is paid.
+	FeesPaidAmount float64 `json:"fees_paid_amount"`
+	PaymentMethod  string  `json:"payment_method"`
+	PaymentChannel string  `json:"payment_channel"`
 }
 
-func NewFinanceService(repo repository.FinanceRepository, xenditAPIKey string, mqClient *mq.Client, emailSender EmailSender) FinanceService {
+func NewFinanceService(repo repository.FinanceRepository, xenditAPIKey string, emailSender EmailSender, rates *money.Rates) FinanceService {
 	return &financeService{
 		repo:         repo,
 		xenditAPIKey: xenditAPIKey,
-		mqClient:     mqClient,
 		emailSender:  emailSender,
+		rates:        rates,
 	}
 }
 
@@ -64,37 +101,64 @@ func (s *financeService) CreateTransaction(ctx context.Context, req *pb.Transact
 
 	switch req.Type {
 	case pb.TransactionType_ADOPT:
-		balance, err := s.repo.GetUserBalance(ctx, userID)
+		balance, walletCurrency, err := s.repo.GetUserBalance(ctx, userID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user balance: %w", err)
 		}
 
-		log.Printf("[CreateTransaction] User: %s, Balance: %d, Required: %d", userID, balance, req.Amount)
-
-		if balance >= req.Amount {
-			tx := &models.Transaction{
-				UserID:      userID,
-				Amount:      req.Amount,
-				Type:        req.Type.String(),
-				ReferenceID: req.ReferenceId,
-				Payment: models.Payment{
-					Amount: req.Amount,
-					Status: "SUCCESS",
-				},
-			}
-			if err := s.repo.CreateTransaction(ctx, tx); err != nil {
+		tx, err := s.checkoutTransaction(userID, req, walletCurrency)
+		if err != nil {
+			return nil, err
+		}
+
+		var voucher *models.Voucher
+		if req.VoucherCode != "" {
+			if voucher, err = s.applyVoucher(ctx, tx, req.VoucherCode, req.SpeciesId, req.PlotId); err != nil {
 				return nil, err
 			}
-			if err := s.repo.UpdateWalletBalance(ctx, userID, -req.Amount); err != nil {
-				return nil, fmt.Errorf("failed to update wallet balance for adoption: %w", err)
-			}
+		}
 
-			// Publish success event immediately
-			_ = s.mqClient.Publish(ctx, "payment.success", map[string]string{"reference_id": tx.ReferenceID})
+		log.Printf("[CreateTransaction] User: %s, Balance: %d %s, Required: %d %s", userID, balance, walletCurrency, tx.Amount, tx.Currency)
+
+		if balance >= tx.Amount {
+			tx.Payment = models.Payment{
+				Amount: tx.Amount,
+				Status: "SUCCESS",
+			}
+			var match *models.CampaignMatch
+			var campaign *models.MatchingCampaign
+			err := s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+				// The balance read above may have been spent by another
+				// purchase since, so the debit itself checks it again
+				if err := repo.DebitWallet(ctx, userID, tx.Amount, tx.Currency); err != nil {
+					return fmt.Errorf("failed to debit wallet for adoption: %w", err)
+				}
+				if err := saveTransaction(ctx, repo, tx, voucher); err != nil {
+					return err
+				}
+				// Paid in full, so the adoption can complete immediately
+				if err := repo.EnqueueEvent(ctx, "payment.success", map[string]string{"reference_id": tx.ReferenceID}); err != nil {
+					return err
+				}
+				var err error
+				match, campaign, err = s.matchAdoption(ctx, repo, tx)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			s.emailReceipt(ctx, tx)
+			s.notifyMatched(ctx, tx, match, campaign)
 
 			return tx, nil
 		}
-		return s.createInvoiceTransaction(ctx, userID, req.Amount, "ADOPT", req.ReferenceId, 86400)
+		// The wallet balance goes towards the price and only the shortfall is
+		// invoiced. The balance is held until the invoice is paid or expires.
+		var held int64
+		if balance > 0 {
+			held = balance
+		}
+		return s.createInvoiceTransaction(ctx, tx, voucher, held, 86400)
 
 	case pb.TransactionType_CARE:
 		// TODO: Implement care if time suffice
@@ -104,66 +168,153 @@ func (s *financeService) CreateTransaction(ctx context.Context, req *pb.Transact
 	return nil, fmt.Errorf("unhandled transaction type: %s", req.Type.String())
 }
 
-func (s *financeService) TopUpWallet(ctx context.Context, userID uuid.UUID, amount int64, duration int32) (*models.Transaction, error) {
-	tx, err := s.createInvoiceTransaction(ctx, userID, amount, "DEPOSIT", "", int(duration))
+// checkoutTransaction prices a purchase in the buyer's wallet currency. Items
+// priced in another currency are converted with the exchange-rate table and
+// the original price and applied rate are kept on the transaction.
+func (s *financeService) checkoutTransaction(userID uuid.UUID, req *pb.TransactionRequest, walletCurrency string) (*models.Transaction, error) {
+	currency, err := money.Normalize(req.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+	// Any invoice is issued in the wallet currency
+	method, err := checkPaymentMethod(req.PaymentMethod, req.PaymentChannel, walletCurrency)
 	if err != nil {
 		return nil, err
 	}
 
-	if s.emailSender != nil {
-		email, currentBalance, err := s.repo.GetUserEmailAndBalance(ctx, userID)
-		if err != nil {
-			log.Printf("WARN: failed to fetch user email/balance for top up notification: %v", err)
-		} else {
-			total := currentBalance + amount
-			body := fmt.Sprintf("Selamat!\nSaldo kamu bertambah %d.\nTotal saldo kamu sekarang: %d", amount, total)
-			if err := s.emailSender.Send(email, "Top Up Wallet", body); err != nil {
-				log.Printf("WARN: failed to send top up email to %s: %v", email, err)
-			}
-		}
+	tx := &models.Transaction{
+		UserID:      userID,
+		Amount:      req.Amount,
+		Currency:    currency,
+		Type:        req.Type.String(),
+		ReferenceID: req.ReferenceId,
+		Payment:     models.Payment{PaymentMethod: method},
+	}
+	if currency == walletCurrency {
+		return tx, nil
 	}
 
+	amount, rate, err := s.rates.Convert(req.Amount, currency, walletCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot charge a %s wallet for a %s price: %v", models.ErrCurrencyMismatch, walletCurrency, currency, err)
+	}
+	tx.OriginalAmount, tx.OriginalCurrency, tx.ExchangeRate = req.Amount, currency, rate
+	tx.Amount, tx.Currency = amount, walletCurrency
 	return tx, nil
 }
 
-func (s *financeService) createInvoiceTransaction(ctx context.Context, userID uuid.UUID, amount int64, txType string, refID string, duration int) (*models.Transaction, error) {
+func (s *financeService) TopUpWallet(ctx context.Context, userID uuid.UUID, amount int64, currency string, duration int32, voucherCode string, method models.PaymentMethod) (*models.Transaction, error) {
+	_, walletCurrency, err := s.repo.GetUserBalance(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user wallet: %w", err)
+	}
+	if currency != "" {
+		if currency, err = money.Normalize(currency); err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+		}
+		if currency != walletCurrency {
+			return nil, fmt.Errorf("%w: wallet is held in %s, cannot top up in %s", models.ErrCurrencyMismatch, walletCurrency, currency)
+		}
+	}
+	if method, err = checkPaymentMethod(method.Method, method.Channel, walletCurrency); err != nil {
+		return nil, err
+	}
+
+	if duration == 0 {
+		duration = 86400
+	}
+	flags, err := s.screenTopUp(ctx, topUpCheck{
+		UserID:   userID,
+		Amount:   amount,
+		Currency: walletCurrency,
+		Duration: time.Duration(duration) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &models.Transaction{
+		UserID:   userID,
+		Amount:   amount,
+		Currency: walletCurrency,
+		Type:     "DEPOSIT",
+		Payment:  models.Payment{PaymentMethod: method},
+	}
+	var voucher *models.Voucher
+	if voucherCode != "" {
+		if voucher, err = s.applyVoucher(ctx, tx, voucherCode, "", ""); err != nil {
+			return nil, err
+		}
+	}
+
+	// Flagged top-ups get no invoice until an admin has looked at them.
+	if len(flags) > 0 {
+		return s.holdTopUpForReview(ctx, tx, voucher, flags, int(duration))
+	}
+
+	return s.createInvoiceTransaction(ctx, tx, voucher, 0, int(duration))
+}
+
+// createInvoiceTransaction stores tx as pending, together with the redemption
+// of voucher if one was applied, and issues a Xendit invoice for it in the
+// transaction's currency. When held is positive that much of the amount is
+// held from the wallet and only the rest is invoiced; Payment.Amount is the
+// invoiced part. Should the invoice fail, tx is expired right away so the
+// hold, the voucher and any reservation are given back.
+func (s *financeService) createInvoiceTransaction(ctx context.Context, tx *models.Transaction, voucher *models.Voucher, held int64, duration int) (*models.Transaction, error) {
 	invoiceDuration := int(duration)
 	if invoiceDuration <= 0 {
 		invoiceDuration = 86400 // equals 24 hours
 	}
 
-	tx := &models.Transaction{
-		UserID:      userID,
-		Amount:      amount,
-		Type:        txType,
-		ReferenceID: refID,
-		Payment: models.Payment{
-			Amount:    amount,
-			Status:    "PENDING",
-			ExpiresAt: time.Now().Add(time.Duration(invoiceDuration) * time.Second),
-		},
+	// The payment method chosen at checkout is kept
+	tx.Payment.Amount = tx.Amount - held
+	tx.Payment.Status = "PENDING"
+	tx.Payment.ExpiresAt = time.Now().Add(time.Duration(invoiceDuration) * time.Second)
+
+	err := s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		if err := saveTransaction(ctx, repo, tx, voucher); err != nil {
+			return err
+		}
+		if held > 0 {
+			return holdBalance(ctx, repo, tx, held)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.repo.CreateTransaction(ctx, tx); err != nil {
+	if err := s.issueInvoice(ctx, tx, invoiceDuration); err != nil {
+		if expireErr := s.expireTransaction(ctx, tx, nil); expireErr != nil {
+			log.Printf("WARN: failed to expire transaction %s after its invoice failed: %v", tx.ID, expireErr)
+		}
 		return nil, err
 	}
+	s.notifyInvoiceCreated(ctx, tx)
+	return tx, nil
+}
 
+// issueInvoice requests a Xendit invoice for the payable part of a stored
+// transaction and records its ID, link and expiry on the payment.
+func (s *financeService) issueInvoice(ctx context.Context, tx *models.Transaction, invoiceDuration int) error {
 	reqBody := xenditInvoiceRequest{
 		ExternalID:      tx.ID.String(),
-		Amount:          float64(amount),
-		Description:     fmt.Sprintf("%s - User %s", txType, userID.String()),
+		Amount:          money.ToMajor(tx.Payment.Amount, tx.Currency),
+		Description:     fmt.Sprintf("%s - User %s", tx.Type, tx.UserID.String()),
 		InvoiceDuration: invoiceDuration,
-		Currency:        "IDR",
+		Currency:        tx.Currency,
+		PaymentMethods:  invoicePaymentMethods(tx.Payment.PaymentMethod),
 	}
 
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.xendit.co/v2/invoices", bytes.NewBuffer(jsonBody))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	req.SetBasicAuth(s.xenditAPIKey, "")
@@ -172,17 +323,17 @@ func (s *financeService) createInvoiceTransaction(ctx context.Context, userID uu
 	client := &http.Client{Timeout: 10 * time.Second}
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to create xendit invoice, status: %d", resp.StatusCode)
+		return fmt.Errorf("failed to create xendit invoice, status: %d", resp.StatusCode)
 	}
 
 	var xenditResp xenditInvoiceResponse
 	if err := json.NewDecoder(resp.Body).Decode(&xenditResp); err != nil {
-		return nil, err
+		return err
 	}
 
 	expiryTime, err := time.Parse(time.RFC3339, xenditResp.ExpiryDate)
@@ -191,19 +342,88 @@ func (s *financeService) createInvoiceTransaction(ctx context.Context, userID uu
 		expiryTime = time.Now().Add(time.Duration(invoiceDuration) * time.Second)
 	}
 
-	if err := s.repo.UpdateTransactionInvoiceDetails(ctx, tx.ID, xenditResp.InvoiceURL, expiryTime); err != nil {
+	if err := s.repo.UpdateTransactionInvoiceDetails(ctx, tx.ID, xenditResp.ID, xenditResp.InvoiceURL, expiryTime); err != nil {
 		fmt.Printf("failed to update transaction invoice details: %v\n", err)
-		return nil, err
+		return err
 	}
+	tx.Payment.ExternalID = xenditResp.ID
 	tx.Payment.PaymentURL = xenditResp.InvoiceURL
 	tx.Payment.ExpiresAt = expiryTime
-	return tx, nil
+	return nil
+}
+
+// fetchInvoice looks up the Xendit invoice issued for a transaction ID.
+func (s *financeService) fetchInvoice(ctx context.Context, externalID string) (*xenditInvoiceResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.xendit.co/v2/invoices?external_id="+url.QueryEscape(externalID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(s.xenditAPIKey, "")
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch xendit invoice, status: %d", resp.StatusCode)
+	}
+
+	var invoices []xenditInvoiceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&invoices); err != nil {
+		return nil, err
+	}
+	if len(invoices) == 0 {
+		return nil, fmt.Errorf("%w: no xendit invoice for %s", models.ErrNotFound, externalID)
+	}
+	return &invoices[0], nil
+}
+
+// expireInvoice expires the Xendit invoice of tx so it can no longer be paid.
+// Invoices issued before their ID was stored are looked up by transaction ID.
+// Payments made from the wallet have no invoice and are left alone.
+func (s *financeService) expireInvoice(ctx context.Context, tx *models.Transaction) error {
+	if tx.Payment.PaymentURL == "" {
+		return nil
+	}
+	invoiceID := tx.Payment.ExternalID
+	if invoiceID == "" {
+		invoice, err := s.fetchInvoice(ctx, tx.ID.String())
+		if err != nil {
+			return err
+		}
+		invoiceID = invoice.ID
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.xendit.co/invoices/"+url.PathEscape(invoiceID)+"/expire!", nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(s.xenditAPIKey, "")
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to expire xendit invoice %s, status: %d", invoiceID, resp.StatusCode)
+	}
+	return nil
 }
 
 func (s *financeService) HandleWalletWebhook(ctx context.Context, event string, data []byte) error {
 	var payload struct {
-		ExternalID string `json:"external_id"`
-		Status     string `json:"status"`
+		ExternalID     string  `json:"external_id"`
+		Status         string  `json:"status"`
+		Currency       string  `json:"currency"`
+		FeesPaidAmount float64 `json:"fees_paid_amount"`
+		PaymentMethod  string  `json:"payment_method"`
+		PaymentChannel string  `json:"payment_channel"`
 	}
 
 	if err := json.Unmarshal(data, &payload); err != nil {
@@ -225,29 +445,145 @@ func (s *financeService) HandleWalletWebhook(ctx context.Context, event string,
 			return err
 		}
 
-		if tx.Payment.Status == "SUCCESS" {
+		if tx.Payment.Status == "SUCCESS" || tx.Payment.Status == "CREDITED" {
 			return nil
 		}
 
-		if err := s.repo.UpdateTransactionStatus(ctx, txID, "SUCCESS"); err != nil {
-			return err
+		if payload.Currency != "" && payload.Currency != tx.Currency {
+			return fmt.Errorf("%w: invoice %s was paid in %s, expected %s", models.ErrCurrencyMismatch, tx.ID, payload.Currency, tx.Currency)
 		}
 
-		if tx.Type == "ADOPT" {
-			_ = s.mqClient.Publish(ctx, "payment.success", map[string]string{"reference_id": tx.ReferenceID})
+		tx.Payment.FeeAmount = money.ToMinor(payload.FeesPaidAmount, tx.Currency)
+		tx.Payment.PaymentMethod = paidWith(payload.PaymentMethod, payload.PaymentChannel, tx.Payment.PaymentMethod)
+
+		// The adoption of an expired invoice has already been released, so
+		// the payment goes to the wallet instead
+		if tx.Payment.Status == "EXPIRED" && tx.Type == "ADOPT" {
+			log.Printf("Late payment for expired invoice %s, crediting wallet of user %s", tx.ID, tx.UserID)
+			return s.creditLatePayment(ctx, tx)
+		}
+		return s.markPaid(ctx, tx)
+	} else if payload.Status == "EXPIRED" {
+		tx, err := s.repo.GetTransactionByID(ctx, txID)
+		if err != nil {
+			return err
+		}
+		if tx.Payment.Status != "PENDING" {
 			return nil
 		}
+		return s.expireTransaction(ctx, tx, noticePaymentExpired)
+	}
 
-		return s.repo.UpdateWalletBalance(ctx, tx.UserID, tx.Amount)
-	} else if payload.Status == "EXPIRED" {
-		return s.repo.UpdateTransactionStatus(ctx, txID, "EXPIRED")
+	return nil
+}
+
+// markPaid settles a paid invoice: adoptions are handed to the tree service
+// and matched by any campaign of the sponsor's employer, deposits are
+// credited to the wallet. The fee and payment method the provider reported
+// are expected on tx.Payment. An invoice that was settled in the meantime is
+// left as it is, and an adoption that expired in the meantime is credited to
+// the wallet instead.
+func (s *financeService) markPaid(ctx context.Context, tx *models.Transaction) error {
+	paidAt := time.Now()
+	from := []string{"PENDING", "EXPIRED"}
+	if tx.Type == "ADOPT" {
+		// The reservation of an expired adoption has been released
+		from = from[:1]
+	}
+	var settled bool
+	var match *models.CampaignMatch
+	var campaign *models.MatchingCampaign
+	err := s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		// A duplicate webhook, a reconciliation run or the expiry job racing
+		// this one finds the invoice no longer payable and leaves it alone.
+		var err error
+		settled, err = repo.TransitionPaymentStatus(ctx, tx.ID, "SUCCESS", from...)
+		if err != nil || !settled {
+			return err
+		}
+		if err := repo.UpdatePaymentSettlement(ctx, tx.ID, tx.Payment.PaymentMethod, tx.Payment.FeeAmount, paidAt); err != nil {
+			return err
+		}
+		if tx.Type == "ADOPT" {
+			if err := captureHold(ctx, repo, tx.ID); err != nil {
+				return err
+			}
+			if err := repo.EnqueueEvent(ctx, "payment.success", map[string]string{"reference_id": tx.ReferenceID}); err != nil {
+				return err
+			}
+			match, campaign, err = s.matchAdoption(ctx, repo, tx)
+			return err
+		}
+		// A top-up voucher makes up the discount, so the full amount is credited
+		return repo.UpdateWalletBalance(ctx, tx.UserID, tx.Amount+tx.DiscountAmount, tx.Currency)
+	})
+	if err != nil {
+		return err
+	}
+	if !settled {
+		if tx.Type == "ADOPT" {
+			// a no-op unless the expiry job got there first
+			return s.creditLatePayment(ctx, tx)
+		}
+		return nil
 	}
 
+	tx.Payment.Status, tx.Payment.PaidAt = "SUCCESS", &paidAt
+	if tx.Type == "DEPOSIT" {
+		s.notify(ctx, noticeTopUpCredited, tx, walletNoticeData{Credited: money.Format(tx.Amount+tx.DiscountAmount, tx.Currency)}, tx)
+	} else {
+		s.emailReceipt(ctx, tx)
+		s.notifyMatched(ctx, tx, match, campaign)
+	}
 	return nil
 }
 
-func (s *financeService) GetBalance(ctx context.Context, userID uuid.UUID) (int64, error) {
-	return s.repo.GetUserBalance(ctx, userID)
+// GetBalance returns the total and available wallet balance, converted into
+// displayCurrency when one is requested. Held funds are already taken out of
+// the stored balance, so they only count towards the total.
+func (s *financeService) GetBalance(ctx context.Context, userID uuid.UUID, displayCurrency string) (*models.WalletBalance, error) {
+	available, currency, err := s.repo.GetUserBalance(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	held, err := s.repo.SumHeldBalance(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get held balance: %w", err)
+	}
+
+	wallet := &models.WalletBalance{
+		Balance:          available + held,
+		Available:        available,
+		Currency:         currency,
+		DisplayBalance:   available + held,
+		DisplayAvailable: available,
+		DisplayCurrency:  currency,
+	}
+	if displayCurrency == "" {
+		return wallet, nil
+	}
+
+	display, err := money.Normalize(displayCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+	total, _, err := s.rates.Convert(wallet.Balance, currency, display)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+	spendable, _, err := s.rates.Convert(available, currency, display)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+	wallet.DisplayBalance, wallet.DisplayAvailable, wallet.DisplayCurrency = total, spendable, display
+	return wallet, nil
+}
+
+func (s *financeService) GetExchangeRates(ctx context.Context) (string, []money.Rate) {
+	if s.rates == nil {
+		return money.DefaultCurrency, nil
+	}
+	return s.rates.Base(), s.rates.List()
 }
 
 func (s *financeService) GetTransactionHistory(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error) {
@@ -260,14 +596,89 @@ func (s *financeService) CheckPaymentExpiry(ctx context.Context) error {
 		return err
 	}
 
-	for _, tx := range txs {
-		if err := s.repo.UpdateTransactionStatus(ctx, tx.ID, "EXPIRED"); err != nil {
-			// TODO: Log error
+	for i := range txs {
+		if err := s.expireTransaction(ctx, &txs[i], noticePaymentExpired); err != nil {
+			log.Printf("Failed to expire transaction %s: %v", txs[i].ID, err)
 			continue
 		}
+		if txs[i].Payment.Status != "EXPIRED" {
+			continue // paid in the meantime
+		}
+		// A payment made before the invoice is closed still arrives as a
+		// late PAID webhook, which credits the wallet
+		if err := s.expireInvoice(ctx, &txs[i]); err != nil {
+			log.Printf("WARN: failed to expire invoice of transaction %s at the provider: %v", txs[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// CancelPayment withdraws a pending invoice of the user before it expires.
+// The invoice is closed at the provider first, so a cancelled payment can no
+// longer be paid, and then expired here, which releases the reserved
+// adoption and any voucher.
+func (s *financeService) CancelPayment(ctx context.Context, userID, txID uuid.UUID) (*models.Transaction, error) {
+	tx, err := s.repo.GetTransactionByID(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	if tx.UserID != userID {
+		return nil, models.ErrNotFound
+	}
+	if tx.Payment.Status != "PENDING" {
+		return nil, fmt.Errorf("%w: only pending payments can be cancelled", models.ErrInvalidInput)
+	}
+
+	if err := s.expireInvoice(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to cancel invoice: %w", err)
+	}
+	if err := s.expireTransaction(ctx, tx, noticePaymentCancelled); err != nil {
+		return nil, err
+	}
+	if tx.Payment.Status != "EXPIRED" {
+		return nil, fmt.Errorf("%w: the payment was made before it could be cancelled", models.ErrInvalidInput)
+	}
+	return tx, nil
+}
+
+// expireTransaction marks an unpaid invoice EXPIRED and releases the adoption
+// it was reserving, together with any wallet balance held towards it. The
+// sponsor is then sent notice, if one is given. An invoice paid in the
+// meantime is left as it is, and tx.Payment.Status stays unchanged.
+func (s *financeService) expireTransaction(ctx context.Context, tx *models.Transaction, notice *walletNotice) error {
+	var expired bool
+	var released int64
+	err := s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		var err error
+		expired, err = repo.TransitionPaymentStatus(ctx, tx.ID, "EXPIRED", "PENDING")
+		if err != nil || !expired {
+			return err
+		}
+		if released, err = releaseHold(ctx, repo, tx.ID); err != nil {
+			return err
+		}
+		if tx.VoucherCode != "" {
+			if err := repo.ReleaseVoucherRedemption(ctx, tx.ID); err != nil {
+				return err
+			}
+		}
 		if tx.Type == "ADOPT" && tx.ReferenceID != "" {
-			_ = s.mqClient.Publish(ctx, "payment.expired", map[string]string{"reference_id": tx.ReferenceID})
+			return repo.EnqueueEvent(ctx, "payment.expired", map[string]string{"reference_id": tx.ReferenceID})
 		}
+		return nil
+	})
+	if err != nil || !expired {
+		return err
+	}
+	tx.Payment.Status = "EXPIRED"
+	if notice == nil {
+		return nil
+	}
+
+	var data walletNoticeData
+	if released > 0 {
+		data.Released = money.Format(released, tx.Currency)
 	}
+	s.notify(ctx, notice, tx, data, nil)
 	return nil
 }
@@ -2,15 +2,19 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
 	"reforest/pkg/pb"
 
 	"github.com/google/uuid"
@@ -19,7 +23,9 @@ import (
 type mockFinanceRepo struct {
 	balanceUserID      uuid.UUID
 	balanceDelta       int64
+	balanceCurrency    string
 	getBalanceValue    int64
+	getBalanceCurrency string
 	getBalanceErr      error
 	getEmail           string
 	getEmailBalanceVal int64
@@ -33,21 +39,108 @@ type mockFinanceRepo struct {
 	updatedStatusID    uuid.UUID
 	updatedStatusValue string
 	updateStatusErr    error
+	paymentStatus      map[uuid.UUID]string
+	balanceUpdates     int
 	invoiceUpdateID    uuid.UUID
+	invoiceUpdateInvID string
 	invoiceUpdateURL   string
 	invoiceUpdateExp   time.Time
 	invoiceUpdateErr   error
 	pendingBefore      []models.Transaction
 	pendingBeforeErr   error
+	settledBetween     []models.Transaction
+	settledFrom        time.Time
+	settledTo          time.Time
+	candidates         []models.Transaction
+	candidatesSince    time.Time
+	savedReport        *models.ReconciliationReport
+	reports            []models.ReconciliationReport
+	reportsLimit       int
+	txErr              error
+	events             []mockOutboxEvent
+	createdHold        *models.BalanceHold
+	holdByTx           *models.BalanceHold
+	holdByID           *models.BalanceHold
+	expiredHolds       []models.BalanceHold
+	heldBalance        int64
+	settledHoldID      uuid.UUID
+	settledHoldStatus  string
+	settledHolds       int
+	capturedHoldTxID   uuid.UUID
+	voucher            *models.Voucher
+	createdVoucher     *models.Voucher
+	redemption         *models.VoucherRedemption
+	redeemErr          error
+	releasedTxID       uuid.UUID
+	usersByEmail       map[string]uuid.UUID
+	debitUserID        uuid.UUID
+	debitAmount        int64
+	debitErr           error
+	transfersSent      int64
+	createdTxs         []*models.Transaction
+	depositsStarted    int64
+	depositsOfAmount   int64
+	depositVolume      int64
+	createdReview      *models.TopUpReview
+	reviewByID         *models.TopUpReview
+	decidedReviewID    uuid.UUID
+	decidedStatus      string
+	decidedReviewer    uuid.UUID
+	createdAdjustment  *models.BalanceAdjustment
+	adjustmentByID     *models.BalanceAdjustment
+	decidedAdjustment  uuid.UUID
+	adjustmentStatus   string
+	adjustmentDecider  uuid.UUID
+	adjustmentTxID     *uuid.UUID
+	settledMethod      models.PaymentMethod
+	settledFee         int64
+	settledAt          time.Time
+	createdCampaign    *models.MatchingCampaign
+	campaignByID       *models.MatchingCampaign
+	campaigns          []models.MatchingCampaign
+	campaignDomain     string
+	matchedTrees       int64
+	spentCampaign      uuid.UUID
+	spentAmount        int64
+	spendErr           error
+	createdMatch       *models.CampaignMatch
+	expiringInvoices   []models.Transaction
+	expiringFrom       time.Time
+	expiringTo         time.Time
+	reminders          []models.PaymentReminder
+	createdReminders   []models.PaymentReminder
 }
 
-func (m *mockFinanceRepo) GetUserBalance(ctx context.Context, userID uuid.UUID) (int64, error) {
-	return m.getBalanceValue, m.getBalanceErr
+type mockOutboxEvent struct {
+	routingKey string
+	payload    interface{}
 }
 
-func (m *mockFinanceRepo) UpdateWalletBalance(ctx context.Context, userID uuid.UUID, amount int64) error {
+func (m *mockFinanceRepo) WithTransaction(ctx context.Context, fn func(repo repository.FinanceRepository) error) error {
+	if m.txErr != nil {
+		return m.txErr
+	}
+	return fn(m)
+}
+
+func (m *mockFinanceRepo) EnqueueEvent(ctx context.Context, routingKey string, payload interface{}) error {
+	m.events = append(m.events, mockOutboxEvent{routingKey: routingKey, payload: payload})
+	return nil
+}
+
+func (m *mockFinanceRepo) GetUserBalance(ctx context.Context, userID uuid.UUID) (int64, string, error) {
+	currency := m.getBalanceCurrency
+	if currency == "" {
+		currency = "IDR"
+	}
+	return m.getBalanceValue, currency, m.getBalanceErr
+}
+
+func (m *mockFinanceRepo) UpdateWalletBalance(ctx context.Context, userID uuid.UUID, amount int64, currency string) error {
+	m.balanceUpdates++
 	m.balanceUserID = userID
 	m.balanceDelta = amount
+	m.balanceCurrency = currency
 	return m.getBalanceErr
 }
 
@@ -55,8 +148,195 @@ func (m *mockFinanceRepo) GetUserEmailAndBalance(ctx context.Context, userID uui
 	return m.getEmail, m.getEmailBalanceVal, m.getEmailBalanceErr
 }
 
+func (m *mockFinanceRepo) GetUserIDByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	id, ok := m.usersByEmail[email]
+	if !ok {
+		return uuid.Nil, models.ErrNotFound
+	}
+	return id, nil
+}
+
+func (m *mockFinanceRepo) DebitWallet(ctx context.Context, userID uuid.UUID, amount int64, currency string) error {
+	m.debitUserID, m.debitAmount = userID, amount
+	return m.debitErr
+}
+
+func (m *mockFinanceRepo) SumTransfersSentSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	return m.transfersSent, nil
+}
+
+func (m *mockFinanceRepo) CountDepositsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	return m.depositsStarted, nil
+}
+
+func (m *mockFinanceRepo) CountDepositsOfAmountSince(ctx context.Context, userID uuid.UUID, amount int64, since time.Time) (int64, error) {
+	return m.depositsOfAmount, nil
+}
+
+func (m *mockFinanceRepo) SumDepositsSince(ctx context.Context, userID uuid.UUID, since time.Time) (int64, error) {
+	return m.depositVolume, nil
+}
+
+func (m *mockFinanceRepo) CreateTopUpReview(ctx context.Context, review *models.TopUpReview) error {
+	m.createdReview = review
+	return nil
+}
+
+func (m *mockFinanceRepo) GetTopUpReview(ctx context.Context, id uuid.UUID) (*models.TopUpReview, error) {
+	if m.reviewByID == nil || m.reviewByID.ID != id {
+		return nil, models.ErrNotFound
+	}
+	return m.reviewByID, nil
+}
+
+func (m *mockFinanceRepo) ListTopUpReviews(ctx context.Context, status string) ([]models.TopUpReview, error) {
+	return nil, nil
+}
+
+func (m *mockFinanceRepo) DecideTopUpReview(ctx context.Context, id uuid.UUID, status string, reviewerID uuid.UUID, note string) error {
+	m.decidedReviewID, m.decidedStatus, m.decidedReviewer = id, status, reviewerID
+	return nil
+}
+
+func (m *mockFinanceRepo) UpdatePaymentSettlement(ctx context.Context, id uuid.UUID, method models.PaymentMethod, fee int64, paidAt time.Time) error {
+	m.settledMethod, m.settledFee, m.settledAt = method, fee, paidAt
+	return nil
+}
+
+func (m *mockFinanceRepo) CreateBalanceAdjustment(ctx context.Context, adjustment *models.BalanceAdjustment) error {
+	adjustment.ID = uuid.New()
+	m.createdAdjustment = adjustment
+	return nil
+}
+
+func (m *mockFinanceRepo) GetBalanceAdjustment(ctx context.Context, id uuid.UUID) (*models.BalanceAdjustment, error) {
+	if m.adjustmentByID == nil || m.adjustmentByID.ID != id {
+		return nil, models.ErrNotFound
+	}
+	return m.adjustmentByID, nil
+}
+
+func (m *mockFinanceRepo) ListBalanceAdjustments(ctx context.Context, status string) ([]models.BalanceAdjustment, error) {
+	return nil, nil
+}
+
+func (m *mockFinanceRepo) DecideBalanceAdjustment(ctx context.Context, id uuid.UUID, status string, deciderID uuid.UUID, note string, txID *uuid.UUID) error {
+	m.decidedAdjustment, m.adjustmentStatus, m.adjustmentDecider, m.adjustmentTxID = id, status, deciderID, txID
+	return nil
+}
+
+func (m *mockFinanceRepo) CreateMatchingCampaign(ctx context.Context, campaign *models.MatchingCampaign) error {
+	campaign.ID = uuid.New()
+	m.createdCampaign = campaign
+	return nil
+}
+
+func (m *mockFinanceRepo) GetMatchingCampaign(ctx context.Context, id uuid.UUID) (*models.MatchingCampaign, error) {
+	if m.campaignByID == nil {
+		return nil, models.ErrNotFound
+	}
+	return m.campaignByID, nil
+}
+
+func (m *mockFinanceRepo) ListMatchingCampaigns(ctx context.Context) ([]models.MatchingCampaign, error) {
+	return m.campaigns, nil
+}
+
+func (m *mockFinanceRepo) FindMatchingCampaigns(ctx context.Context, domain string, at time.Time) ([]models.MatchingCampaign, error) {
+	m.campaignDomain = domain
+	return m.campaigns, nil
+}
+
+func (m *mockFinanceRepo) UpdateMatchingCampaignStatus(ctx context.Context, id uuid.UUID, status string) error {
+	return nil
+}
+
+func (m *mockFinanceRepo) SpendMatchingBudget(ctx context.Context, id uuid.UUID, amount int64) error {
+	if m.spendErr != nil {
+		return m.spendErr
+	}
+	m.spentCampaign, m.spentAmount = id, amount
+	return nil
+}
+
+func (m *mockFinanceRepo) SumMatchedTrees(ctx context.Context, campaignID, userID uuid.UUID) (int64, error) {
+	return m.matchedTrees, nil
+}
+
+func (m *mockFinanceRepo) CreateCampaignMatch(ctx context.Context, match *models.CampaignMatch) error {
+	match.ID = uuid.New()
+	m.createdMatch = match
+	return nil
+}
+
+func (m *mockFinanceRepo) ListCampaignMatches(ctx context.Context, campaignID uuid.UUID) ([]models.CampaignMatch, error) {
+	return nil, nil
+}
+
+func (m *mockFinanceRepo) CreateBalanceHold(ctx context.Context, hold *models.BalanceHold) error {
+	m.createdHold = hold
+	if hold.TransactionID != nil && m.holdByTx == nil {
+		m.holdByTx = hold
+	}
+	return nil
+}
+
+func (m *mockFinanceRepo) GetBalanceHoldByTransactionID(ctx context.Context, txID uuid.UUID) (*models.BalanceHold, error) {
+	if m.holdByTx == nil {
+		return nil, models.ErrNotFound
+	}
+	return m.holdByTx, nil
+}
+
+func (m *mockFinanceRepo) GetBalanceHold(ctx context.Context, id uuid.UUID) (*models.BalanceHold, error) {
+	if m.holdByID == nil || m.holdByID.ID != id {
+		return nil, models.ErrNotFound
+	}
+	return m.holdByID, nil
+}
+
+func (m *mockFinanceRepo) ListBalanceHoldsByUserID(ctx context.Context, userID uuid.UUID) ([]models.BalanceHold, error) {
+	if m.holdByID == nil {
+		return nil, nil
+	}
+	return []models.BalanceHold{*m.holdByID}, nil
+}
+
+func (m *mockFinanceRepo) ListExpiredBalanceHolds(ctx context.Context, before time.Time) ([]models.BalanceHold, error) {
+	return m.expiredHolds, nil
+}
+
+func (m *mockFinanceRepo) SumHeldBalance(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return m.heldBalance, nil
+}
+
+func (m *mockFinanceRepo) SettleBalanceHold(ctx context.Context, id uuid.UUID, status string) error {
+	for _, h := range append([]*models.BalanceHold{m.holdByTx, m.holdByID}, holdPtrs(m.expiredHolds)...) {
+		if h != nil && h.ID == id && h.Status != "HELD" {
+			return models.ErrNotFound
+		}
+	}
+	m.settledHoldID, m.settledHoldStatus = id, status
+	m.settledHolds++
+	return nil
+}
+
+func (m *mockFinanceRepo) CaptureBalanceHold(ctx context.Context, id, txID uuid.UUID) error {
+	m.settledHoldID, m.settledHoldStatus, m.capturedHoldTxID = id, "CAPTURED", txID
+	return nil
+}
+
+func holdPtrs(holds []models.BalanceHold) []*models.BalanceHold {
+	ptrs := make([]*models.BalanceHold, len(holds))
+	for i := range holds {
+		ptrs[i] = &holds[i]
+	}
+	return ptrs
+}
+
 func (m *mockFinanceRepo) CreateTransaction(ctx context.Context, tx *models.Transaction) error {
 	m.createTx = tx
+	m.createdTxs = append(m.createdTxs, tx)
 	return m.createTxErr
 }
 
@@ -74,8 +354,27 @@ func (m *mockFinanceRepo) UpdateTransactionStatus(ctx context.Context, id uuid.U
 	return m.updateStatusErr
 }
 
-func (m *mockFinanceRepo) UpdateTransactionInvoiceDetails(ctx context.Context, id uuid.UUID, paymentURL string, expiresAt time.Time) error {
+// TransitionPaymentStatus checks the status stored in paymentStatus, and
+// treats payments not in it as being in any status.
+func (m *mockFinanceRepo) TransitionPaymentStatus(ctx context.Context, id uuid.UUID, status string, from ...string) (bool, error) {
+	if m.updateStatusErr != nil {
+		return false, m.updateStatusErr
+	}
+	if current, ok := m.paymentStatus[id]; ok && !slices.Contains(from, current) {
+		return false, nil
+	}
+	if m.paymentStatus == nil {
+		m.paymentStatus = make(map[uuid.UUID]string)
+	}
+	m.paymentStatus[id] = status
+	m.updatedStatusID = id
+	m.updatedStatusValue = status
+	return true, nil
+}
+
+func (m *mockFinanceRepo) UpdateTransactionInvoiceDetails(ctx context.Context, id uuid.UUID, invoiceID, paymentURL string, expiresAt time.Time) error {
 	m.invoiceUpdateID = id
+	m.invoiceUpdateInvID = invoiceID
 	m.invoiceUpdateURL = paymentURL
 	m.invoiceUpdateExp = expiresAt
 	return m.invoiceUpdateErr
@@ -85,12 +384,72 @@ func (m *mockFinanceRepo) GetPendingTransactionsBefore(ctx context.Context, expi
 	return m.pendingBefore, m.pendingBeforeErr
 }
 
+func (m *mockFinanceRepo) GetPendingInvoicesExpiringBetween(ctx context.Context, txType string, from, to time.Time) ([]models.Transaction, error) {
+	m.expiringFrom, m.expiringTo = from, to
+	return m.expiringInvoices, nil
+}
+
+func (m *mockFinanceRepo) ListPaymentReminders(ctx context.Context, txIDs []uuid.UUID) ([]models.PaymentReminder, error) {
+	return m.reminders, nil
+}
+
+func (m *mockFinanceRepo) CreatePaymentReminder(ctx context.Context, reminder *models.PaymentReminder) error {
+	m.createdReminders = append(m.createdReminders, *reminder)
+	return nil
+}
+
+func (m *mockFinanceRepo) GetSettledTransactionsByUserIDBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.Transaction, error) {
+	m.settledFrom, m.settledTo = from, to
+	return m.settledBetween, nil
+}
+
+func (m *mockFinanceRepo) GetReconciliationCandidates(ctx context.Context, expiredSince time.Time) ([]models.Transaction, error) {
+	m.candidatesSince = expiredSince
+	return m.candidates, nil
+}
+
+func (m *mockFinanceRepo) CreateReconciliationReport(ctx context.Context, report *models.ReconciliationReport) error {
+	m.savedReport = report
+	return nil
+}
+
+func (m *mockFinanceRepo) ListReconciliationReports(ctx context.Context, limit int) ([]models.ReconciliationReport, error) {
+	m.reportsLimit = limit
+	return m.reports, nil
+}
+
+func (m *mockFinanceRepo) CreateVoucher(ctx context.Context, voucher *models.Voucher) error {
+	m.createdVoucher = voucher
+	return nil
+}
+
+func (m *mockFinanceRepo) GetVoucherByCode(ctx context.Context, code string) (*models.Voucher, error) {
+	if m.voucher == nil || m.voucher.Code != code {
+		return nil, models.ErrNotFound
+	}
+	return m.voucher, nil
+}
+
+func (m *mockFinanceRepo) ListVouchers(ctx context.Context) ([]models.Voucher, error) {
+	return nil, nil
+}
+
+func (m *mockFinanceRepo) RedeemVoucher(ctx context.Context, redemption *models.VoucherRedemption, maxPerUser int) error {
+	m.redemption = redemption
+	return m.redeemErr
+}
+
+func (m *mockFinanceRepo) ReleaseVoucherRedemption(ctx context.Context, txID uuid.UUID) error {
+	m.releasedTxID = txID
+	return nil
+}
+
 func TestFinanceService_CreateTransaction_AdoptCreatesInvoiceWhenBalanceInsufficient(t *testing.T) {
 	repo := &mockFinanceRepo{getBalanceValue: 500}
 	svc := NewFinanceService(repo, "key", nil, nil)
 
 	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
-	body := fmt.Sprintf(`{"invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"%s"}`, expiry.Format(time.RFC3339))
+	body := fmt.Sprintf(`{"id":"inv-abc","invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"%s"}`, expiry.Format(time.RFC3339))
 
 	origTransport := http.DefaultTransport
 	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
@@ -126,6 +485,9 @@ func TestFinanceService_CreateTransaction_AdoptCreatesInvoiceWhenBalanceInsuffic
 	if repo.invoiceUpdateURL != "https://pay.xendit.co/abc" {
 		t.Fatalf("invoice URL not saved")
 	}
+	if repo.invoiceUpdateInvID != "inv-abc" || tx.Payment.ExternalID != "inv-abc" {
+		t.Fatalf("invoice ID not saved, got %q", repo.invoiceUpdateInvID)
+	}
 	if !repo.invoiceUpdateExp.Equal(expiry) {
 		t.Fatalf("expiry not saved correctly, got %v want %v", repo.invoiceUpdateExp, expiry)
 	}
@@ -134,6 +496,111 @@ func TestFinanceService_CreateTransaction_AdoptCreatesInvoiceWhenBalanceInsuffic
 	}
 }
 
+func TestFinanceService_CreateTransaction_AdoptHoldsBalanceAndInvoicesShortfall(t *testing.T) {
+	userID := uuid.New()
+	repo := &mockFinanceRepo{getBalanceValue: 30000}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	var sent xenditInvoiceRequest
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Fatalf("invalid invoice request: %v", err)
+		}
+		body := `{"id":"inv-1","invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"2030-01-01T00:00:00Z"}`
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	tx, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:      userID.String(),
+		Amount:      100000,
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "ref-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateTransaction() error = %v", err)
+	}
+
+	if sent.Amount != 70000 {
+		t.Fatalf("only the shortfall should be invoiced, got %v", sent.Amount)
+	}
+	if tx.Amount != 100000 || tx.Payment.Amount != 70000 || tx.Payment.Status != "PENDING" {
+		t.Fatalf("unexpected transaction: amount %d, invoiced %d, status %s", tx.Amount, tx.Payment.Amount, tx.Payment.Status)
+	}
+	if repo.debitUserID != userID || repo.debitAmount != 30000 {
+		t.Fatalf("wallet balance should be taken for the hold, got %d", repo.debitAmount)
+	}
+	if repo.createdHold == nil || repo.createdHold.Amount != 30000 || repo.createdHold.Status != "HELD" {
+		t.Fatalf("expected a HELD hold of 30000, got %+v", repo.createdHold)
+	}
+	if len(repo.events) != 0 {
+		t.Fatalf("adoption must wait for the invoice, got %+v", repo.events)
+	}
+}
+
+func TestFinanceService_CreateTransaction_InvoiceFailureReleasesHold(t *testing.T) {
+	userID := uuid.New()
+	voucher := &models.Voucher{ID: uuid.New(), Code: "EARTHDAY", DiscountType: "PERCENTAGE", Value: 10}
+	repo := &mockFinanceRepo{getBalanceValue: 30000, voucher: voucher}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(`{}`)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	_, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:      userID.String(),
+		Amount:      100000,
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "intent-1",
+		VoucherCode: "EARTHDAY",
+	})
+	if err == nil {
+		t.Fatalf("expected the invoice error")
+	}
+
+	tx := repo.createTx
+	if repo.paymentStatus[tx.ID] != "EXPIRED" {
+		t.Fatalf("transaction should be expired right away, got %q", repo.paymentStatus[tx.ID])
+	}
+	if repo.settledHoldStatus != "RELEASED" || repo.balanceUserID != userID || repo.balanceDelta != 30000 {
+		t.Fatalf("held balance should be given back, got %q and %d", repo.settledHoldStatus, repo.balanceDelta)
+	}
+	if repo.releasedTxID != tx.ID {
+		t.Fatalf("voucher redemption should be released")
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "payment.expired" {
+		t.Fatalf("the reservation should be released, got %+v", repo.events)
+	}
+}
+
+func TestFinanceService_CreateTransaction_AdoptEmptyWalletInvoicesFullAmount(t *testing.T) {
+	repo := &mockFinanceRepo{}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		body := `{"id":"inv-1","invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"2030-01-01T00:00:00Z"}`
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	tx, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId: uuid.New().String(),
+		Amount: 100000,
+		Type:   pb.TransactionType_ADOPT,
+	})
+	if err != nil {
+		t.Fatalf("CreateTransaction() error = %v", err)
+	}
+	if tx.Payment.Amount != 100000 || repo.createdHold != nil || repo.debitAmount != 0 {
+		t.Fatalf("nothing should be held from an empty wallet, got hold %+v", repo.createdHold)
+	}
+}
+
 func TestFinanceService_CreateTransaction_InvalidUser(t *testing.T) {
 	repo := &mockFinanceRepo{}
 	svc := NewFinanceService(repo, "key", nil, nil)
@@ -203,8 +670,8 @@ func TestFinanceService_TopUpWallet_Success(t *testing.T) {
 	defer func() { http.DefaultTransport = origTransport }()
 
 	userID := uuid.New()
-	amount := int64(2000)
-	tx, err := svc.TopUpWallet(context.Background(), userID, amount, 3600)
+	amount := int64(20000)
+	tx, err := svc.TopUpWallet(context.Background(), userID, amount, "", 3600, "", models.PaymentMethod{})
 	if err != nil {
 		t.Fatalf("TopUpWallet() error = %v", err)
 	}
@@ -233,7 +700,7 @@ func TestFinanceService_TopUpWallet_XenditErrorStatus(t *testing.T) {
 	})
 	defer func() { http.DefaultTransport = origTransport }()
 
-	_, err := svc.TopUpWallet(context.Background(), uuid.New(), 1000, 3600)
+	_, err := svc.TopUpWallet(context.Background(), uuid.New(), 10000, "", 3600, "", models.PaymentMethod{})
 	if err == nil {
 		t.Fatalf("expected error on non-200 status")
 	}
@@ -251,7 +718,7 @@ func TestFinanceService_TopUpWallet_InvalidExpiryFallsBack(t *testing.T) {
 	})
 	defer func() { http.DefaultTransport = origTransport }()
 
-	tx, err := svc.TopUpWallet(context.Background(), uuid.New(), 1000, 10)
+	tx, err := svc.TopUpWallet(context.Background(), uuid.New(), 10000, "", 600, "", models.PaymentMethod{})
 	if err != nil {
 		t.Fatalf("TopUpWallet error: %v", err)
 	}
@@ -272,7 +739,7 @@ func TestFinanceService_TopUpWallet_UpdateInvoiceError(t *testing.T) {
 	})
 	defer func() { http.DefaultTransport = origTransport }()
 
-	if _, err := svc.TopUpWallet(context.Background(), uuid.New(), 1000, 10); err == nil {
+	if _, err := svc.TopUpWallet(context.Background(), uuid.New(), 10000, "", 600, "", models.PaymentMethod{}); err == nil {
 		t.Fatalf("expected error when update invoice details fails")
 	}
 }
@@ -306,6 +773,186 @@ func TestFinanceService_HandleWalletWebhook_Paid(t *testing.T) {
 	}
 }
 
+func TestFinanceService_HandleWalletWebhook_PaidRecordsFee(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{txByID: &models.Transaction{
+		ID:       txID,
+		UserID:   uuid.New(),
+		Amount:   1500,
+		Currency: "USD",
+		Type:     "DEPOSIT",
+		Payment:  models.Payment{Amount: 1500, Status: "PENDING"},
+	}}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID","fees_paid_amount":0.45}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+	if repo.settledFee != 45 || repo.settledAt.IsZero() {
+		t.Fatalf("expected the 45 cent fee recorded with the payment time, got %d at %v", repo.settledFee, repo.settledAt)
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_PaidEmailsReceipt(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		getEmail: "sponsor@example.com",
+		txByID: &models.Transaction{
+			ID:      txID,
+			UserID:  uuid.New(),
+			Amount:  5000,
+			Type:    "DEPOSIT",
+			Payment: models.Payment{Status: "PENDING"},
+		},
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+
+	if sender.to != "sponsor@example.com" {
+		t.Fatalf("receipt should be emailed to sponsor, got %q", sender.to)
+	}
+	if len(sender.attachments) != 1 || sender.attachments[0].ContentType != "application/pdf" {
+		t.Fatalf("expected a PDF receipt attachment, got %+v", sender.attachments)
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_PaidCapturesHold(t *testing.T) {
+	txID, holdID := uuid.New(), uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:          txID,
+			UserID:      uuid.New(),
+			Amount:      100000,
+			Type:        "ADOPT",
+			ReferenceID: "intent-1",
+			Payment:     models.Payment{Amount: 70000, Status: "PENDING"},
+		},
+		holdByTx: &models.BalanceHold{ID: holdID, Amount: 30000, Currency: "IDR", Status: "HELD"},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+	if repo.settledHoldID != holdID || repo.settledHoldStatus != "CAPTURED" {
+		t.Fatalf("hold should be captured, got %s %q", repo.settledHoldID, repo.settledHoldStatus)
+	}
+	if repo.balanceDelta != 0 {
+		t.Fatalf("captured funds must not go back to the wallet, got %d", repo.balanceDelta)
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "payment.success" {
+		t.Fatalf("expected payment.success event, got %+v", repo.events)
+	}
+}
+
+func TestFinanceService_MarkPaid_SettlesOnce(t *testing.T) {
+	txID, userID := uuid.New(), uuid.New()
+	repo := &mockFinanceRepo{paymentStatus: map[uuid.UUID]string{txID: "PENDING"}}
+	svc := NewFinanceService(repo, "x", nil, nil).(*financeService)
+
+	// A duplicate webhook and a reconciliation run both read the invoice
+	// while it was still pending
+	for i := 0; i < 2; i++ {
+		tx := &models.Transaction{
+			ID:       txID,
+			UserID:   userID,
+			Amount:   50000,
+			Currency: "IDR",
+			Type:     "DEPOSIT",
+			Payment:  models.Payment{Amount: 50000, Status: "PENDING"},
+		}
+		if err := svc.markPaid(context.Background(), tx); err != nil {
+			t.Fatalf("markPaid() error = %v", err)
+		}
+	}
+	if repo.balanceUpdates != 1 || repo.balanceDelta != 50000 {
+		t.Fatalf("wallet should be credited once, got %d updates", repo.balanceUpdates)
+	}
+	if repo.paymentStatus[txID] != "SUCCESS" {
+		t.Fatalf("expected payment SUCCESS, got %q", repo.paymentStatus[txID])
+	}
+}
+
+func TestFinanceService_CreditLatePayment_CreditsOnce(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{paymentStatus: map[uuid.UUID]string{txID: "EXPIRED"}}
+	svc := NewFinanceService(repo, "x", nil, nil).(*financeService)
+
+	for i := 0; i < 2; i++ {
+		tx := &models.Transaction{
+			ID:       txID,
+			UserID:   uuid.New(),
+			Amount:   75000,
+			Currency: "IDR",
+			Type:     "ADOPT",
+			Payment:  models.Payment{Amount: 75000, Status: "EXPIRED", FeeAmount: 4995},
+		}
+		if err := svc.creditLatePayment(context.Background(), tx); err != nil {
+			t.Fatalf("creditLatePayment() error = %v", err)
+		}
+	}
+	if repo.balanceUpdates != 1 || len(repo.createdTxs) != 1 {
+		t.Fatalf("late payment should be credited once, got %d updates and %d deposits", repo.balanceUpdates, len(repo.createdTxs))
+	}
+	if repo.settledFee != 4995 || repo.createdTxs[0].Payment.FeeAmount != 4995 || repo.createdTxs[0].Payment.PaidAt == nil {
+		t.Fatalf("the provider fee should be recorded and carried by the deposit, got %+v", repo.createdTxs[0].Payment)
+	}
+}
+
+func TestFinanceService_MarkPaid_AdoptionExpiredMeanwhile(t *testing.T) {
+	txID := uuid.New()
+	// the webhook read the invoice as pending, then the expiry job won
+	repo := &mockFinanceRepo{paymentStatus: map[uuid.UUID]string{txID: "EXPIRED"}}
+	svc := NewFinanceService(repo, "x", nil, nil).(*financeService)
+
+	tx := &models.Transaction{
+		ID:          txID,
+		UserID:      uuid.New(),
+		Amount:      75000,
+		Currency:    "IDR",
+		Type:        "ADOPT",
+		ReferenceID: "intent-1",
+		Payment:     models.Payment{Amount: 75000, Status: "PENDING"},
+	}
+	if err := svc.markPaid(context.Background(), tx); err != nil {
+		t.Fatalf("markPaid() error = %v", err)
+	}
+	if repo.paymentStatus[txID] != "CREDITED" || repo.balanceDelta != 75000 {
+		t.Fatalf("payment should be credited to the wallet, got %q and %d", repo.paymentStatus[txID], repo.balanceDelta)
+	}
+	if len(repo.events) != 0 {
+		t.Fatalf("an expired adoption must not be completed, got %+v", repo.events)
+	}
+}
+
+func TestFinanceService_ExpireTransaction_PaidMeanwhile(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		paymentStatus: map[uuid.UUID]string{txID: "SUCCESS"},
+		holdByTx:      &models.BalanceHold{ID: uuid.New(), Amount: 30000, Currency: "IDR", Status: "HELD"},
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil).(*financeService)
+
+	tx := &models.Transaction{ID: txID, Type: "ADOPT", ReferenceID: "intent-1", Payment: models.Payment{Status: "PENDING"}}
+	if err := svc.expireTransaction(context.Background(), tx, noticePaymentExpired); err != nil {
+		t.Fatalf("expireTransaction() error = %v", err)
+	}
+	if repo.paymentStatus[txID] != "SUCCESS" || tx.Payment.Status != "PENDING" {
+		t.Fatalf("a paid invoice must not be expired, got %q", repo.paymentStatus[txID])
+	}
+	if len(repo.events) != 0 || repo.settledHolds != 0 || sender.to != "" {
+		t.Fatalf("nothing should be released or sent, got %+v", repo.events)
+	}
+}
+
 func TestFinanceService_HandleWalletWebhook_InvalidJSON(t *testing.T) {
 	repo := &mockFinanceRepo{}
 	svc := NewFinanceService(repo, "x", nil, nil)
@@ -353,6 +1000,97 @@ func TestFinanceService_HandleWalletWebhook_Expired(t *testing.T) {
 	}
 }
 
+func TestFinanceService_HandleWalletWebhook_ExpiredReleasesAdoption(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:          txID,
+			Type:        "ADOPT",
+			ReferenceID: "intent-1",
+			VoucherCode: "TREES10",
+			Payment:     models.Payment{Status: "PENDING"},
+		},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"EXPIRED"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+	if repo.releasedTxID != txID {
+		t.Fatalf("voucher redemption should be released")
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "payment.expired" {
+		t.Fatalf("expected payment.expired event, got %+v", repo.events)
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_ExpiredIgnoresSettled(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{ID: txID, Type: "ADOPT", Payment: models.Payment{Status: "SUCCESS"}},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"EXPIRED"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+	if repo.updatedStatusValue != "" || len(repo.events) != 0 {
+		t.Fatalf("settled payment must not be expired, got status %q", repo.updatedStatusValue)
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_PaidAfterExpiryCreditsWallet(t *testing.T) {
+	txID, userID := uuid.New(), uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:          txID,
+			UserID:      userID,
+			Amount:      75000,
+			Currency:    "IDR",
+			Type:        "ADOPT",
+			ReferenceID: "intent-1",
+			Payment:     models.Payment{Amount: 75000, Status: "EXPIRED"},
+		},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID","currency":"IDR"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+
+	if repo.updatedStatusValue != "CREDITED" {
+		t.Fatalf("expected payment marked CREDITED, got %q", repo.updatedStatusValue)
+	}
+	if repo.balanceUserID != userID || repo.balanceDelta != 75000 {
+		t.Fatalf("wallet should be credited 75000, got %d for %s", repo.balanceDelta, repo.balanceUserID)
+	}
+	if repo.createTx == nil || repo.createTx.Type != "DEPOSIT" || repo.createTx.ReferenceID != txID.String() {
+		t.Fatalf("expected a deposit referencing the late payment, got %+v", repo.createTx)
+	}
+	if len(repo.events) != 0 {
+		t.Fatalf("a late payment must not complete the adoption, got %+v", repo.events)
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_PaidAfterCreditIgnored(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{ID: txID, Type: "ADOPT", Payment: models.Payment{Status: "CREDITED"}},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"SETTLED"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+	if repo.balanceDelta != 0 || repo.createTx != nil {
+		t.Fatalf("a credited payment must not be credited twice")
+	}
+}
+
 func TestFinanceService_HandleWalletWebhook_UnknownStatus(t *testing.T) {
 	repo := &mockFinanceRepo{}
 	svc := NewFinanceService(repo, "x", nil, nil)
@@ -388,17 +1126,166 @@ func TestFinanceService_CheckPaymentExpiry(t *testing.T) {
 	}
 }
 
+func TestFinanceService_CheckPaymentExpiry_ReleasesHold(t *testing.T) {
+	userID, holdID := uuid.New(), uuid.New()
+	repo := &mockFinanceRepo{
+		pendingBefore: []models.Transaction{
+			{ID: uuid.New(), UserID: userID, Type: "ADOPT", ReferenceID: "intent-1", Payment: models.Payment{Status: "PENDING"}},
+		},
+		holdByTx: &models.BalanceHold{ID: holdID, UserID: userID, Amount: 30000, Currency: "IDR", Status: "HELD"},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if err := svc.CheckPaymentExpiry(context.Background()); err != nil {
+		t.Fatalf("CheckPaymentExpiry() error = %v", err)
+	}
+	if repo.settledHoldID != holdID || repo.settledHoldStatus != "RELEASED" {
+		t.Fatalf("hold should be released, got %s %q", repo.settledHoldID, repo.settledHoldStatus)
+	}
+	if repo.balanceUserID != userID || repo.balanceDelta != 30000 || repo.balanceCurrency != "IDR" {
+		t.Fatalf("held funds should be returned to the wallet, got %d %s", repo.balanceDelta, repo.balanceCurrency)
+	}
+}
+
+func TestFinanceService_CheckPaymentExpiry_SettledHoldNotReturnedTwice(t *testing.T) {
+	repo := &mockFinanceRepo{
+		pendingBefore: []models.Transaction{
+			{ID: uuid.New(), Type: "ADOPT", Payment: models.Payment{Status: "PENDING"}},
+		},
+		holdByTx: &models.BalanceHold{ID: uuid.New(), Amount: 30000, Currency: "IDR", Status: "RELEASED"},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if err := svc.CheckPaymentExpiry(context.Background()); err != nil {
+		t.Fatalf("CheckPaymentExpiry() error = %v", err)
+	}
+	if repo.balanceDelta != 0 {
+		t.Fatalf("a released hold must not be credited again, got %d", repo.balanceDelta)
+	}
+}
+
+// mockXenditExpire answers invoice expiry calls with status and records the
+// URLs called.
+func mockXenditExpire(t *testing.T, status int) *[]string {
+	var called []string
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected %s %s", r.Method, r.URL)
+		}
+		called = append(called, r.URL.String())
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(`{}`)), Header: make(http.Header)}, nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+	return &called
+}
+
+func TestFinanceService_CheckPaymentExpiry_ExpiresInvoiceAtProvider(t *testing.T) {
+	repo := &mockFinanceRepo{
+		pendingBefore: []models.Transaction{
+			{ID: uuid.New(), Type: "DEPOSIT", Payment: models.Payment{Status: "PENDING", ExternalID: "inv-1", PaymentURL: "https://pay.xendit.co/1"}},
+			{ID: uuid.New(), Type: "ADOPT", Payment: models.Payment{Status: "PENDING"}},
+		},
+	}
+	called := mockXenditExpire(t, http.StatusOK)
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if err := svc.CheckPaymentExpiry(context.Background()); err != nil {
+		t.Fatalf("CheckPaymentExpiry() error = %v", err)
+	}
+	if len(*called) != 1 || (*called)[0] != "https://api.xendit.co/invoices/inv-1/expire!" {
+		t.Fatalf("expected only the invoice to be expired at the provider, got %v", *called)
+	}
+}
+
+func TestFinanceService_CheckPaymentExpiry_ProviderFailureStillExpires(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		pendingBefore: []models.Transaction{
+			{ID: txID, Type: "DEPOSIT", Payment: models.Payment{Status: "PENDING", ExternalID: "inv-1", PaymentURL: "https://pay.xendit.co/1"}},
+		},
+	}
+	mockXenditExpire(t, http.StatusInternalServerError)
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if err := svc.CheckPaymentExpiry(context.Background()); err != nil {
+		t.Fatalf("CheckPaymentExpiry() error = %v", err)
+	}
+	if repo.updatedStatusID != txID || repo.updatedStatusValue != "EXPIRED" {
+		t.Fatalf("payment should be expired locally, got %q", repo.updatedStatusValue)
+	}
+}
+
+func TestFinanceService_CancelPayment(t *testing.T) {
+	userID, txID := uuid.New(), uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:          txID,
+			UserID:      userID,
+			Type:        "ADOPT",
+			ReferenceID: "intent-1",
+			Payment:     models.Payment{Status: "PENDING", ExternalID: "inv-1", PaymentURL: "https://pay.xendit.co/1"},
+		},
+	}
+	called := mockXenditExpire(t, http.StatusOK)
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	tx, err := svc.CancelPayment(context.Background(), userID, txID)
+	if err != nil {
+		t.Fatalf("CancelPayment() error = %v", err)
+	}
+	if len(*called) != 1 {
+		t.Fatalf("invoice should be expired at the provider, got %v", *called)
+	}
+	if tx.Payment.Status != "EXPIRED" || repo.updatedStatusValue != "EXPIRED" {
+		t.Fatalf("payment should be expired, got %q", tx.Payment.Status)
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "payment.expired" {
+		t.Fatalf("expected payment.expired event, got %+v", repo.events)
+	}
+}
+
+func TestFinanceService_CancelPayment_Rejected(t *testing.T) {
+	userID := uuid.New()
+	tests := []struct {
+		name    string
+		tx      models.Transaction
+		status  int
+		wantErr error
+	}{
+		{"other user", models.Transaction{UserID: uuid.New(), Payment: models.Payment{Status: "PENDING"}}, http.StatusOK, models.ErrNotFound},
+		{"already settled", models.Transaction{UserID: userID, Payment: models.Payment{Status: "SUCCESS"}}, http.StatusOK, models.ErrInvalidInput},
+		{"provider failure", models.Transaction{UserID: userID, Payment: models.Payment{Status: "PENDING", ExternalID: "inv-1", PaymentURL: "https://pay.xendit.co/1"}}, http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.tx.ID = uuid.New()
+			repo := &mockFinanceRepo{txByID: &tt.tx}
+			mockXenditExpire(t, tt.status)
+			svc := NewFinanceService(repo, "x", nil, nil)
+
+			_, err := svc.CancelPayment(context.Background(), userID, tt.tx.ID)
+			if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+				t.Fatalf("CancelPayment() error = %v, want %v", err, tt.wantErr)
+			}
+			if repo.updatedStatusValue != "" {
+				t.Fatalf("payment must stay pending, got %q", repo.updatedStatusValue)
+			}
+		})
+	}
+}
+
 func TestFinanceService_GetBalance(t *testing.T) {
 	repo := &mockFinanceRepo{getBalanceValue: 1234}
 	svc := NewFinanceService(repo, "x", nil, nil)
 
 	uid := uuid.New()
-	bal, err := svc.GetBalance(context.Background(), uid)
+	bal, err := svc.GetBalance(context.Background(), uid, "")
 	if err != nil {
 		t.Fatalf("GetBalance() error = %v", err)
 	}
-	if bal != 1234 {
-		t.Fatalf("balance mismatch, got %v", bal)
+	if bal.Balance != 1234 || bal.Currency != "IDR" || bal.DisplayBalance != 1234 {
+		t.Fatalf("balance mismatch, got %+v", bal)
 	}
 }
 
@@ -420,3 +1307,213 @@ func TestFinanceService_GetTransactionHistory(t *testing.T) {
 		t.Fatalf("expected 2 transactions, got %d", len(txs))
 	}
 }
+
+func testRates(t *testing.T) *money.Rates {
+	rates, err := money.ParseRates("IDR", "USD=16000")
+	if err != nil {
+		t.Fatalf("ParseRates() error = %v", err)
+	}
+	return rates
+}
+
+func TestFinanceService_CreateTransaction_AdoptConvertsPriceToWalletCurrency(t *testing.T) {
+	repo := &mockFinanceRepo{}
+	svc := NewFinanceService(repo, "x", nil, testRates(t))
+
+	var sent xenditInvoiceRequest
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Fatalf("invalid invoice request: %v", err)
+		}
+		body := `{"invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"2030-01-01T00:00:00Z"}`
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	tx, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:      uuid.New().String(),
+		Amount:      1000, // USD 10.00
+		Currency:    "USD",
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "ref-usd",
+	})
+	if err != nil {
+		t.Fatalf("CreateTransaction() error = %v", err)
+	}
+
+	if tx.Amount != 160000 || tx.Currency != "IDR" {
+		t.Fatalf("expected charge of IDR 160.000, got %d %s", tx.Amount, tx.Currency)
+	}
+	if tx.OriginalAmount != 1000 || tx.OriginalCurrency != "USD" || tx.ExchangeRate != "16000" {
+		t.Fatalf("original price not recorded: %+v", tx)
+	}
+	if sent.Amount != 160000 || sent.Currency != "IDR" {
+		t.Fatalf("invoice should be issued in the wallet currency, got %v %s", sent.Amount, sent.Currency)
+	}
+}
+
+func TestFinanceService_CreateTransaction_AdoptWithoutRateRejected(t *testing.T) {
+	repo := &mockFinanceRepo{getBalanceValue: 200000}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	_, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:   uuid.New().String(),
+		Amount:   1000,
+		Currency: "USD",
+		Type:     pb.TransactionType_ADOPT,
+	})
+	if !errors.Is(err, models.ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if repo.createTx != nil {
+		t.Fatalf("no transaction should be created")
+	}
+}
+
+func TestFinanceService_TopUpWallet_CurrencyMismatch(t *testing.T) {
+	repo := &mockFinanceRepo{}
+	svc := NewFinanceService(repo, "x", nil, testRates(t))
+
+	_, err := svc.TopUpWallet(context.Background(), uuid.New(), 1000, "USD", 3600, "", models.PaymentMethod{})
+	if !errors.Is(err, models.ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if repo.createTx != nil {
+		t.Fatalf("no transaction should be created")
+	}
+}
+
+func TestFinanceService_TopUpWallet_InvoicesInWalletCurrency(t *testing.T) {
+	repo := &mockFinanceRepo{getBalanceCurrency: "USD"}
+	svc := NewFinanceService(repo, "x", nil, testRates(t))
+
+	var sent xenditInvoiceRequest
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Fatalf("invalid invoice request: %v", err)
+		}
+		body := `{"invoice_url":"https://pay.xendit.co/usd","status":"PENDING","expiry_date":"2030-01-01T00:00:00Z"}`
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	tx, err := svc.TopUpWallet(context.Background(), uuid.New(), 1050, "usd", 3600, "", models.PaymentMethod{})
+	if err != nil {
+		t.Fatalf("TopUpWallet() error = %v", err)
+	}
+	if tx.Currency != "USD" || tx.Amount != 1050 {
+		t.Fatalf("transaction should be stored in USD cents, got %d %s", tx.Amount, tx.Currency)
+	}
+	if sent.Currency != "USD" || sent.Amount != 10.5 {
+		t.Fatalf("invoice should be issued as USD 10.50, got %v %s", sent.Amount, sent.Currency)
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_CurrencyMismatch(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:       txID,
+			UserID:   uuid.New(),
+			Amount:   5000,
+			Currency: "IDR",
+			Type:     "DEPOSIT",
+			Payment:  models.Payment{Status: "PENDING"},
+		},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID","currency":"USD"}`
+	err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload))
+	if !errors.Is(err, models.ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if repo.updatedStatusValue != "" || repo.balanceDelta != 0 {
+		t.Fatalf("mismatched payment must not settle the transaction")
+	}
+}
+
+func TestFinanceService_GetBalance_DisplayCurrency(t *testing.T) {
+	repo := &mockFinanceRepo{getBalanceValue: 160000}
+	svc := NewFinanceService(repo, "x", nil, testRates(t))
+
+	bal, err := svc.GetBalance(context.Background(), uuid.New(), "usd")
+	if err != nil {
+		t.Fatalf("GetBalance() error = %v", err)
+	}
+	if bal.Balance != 160000 || bal.Currency != "IDR" {
+		t.Fatalf("wallet balance should stay in IDR, got %+v", bal)
+	}
+	if bal.DisplayBalance != 1000 || bal.DisplayCurrency != "USD" {
+		t.Fatalf("expected display balance of USD 10.00, got %+v", bal)
+	}
+
+	if _, err := svc.GetBalance(context.Background(), uuid.New(), "SGD"); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput without an SGD rate, got %v", err)
+	}
+}
+
+func TestFinanceService_CreateTransaction_AdoptFromWalletEnqueuesSuccess(t *testing.T) {
+	repo := &mockFinanceRepo{getBalanceValue: 5000}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	userID := uuid.New()
+	_, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:      userID.String(),
+		Amount:      1500,
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "intent-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateTransaction() error = %v", err)
+	}
+
+	if repo.debitUserID != userID || repo.debitAmount != 1500 {
+		t.Fatalf("expected wallet debited 1500, got %d", repo.debitAmount)
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "payment.success" {
+		t.Fatalf("expected payment.success in the outbox, got %+v", repo.events)
+	}
+	if ref := repo.events[0].payload.(map[string]string)["reference_id"]; ref != "intent-1" {
+		t.Fatalf("unexpected reference_id %s", ref)
+	}
+}
+
+func TestFinanceService_CreateTransaction_AdoptFromWalletSpentMeanwhile(t *testing.T) {
+	// the balance covered the price when read, but another purchase spent
+	// it before the debit, so the conditional debit matches no row
+	repo := &mockFinanceRepo{getBalanceValue: 5000, debitErr: models.ErrInsufficientBalance}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	_, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:      uuid.New().String(),
+		Amount:      1500,
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "intent-1",
+	})
+	if !errors.Is(err, models.ErrInsufficientBalance) {
+		t.Fatalf("expected ErrInsufficientBalance, got %v", err)
+	}
+	if repo.createTx != nil || len(repo.events) != 0 || repo.balanceDelta != 0 {
+		t.Fatalf("nothing should be written when the debit fails")
+	}
+}
+
+func TestFinanceService_CreateTransaction_AdoptFromWalletRollsBack(t *testing.T) {
+	repo := &mockFinanceRepo{getBalanceValue: 5000, txErr: errors.New("serialization failure")}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	_, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId: uuid.New().String(),
+		Amount: 1500,
+		Type:   pb.TransactionType_ADOPT,
+	})
+	if err == nil {
+		t.Fatalf("expected transaction error")
+	}
+	if repo.createTx != nil || len(repo.events) != 0 {
+		t.Fatalf("nothing should be written when the transaction fails")
+	}
+}
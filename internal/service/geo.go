@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const maxPlotSearchRadius = 500_000 // metres
+
+// Plot outlines are at most a few kilometres across, so their geometry is
+// checked on a flat [longitude, latitude] plane.
+
+func validCoordinate(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// plotGeometry validates the location and boundary of a plot request and
+// converts them to GeoJSON. A boundary without a location gets one at its
+// middle.
+func plotGeometry(req *pb.Plot) (*models.GeoPoint, *models.GeoPolygon, error) {
+	var location *models.GeoPoint
+	if l := req.Location; l != nil {
+		if !validCoordinate(l.Latitude, l.Longitude) {
+			return nil, nil, fmt.Errorf("%w: location is not a valid coordinate", models.ErrInvalidInput)
+		}
+		location = models.NewGeoPoint(l.Longitude, l.Latitude)
+	}
+	if len(req.Boundary) == 0 {
+		return location, nil, nil
+	}
+
+	ring := make([][]float64, 0, len(req.Boundary))
+	for _, p := range req.Boundary {
+		if !validCoordinate(p.Latitude, p.Longitude) {
+			return nil, nil, fmt.Errorf("%w: boundary has an invalid coordinate", models.ErrInvalidInput)
+		}
+		pos := []float64{p.Longitude, p.Latitude}
+		if len(ring) > 0 && samePosition(ring[len(ring)-1], pos) {
+			continue
+		}
+		ring = append(ring, pos)
+	}
+	if len(ring) > 1 && samePosition(ring[0], ring[len(ring)-1]) {
+		ring = ring[:len(ring)-1]
+	}
+	if len(ring) < 3 || ringArea(ring) == 0 {
+		return nil, nil, fmt.Errorf("%w: boundary needs at least three corners enclosing an area", models.ErrInvalidInput)
+	}
+	if ringSelfIntersects(ring) {
+		return nil, nil, fmt.Errorf("%w: boundary crosses itself", models.ErrInvalidInput)
+	}
+
+	if location == nil {
+		mid, ok := interiorPoint(ring)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: boundary encloses no area", models.ErrInvalidInput)
+		}
+		location = models.NewGeoPoint(mid[0], mid[1])
+	} else if !insideRing(location.Coordinates, ring) && !onRing(location.Coordinates, ring) {
+		return nil, nil, fmt.Errorf("%w: location lies outside the boundary", models.ErrInvalidInput)
+	}
+	return location, models.NewGeoPolygon(append(ring, ring[0])), nil
+}
+
+// checkPlotOverlap rejects a plot whose boundary overlaps another plot's.
+// Plots may share edges and corners.
+func (s *treeManagementService) checkPlotOverlap(ctx context.Context, plot *models.Plot) error {
+	if plot.Boundary == nil {
+		return nil
+	}
+	candidates, err := s.repo.FindPlotsIntersecting(ctx, plot.Boundary, plot.ID)
+	if err != nil {
+		return err
+	}
+	ring := openRing(plot.Boundary)
+	for _, other := range candidates {
+		if other.Boundary != nil && ringsOverlap(ring, openRing(other.Boundary)) {
+			return fmt.Errorf("%w: boundary overlaps plot %s (%s)", models.ErrAlreadyExists, other.LocationName, other.ID.Hex())
+		}
+	}
+	return nil
+}
+
+// treePlacement validates where a tree in plotID stands and converts it.
+// The location must lie within the plot's boundary; for plots without one
+// only the coordinate itself is checked. Either may be nil.
+func (s *treeManagementService) treePlacement(ctx context.Context, plotID primitive.ObjectID, loc *pb.GeoPoint, pos *pb.GridPosition) (*models.GeoPoint, *models.GridPosition, error) {
+	var location *models.GeoPoint
+	if loc != nil {
+		if !validCoordinate(loc.Latitude, loc.Longitude) {
+			return nil, nil, fmt.Errorf("%w: location is not a valid coordinate", models.ErrInvalidInput)
+		}
+		plot, err := s.repo.GetPlot(ctx, plotID)
+		if err != nil {
+			return nil, nil, err
+		}
+		location = models.NewGeoPoint(loc.Longitude, loc.Latitude)
+		if plot.Boundary != nil {
+			ring := openRing(plot.Boundary)
+			if !insideRing(location.Coordinates, ring) && !onRing(location.Coordinates, ring) {
+				return nil, nil, fmt.Errorf("%w: location lies outside the boundary of plot %s", models.ErrInvalidInput, plot.LocationName)
+			}
+		}
+	}
+	var position *models.GridPosition
+	if pos != nil {
+		if pos.Row < 1 || pos.Column < 1 {
+			return nil, nil, fmt.Errorf("%w: row and column are counted from 1", models.ErrInvalidInput)
+		}
+		position = &models.GridPosition{Row: int(pos.Row), Column: int(pos.Column)}
+	}
+	return location, position, nil
+}
+
+// openRing returns the outer ring of a polygon without its closing position.
+func openRing(p *models.GeoPolygon) [][]float64 {
+	if len(p.Coordinates) == 0 || len(p.Coordinates[0]) == 0 {
+		return nil
+	}
+	ring := p.Coordinates[0]
+	return ring[:len(ring)-1]
+}
+
+func samePosition(a, b []float64) bool {
+	return a[0] == b[0] && a[1] == b[1]
+}
+
+// cross is positive when c lies left of the line from a to b, negative when
+// right of it and zero when on it.
+func cross(a, b, c []float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+func ringArea(ring [][]float64) float64 {
+	var sum float64
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%len(ring)]
+		sum += a[0]*b[1] - b[0]*a[1]
+	}
+	return math.Abs(sum) / 2
+}
+
+// onSegment reports whether p lies on the segment from a to b.
+func onSegment(p, a, b []float64) bool {
+	return cross(a, b, p) == 0 &&
+		p[0] >= math.Min(a[0], b[0]) && p[0] <= math.Max(a[0], b[0]) &&
+		p[1] >= math.Min(a[1], b[1]) && p[1] <= math.Max(a[1], b[1])
+}
+
+// segmentsCross reports whether two segments cross at a point inside both.
+func segmentsCross(a, b, c, d []float64) bool {
+	return cross(a, b, c)*cross(a, b, d) < 0 && cross(c, d, a)*cross(c, d, b) < 0
+}
+
+// segmentsTouch reports whether two segments share any point.
+func segmentsTouch(a, b, c, d []float64) bool {
+	return segmentsCross(a, b, c, d) ||
+		onSegment(c, a, b) || onSegment(d, a, b) || onSegment(a, c, d) || onSegment(b, c, d)
+}
+
+// ringSelfIntersects reports whether any two non-adjacent edges of ring
+// meet.
+func ringSelfIntersects(ring [][]float64) bool {
+	n := len(ring)
+	for i := 0; i < n; i++ {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue // adjacent through the closing edge
+			}
+			if segmentsTouch(ring[i], ring[(i+1)%n], ring[j], ring[(j+1)%n]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// onRing reports whether p lies on an edge of ring.
+func onRing(p []float64, ring [][]float64) bool {
+	for i := range ring {
+		if onSegment(p, ring[i], ring[(i+1)%len(ring)]) {
+			return true
+		}
+	}
+	return false
+}
+
+// insideRing reports whether p lies strictly inside ring.
+func insideRing(p []float64, ring [][]float64) bool {
+	if onRing(p, ring) {
+		return false
+	}
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// interiorPoint finds a point strictly inside ring: its vertex average when
+// that is inside, otherwise the centre of one of its corners.
+func interiorPoint(ring [][]float64) ([]float64, bool) {
+	var mid [2]float64
+	for _, p := range ring {
+		mid[0] += p[0] / float64(len(ring))
+		mid[1] += p[1] / float64(len(ring))
+	}
+	if insideRing(mid[:], ring) {
+		return mid[:], true
+	}
+	n := len(ring)
+	for i := range ring {
+		a, b, c := ring[(i+n-1)%n], ring[i], ring[(i+1)%n]
+		p := []float64{(a[0] + b[0] + c[0]) / 3, (a[1] + b[1] + c[1]) / 3}
+		if insideRing(p, ring) {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// ringsOverlap reports whether two rings share any area, as opposed to only
+// edges or corners.
+func ringsOverlap(a, b [][]float64) bool {
+	for i := range a {
+		for j := range b {
+			if segmentsCross(a[i], a[(i+1)%len(a)], b[j], b[(j+1)%len(b)]) {
+				return true
+			}
+		}
+	}
+	return pointsInside(a, b) || pointsInside(b, a)
+}
+
+// pointsInside reports whether a vertex, edge midpoint or interior point of
+// a lies strictly inside b.
+func pointsInside(a, b [][]float64) bool {
+	for i := range a {
+		next := a[(i+1)%len(a)]
+		mid := []float64{(a[i][0] + next[0]) / 2, (a[i][1] + next[1]) / 2}
+		if insideRing(a[i], b) || insideRing(mid, b) {
+			return true
+		}
+	}
+	p, ok := interiorPoint(a)
+	return ok && insideRing(p, b)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func square(minLng, minLat, size float64) []*pb.GeoPoint {
+	return []*pb.GeoPoint{
+		{Longitude: minLng, Latitude: minLat},
+		{Longitude: minLng + size, Latitude: minLat},
+		{Longitude: minLng + size, Latitude: minLat + size},
+		{Longitude: minLng, Latitude: minLat + size},
+	}
+}
+
+func TestPlotGeometry(t *testing.T) {
+	t.Run("closes the boundary and places the location inside", func(t *testing.T) {
+		location, boundary, err := plotGeometry(&pb.Plot{Boundary: square(106.8, -6.2, 0.01)})
+		if err != nil {
+			t.Fatalf("plotGeometry() error = %v", err)
+		}
+		ring := boundary.Coordinates[0]
+		if boundary.Type != "Polygon" || len(ring) != 5 || !samePosition(ring[0], ring[4]) {
+			t.Fatalf("expected a closed ring of five positions, got %+v", boundary)
+		}
+		if !insideRing(location.Coordinates, openRing(boundary)) {
+			t.Fatalf("location %v should lie inside the boundary", location.Coordinates)
+		}
+	})
+
+	t.Run("keeps a given location", func(t *testing.T) {
+		location, _, err := plotGeometry(&pb.Plot{
+			Location: &pb.GeoPoint{Latitude: -6.199, Longitude: 106.801},
+			Boundary: square(106.8, -6.2, 0.01),
+		})
+		if err != nil {
+			t.Fatalf("plotGeometry() error = %v", err)
+		}
+		if location.Coordinates[0] != 106.801 || location.Coordinates[1] != -6.199 {
+			t.Fatalf("unexpected location %v", location.Coordinates)
+		}
+	})
+
+	for name, req := range map[string]*pb.Plot{
+		"invalid location": {Location: &pb.GeoPoint{Latitude: 91}},
+		"too few corners":  {Boundary: square(106.8, -6.2, 0.01)[:2]},
+		"no area":          {Boundary: []*pb.GeoPoint{{Longitude: 1}, {Longitude: 2}, {Longitude: 3}}},
+		"crosses itself":   {Boundary: []*pb.GeoPoint{{Longitude: 0, Latitude: 0}, {Longitude: 1, Latitude: 1}, {Longitude: 1, Latitude: 0}, {Longitude: 0, Latitude: 1}}},
+		"location outside": {Location: &pb.GeoPoint{Latitude: -6.1, Longitude: 106.8}, Boundary: square(106.8, -6.2, 0.01)},
+		"invalid corner":   {Boundary: []*pb.GeoPoint{{Longitude: 181}, {Longitude: 2}, {Longitude: 2, Latitude: 1}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := plotGeometry(req); !errors.Is(err, models.ErrInvalidInput) {
+				t.Fatalf("expected ErrInvalidInput, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRingsOverlap(t *testing.T) {
+	ring := func(points []*pb.GeoPoint) [][]float64 {
+		r := make([][]float64, len(points))
+		for i, p := range points {
+			r[i] = []float64{p.Longitude, p.Latitude}
+		}
+		return r
+	}
+	a := ring(square(0, 0, 2))
+
+	for name, c := range map[string]struct {
+		b    [][]float64
+		want bool
+	}{
+		"shared edge":   {ring(square(2, 0, 2)), false},
+		"shared corner": {ring(square(2, 2, 2)), false},
+		"apart":         {ring(square(5, 5, 1)), false},
+		"identical":     {ring(square(0, 0, 2)), true},
+		"crossing":      {ring(square(1, 1, 2)), true},
+		"contained":     {ring(square(0.5, 0.5, 1)), true},
+		"containing":    {ring(square(-1, -1, 4)), true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := ringsOverlap(a, c.b); got != c.want {
+				t.Fatalf("ringsOverlap() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestTreeManagementService_CreatePlot_RejectsOverlap(t *testing.T) {
+	location, boundary, err := plotGeometry(&pb.Plot{Boundary: square(106.8, -6.2, 0.01)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &mockTreeRepo{plots: []*models.Plot{{ID: primitive.NewObjectID(), LocationName: "Bogor", Location: location, Boundary: boundary}}}
+	svc := NewTreeManagementService(repo, nil, nil, nil, nil)
+
+	_, err = svc.CreatePlot(context.Background(), &pb.Plot{LocationName: "Depok", Boundary: square(106.805, -6.195, 0.01)})
+	if !errors.Is(err, models.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists, got %v", err)
+	}
+	if repo.plot != nil {
+		t.Fatalf("overlapping plot should not be stored")
+	}
+
+	// a neighbour sharing the eastern edge is fine
+	if _, err := svc.CreatePlot(context.Background(), &pb.Plot{LocationName: "Depok", Boundary: square(106.81, -6.2, 0.01)}); err != nil {
+		t.Fatalf("CreatePlot() error = %v", err)
+	}
+}
+
+func TestTreeManagementService_FindPlotsWithin(t *testing.T) {
+	repo := &mockTreeRepo{}
+	svc := NewTreeManagementService(repo, nil, nil, nil, nil)
+
+	if _, err := svc.FindPlotsWithin(context.Background(), &pb.FindPlotsWithinRequest{
+		MinLatitude: -6.3, MinLongitude: 106.7, MaxLatitude: -6.1, MaxLongitude: 106.9,
+	}); err != nil {
+		t.Fatalf("FindPlotsWithin() error = %v", err)
+	}
+	ring := repo.withinArea.Coordinates[0]
+	if len(ring) != 5 || ring[0][0] != 106.7 || ring[0][1] != -6.3 || ring[2][0] != 106.9 || ring[2][1] != -6.1 {
+		t.Fatalf("unexpected search area %v", ring)
+	}
+
+	if _, err := svc.FindPlotsWithin(context.Background(), &pb.FindPlotsWithinRequest{
+		MinLatitude: -6.1, MinLongitude: 106.7, MaxLatitude: -6.3, MaxLongitude: 106.9,
+	}); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for an inverted box, got %v", err)
+	}
+}
+
+func TestTreeManagementService_CreateLog_PlacesTree(t *testing.T) {
+	location, boundary, err := plotGeometry(&pb.Plot{Boundary: square(106.8, -6.2, 0.01)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plot := &models.Plot{ID: primitive.NewObjectID(), LocationName: "Bogor", Location: location, Boundary: boundary}
+	tree := &models.Tree{ID: primitive.NewObjectID(), PlotID: plot.ID}
+	repo := &mockTreeRepo{plot: plot, tree: tree}
+	svc := NewTreeManagementService(repo, nil, nil, nil, nil)
+
+	log, err := svc.CreateLog(context.Background(), &pb.CreateLogRequest{
+		AdoptedTreeId: tree.ID.Hex(),
+		Activity:      "Tree Planted",
+		Location:      &pb.GeoPoint{Latitude: -6.195, Longitude: 106.805},
+		Position:      &pb.GridPosition{Row: 3, Column: 7},
+	}, "admin-1")
+	if err != nil {
+		t.Fatalf("CreateLog() error = %v", err)
+	}
+	if log.Location == nil || log.Position == nil || *log.Position != (models.GridPosition{Row: 3, Column: 7}) {
+		t.Fatalf("log should record the placement, got %+v", log)
+	}
+	if tree.Location == nil || tree.Location.Coordinates[0] != 106.805 || tree.Location.Coordinates[1] != -6.195 || tree.Position.Row != 3 {
+		t.Fatalf("tree should be placed, got %+v", tree)
+	}
+
+	for name, req := range map[string]*pb.CreateLogRequest{
+		"outside the plot": {AdoptedTreeId: tree.ID.Hex(), Location: &pb.GeoPoint{Latitude: -6.1, Longitude: 106.805}},
+		"row from zero":    {AdoptedTreeId: tree.ID.Hex(), Position: &pb.GridPosition{Row: 0, Column: 1}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo.createLogInput = nil
+			if _, err := svc.CreateLog(context.Background(), req, "admin-1"); !errors.Is(err, models.ErrInvalidInput) {
+				t.Fatalf("expected ErrInvalidInput, got %v", err)
+			}
+			if repo.createLogInput != nil {
+				t.Fatalf("log should not be stored")
+			}
+		})
+	}
+}
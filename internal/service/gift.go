@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/mail"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	maxGiftMessageLength = 500
+
+	// claimCodeAlphabet leaves out 0/O and 1/I so codes read back from an
+	// e-card are not mistyped.
+	claimCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// newGift validates the gift options of an adoption and snapshots the
+// gifter's name and email, which are needed again after payment when no
+// user request is in flight.
+func (s *treeManagementService) newGift(ctx context.Context, opts *pb.GiftOptions, gifterID string) (*models.Gift, error) {
+	recipient, err := mail.ParseAddress(strings.TrimSpace(opts.RecipientEmail))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid recipient email", models.ErrInvalidInput)
+	}
+	if len(opts.Message) > maxGiftMessageLength {
+		return nil, fmt.Errorf("%w: gift message is longer than %d characters", models.ErrInvalidInput, maxGiftMessageLength)
+	}
+
+	gifter, err := s.authClient.GetCurrentUser(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up gifter: %w", err)
+	}
+	if strings.EqualFold(gifter.Email, recipient.Address) {
+		return nil, fmt.Errorf("%w: cannot gift a tree to yourself", models.ErrInvalidInput)
+	}
+
+	code, err := newClaimCode()
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(opts.RecipientName)
+	if name == "" {
+		name = recipient.Name
+	}
+	return &models.Gift{
+		GifterID:       gifterID,
+		GifterName:     gifter.FullName,
+		GifterEmail:    gifter.Email,
+		RecipientName:  name,
+		RecipientEmail: recipient.Address,
+		Message:        strings.TrimSpace(opts.Message),
+		ClaimCode:      code,
+		Status:         "PENDING",
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// newClaimCode returns a code of the form GIFT-XXXX-XXXX.
+func newClaimCode() (string, error) {
+	var b strings.Builder
+	b.WriteString("GIFT-")
+	for i := 0; i < 8; i++ {
+		if i == 4 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(claimCodeAlphabet))))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate claim code: %w", err)
+		}
+		b.WriteByte(claimCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// ClaimGift makes recipientID the sponsor of the tree planted for a gift.
+func (s *treeManagementService) ClaimGift(ctx context.Context, code, recipientID string) (*models.Tree, error) {
+	gift, err := s.repo.GetGiftByClaimCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, err
+	}
+	switch gift.Status {
+	case "SENT":
+	case "CLAIMED":
+		return nil, fmt.Errorf("%w: gift has already been claimed", models.ErrAlreadyExists)
+	default:
+		return nil, fmt.Errorf("%w: gift is not ready to be claimed", models.ErrInvalidInput)
+	}
+	if gift.GifterID == recipientID {
+		return nil, fmt.Errorf("%w: cannot claim your own gift", models.ErrInvalidInput)
+	}
+
+	var tree *models.Tree
+	err = s.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		t, err := s.repo.GetTree(ctx, gift.IntentID)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.MarkGiftClaimed(ctx, gift.ID, recipientID, time.Now()); err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				return fmt.Errorf("%w: gift has already been claimed", models.ErrAlreadyExists)
+			}
+			return err
+		}
+		t.SponsorID = recipientID
+		if tree, err = s.repo.UpdateTree(ctx, t); err != nil {
+			return err
+		}
+		_, err = s.repo.CreateLog(ctx, &models.LogEntry{
+			AdoptedTreeID:       t.ID,
+			Activity:            "Gift Claimed",
+			Note:                fmt.Sprintf("Gift from %s claimed by %s", gift.GifterID, recipientID),
+			CurrentHeightMeters: t.CurrentHeightMeters,
+			RecordedAt:          time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		return s.repo.EnqueueEvent(ctx, "gift.claimed", map[string]string{
+			"gift_id":      gift.ID.Hex(),
+			"tree_id":      t.ID.Hex(),
+			"gifter_id":    gift.GifterID,
+			"recipient_id": recipientID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyGiftClaimed(ctx, gift)
+	return tree, nil
+}
+
+// sendGiftCard emails the recipient the e-card with the gifter's message and
+// the code to claim the tree.
+func (s *treeManagementService) sendGiftCard(gift *models.Gift) {
+	if s.emailSender == nil {
+		return
+	}
+
+	from := gift.GifterName
+	if from == "" {
+		from = gift.GifterEmail
+	}
+	var b strings.Builder
+	if gift.RecipientName != "" {
+		fmt.Fprintf(&b, "Halo %s,\n\n", gift.RecipientName)
+	} else {
+		b.WriteString("Halo,\n\n")
+	}
+	fmt.Fprintf(&b, "%s menanam sebuah pohon atas namamu.\n", from)
+	if gift.Message != "" {
+		fmt.Fprintf(&b, "\nPesan dari %s:\n\"%s\"\n", from, gift.Message)
+	}
+	fmt.Fprintf(&b, "\nKode klaim kamu: %s\nMasuk atau daftar, lalu masukkan kode ini untuk menjadikan pohon ini milikmu.", gift.ClaimCode)
+
+	if err := s.emailSender.Send(gift.RecipientEmail, "Kamu mendapat hadiah pohon!", b.String()); err != nil {
+		log.Printf("WARN: failed to send gift e-card to %s: %v", gift.RecipientEmail, err)
+	}
+}
+
+// notifyGiftClaimed confirms the claim to the recipient and tells the gifter.
+// The recipient address comes from their account, falling back to the one
+// the gift was sent to.
+func (s *treeManagementService) notifyGiftClaimed(ctx context.Context, gift *models.Gift) {
+	if s.emailSender == nil {
+		return
+	}
+
+	recipientEmail := gift.RecipientEmail
+	if user, err := s.authClient.GetCurrentUser(ctx, &emptypb.Empty{}); err != nil {
+		log.Printf("WARN: failed to look up gift recipient %s: %v", gift.ID.Hex(), err)
+	} else if user.Email != "" {
+		recipientEmail = user.Email
+	}
+
+	body := fmt.Sprintf("Pohon hadiah dengan kode %s sekarang tercatat atas namamu. Kamu bisa memantau pertumbuhannya dari akunmu.", gift.ClaimCode)
+	if err := s.emailSender.Send(recipientEmail, "Hadiah pohon berhasil diklaim", body); err != nil {
+		log.Printf("WARN: failed to send gift claim email to %s: %v", recipientEmail, err)
+	}
+
+	if gift.GifterEmail == "" {
+		return
+	}
+	recipient := gift.RecipientName
+	if recipient == "" {
+		recipient = gift.RecipientEmail
+	}
+	body = fmt.Sprintf("Kabar baik! %s sudah mengklaim pohon yang kamu hadiahkan.", recipient)
+	if err := s.emailSender.Send(gift.GifterEmail, "Hadiah pohonmu sudah diklaim", body); err != nil {
+		log.Printf("WARN: failed to send gift claim email to %s: %v", gift.GifterEmail, err)
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockAuthClient struct {
+	user *pb.User
+}
+
+func (m *mockAuthClient) Login(ctx context.Context, in *pb.LoginRequest, opts ...grpc.CallOption) (*pb.AuthResponse, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockAuthClient) Register(ctx context.Context, in *pb.RegisterRequest, opts ...grpc.CallOption) (*pb.RegisterResponse, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockAuthClient) GetCurrentUser(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.User, error) {
+	if m.user == nil {
+		return nil, errors.New("unauthenticated")
+	}
+	return m.user, nil
+}
+
+func sentGift(intentID primitive.ObjectID) *models.Gift {
+	return &models.Gift{
+		ID:             primitive.NewObjectID(),
+		IntentID:       intentID,
+		GifterID:       "gifter-1",
+		GifterName:     "Budi",
+		GifterEmail:    "budi@example.com",
+		RecipientName:  "Sari",
+		RecipientEmail: "sari@example.com",
+		ClaimCode:      "GIFT-ABCD-EFGH",
+		Status:         "SENT",
+	}
+}
+
+func TestNewClaimCode(t *testing.T) {
+	code, err := newClaimCode()
+	if err != nil {
+		t.Fatalf("newClaimCode() error = %v", err)
+	}
+	if !regexp.MustCompile(`^GIFT-[A-HJ-NP-Z2-9]{4}-[A-HJ-NP-Z2-9]{4}$`).MatchString(code) {
+		t.Fatalf("unexpected claim code %q", code)
+	}
+}
+
+func TestTreeService_AdoptTree_Gift(t *testing.T) {
+	species := &models.Species{ID: primitive.NewObjectID(), Price: 50000, Currency: "IDR", SpaceRequiredM2: 2}
+	plot := &models.Plot{ID: primitive.NewObjectID(), AvailableSpaceM2: 10}
+	repo := &mockTreeRepo{species: species, plot: plot}
+	fin := &mockFinanceClient{txResp: &pb.Transaction{PaymentUrl: "https://pay.example.com/1"}}
+	auth := &mockAuthClient{user: &pb.User{Id: "gifter-1", Email: "budi@example.com", FullName: "Budi"}}
+	svc := NewTreeManagementService(repo, fin, auth, nil, nil)
+
+	intent, _, _, err := svc.AdoptTree(context.Background(), &pb.AdoptTreeRequest{
+		SpeciesId: species.ID.Hex(),
+		PlotId:    plot.ID.Hex(),
+		Gift:      &pb.GiftOptions{RecipientEmail: " Sari <sari@example.com> ", Message: "Selamat ulang tahun!"},
+	}, "gifter-1")
+	if err != nil {
+		t.Fatalf("AdoptTree() error = %v", err)
+	}
+
+	g := repo.gift
+	if g == nil || g.IntentID != intent.ID || g.Status != "PENDING" {
+		t.Fatalf("expected a pending gift for the intent, got %+v", g)
+	}
+	if g.RecipientEmail != "sari@example.com" || g.RecipientName != "Sari" || g.GifterEmail != "budi@example.com" || g.GifterName != "Budi" {
+		t.Fatalf("unexpected gift parties: %+v", g)
+	}
+	if fin.txReq.UserId != "gifter-1" {
+		t.Fatalf("gifter should pay for the tree, got %s", fin.txReq.UserId)
+	}
+}
+
+func TestTreeService_AdoptTree_GiftInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		gift *pb.GiftOptions
+	}{
+		{"bad email", &pb.GiftOptions{RecipientEmail: "not-an-email"}},
+		{"to self", &pb.GiftOptions{RecipientEmail: "BUDI@example.com"}},
+		{"long message", &pb.GiftOptions{RecipientEmail: "sari@example.com", Message: strings.Repeat("a", maxGiftMessageLength+1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockTreeRepo{}
+			auth := &mockAuthClient{user: &pb.User{Email: "budi@example.com"}}
+			svc := NewTreeManagementService(repo, &mockFinanceClient{}, auth, nil, nil)
+
+			_, _, _, err := svc.AdoptTree(context.Background(), &pb.AdoptTreeRequest{
+				SpeciesId: primitive.NewObjectID().Hex(),
+				PlotId:    primitive.NewObjectID().Hex(),
+				Gift:      tt.gift,
+			}, "gifter-1")
+			if !errors.Is(err, models.ErrInvalidInput) {
+				t.Fatalf("expected invalid input, got %v", err)
+			}
+			if repo.intent != nil || repo.gift != nil {
+				t.Fatalf("nothing should be stored for an invalid gift")
+			}
+		})
+	}
+}
+
+func TestTreeService_HandlePaymentSuccess_Gift(t *testing.T) {
+	intent := &models.AdoptionIntent{ID: primitive.NewObjectID(), SponsorID: "gifter-1", Status: "PENDING"}
+	gift := sentGift(intent.ID)
+	gift.Status = "PENDING"
+	gift.Message = "Selamat ulang tahun!"
+	repo := &mockTreeRepo{intent: intent, gift: gift}
+	sender := &mockEmailSender{}
+	svc := NewTreeManagementService(repo, nil, nil, nil, sender).(*treeManagementService)
+
+	payload := []byte(`{"reference_id":"` + intent.ID.Hex() + `"}`)
+	if err := svc.handlePaymentSuccess(context.Background(), payload); err != nil {
+		t.Fatalf("handlePaymentSuccess() error = %v", err)
+	}
+
+	if repo.createdTree == nil || repo.createdTree.SponsorID != "" {
+		t.Fatalf("gifted tree should be planted unclaimed, got %+v", repo.createdTree)
+	}
+	if gift.Status != "SENT" {
+		t.Fatalf("expected gift SENT, got %s", gift.Status)
+	}
+	if sender.to != "sari@example.com" || !strings.Contains(sender.body, gift.ClaimCode) || !strings.Contains(sender.body, gift.Message) {
+		t.Fatalf("unexpected e-card to %s: %s", sender.to, sender.body)
+	}
+}
+
+func TestTreeService_ClaimGift(t *testing.T) {
+	tree := &models.Tree{ID: primitive.NewObjectID()}
+	gift := sentGift(tree.ID)
+	repo := &mockTreeRepo{tree: tree, gift: gift}
+	sender := &mockEmailSender{}
+	auth := &mockAuthClient{user: &pb.User{Id: "recipient-1", Email: "sari.work@example.com"}}
+	svc := NewTreeManagementService(repo, nil, auth, nil, sender)
+
+	claimed, err := svc.ClaimGift(context.Background(), " gift-abcd-efgh ", "recipient-1")
+	if err != nil {
+		t.Fatalf("ClaimGift() error = %v", err)
+	}
+
+	if claimed.SponsorID != "recipient-1" || repo.updateTree.SponsorID != "recipient-1" {
+		t.Fatalf("tree should move to the recipient, got %+v", claimed)
+	}
+	if gift.Status != "CLAIMED" || gift.RecipientID != "recipient-1" {
+		t.Fatalf("gift should be claimed, got %+v", gift)
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "gift.claimed" {
+		t.Fatalf("expected gift.claimed in the outbox, got %+v", repo.events)
+	}
+	if len(sender.recipients) != 2 || sender.recipients[0] != "sari.work@example.com" || sender.recipients[1] != "budi@example.com" {
+		t.Fatalf("expected confirmation to both parties, got %v", sender.recipients)
+	}
+
+	if _, err := svc.ClaimGift(context.Background(), gift.ClaimCode, "recipient-2"); !errors.Is(err, models.ErrAlreadyExists) {
+		t.Fatalf("second claim should fail with already exists, got %v", err)
+	}
+}
+
+func TestTreeService_ClaimGift_Rejected(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		code        string
+		recipientID string
+		want        error
+	}{
+		{"unknown code", "SENT", "GIFT-XXXX-XXXX", "recipient-1", models.ErrNotFound},
+		{"own gift", "SENT", "GIFT-ABCD-EFGH", "gifter-1", models.ErrInvalidInput},
+		{"unpaid", "PENDING", "GIFT-ABCD-EFGH", "recipient-1", models.ErrInvalidInput},
+		{"expired", "EXPIRED", "GIFT-ABCD-EFGH", "recipient-1", models.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := &models.Tree{ID: primitive.NewObjectID()}
+			gift := sentGift(tree.ID)
+			gift.Status = tt.status
+			repo := &mockTreeRepo{tree: tree, gift: gift}
+			svc := NewTreeManagementService(repo, nil, &mockAuthClient{}, nil, nil)
+
+			if _, err := svc.ClaimGift(context.Background(), tt.code, tt.recipientID); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+			if repo.updateTree != nil {
+				t.Fatalf("tree must not change on a rejected claim")
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultHoldTTL = 24 * time.Hour
+	maxHoldTTL     = 7 * 24 * time.Hour
+)
+
+// CreateBalanceHold reserves part of the user's wallet for a purchase an
+// admin settles by hand later on, capturing or releasing the hold. The funds
+// stop being available straight away and go back to the wallet unless the
+// hold is captured before it expires. Adoptions do not go through this: the
+// only holds they use are the ones CreateTransaction places for the wallet
+// part of a split payment, which follow the invoice.
+func (s *financeService) CreateBalanceHold(ctx context.Context, userID uuid.UUID, req *pb.CreateBalanceHoldRequest) (*models.BalanceHold, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", models.ErrInvalidInput)
+	}
+	if req.Type != pb.TransactionType_ADOPT && req.Type != pb.TransactionType_CARE {
+		return nil, fmt.Errorf("%w: holds can only be placed for ADOPT or CARE", models.ErrInvalidInput)
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = defaultHoldTTL
+	}
+	if ttl < 0 || ttl > maxHoldTTL {
+		return nil, fmt.Errorf("%w: ttl must be at most %s", models.ErrInvalidInput, maxHoldTTL)
+	}
+
+	_, currency, err := s.repo.GetUserBalance(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user wallet: %w", err)
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	hold := &models.BalanceHold{
+		UserID:      userID,
+		Amount:      req.Amount,
+		Currency:    currency,
+		Type:        req.Type.String(),
+		ReferenceID: req.ReferenceId,
+		Description: req.Description,
+		Status:      "HELD",
+		ExpiresAt:   &expiresAt,
+	}
+	err = s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		return placeHold(ctx, repo, hold)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hold, nil
+}
+
+// CaptureBalanceHold spends a held amount, recording it as a settled
+// transaction of the hold's type. Capturing stands in for the purchase being
+// delivered, so only admins may do it.
+func (s *financeService) CaptureBalanceHold(ctx context.Context, holdID uuid.UUID) (*models.BalanceHold, error) {
+	hold, err := s.standaloneHold(ctx, uuid.Nil, true, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &models.Transaction{
+		UserID:      hold.UserID,
+		Amount:      hold.Amount,
+		Currency:    hold.Currency,
+		Type:        hold.Type,
+		ReferenceID: hold.ReferenceID,
+		Payment: models.Payment{
+			Amount: hold.Amount,
+			Status: "SUCCESS",
+		},
+	}
+	err = s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		if err := repo.CreateTransaction(ctx, tx); err != nil {
+			return err
+		}
+		return repo.CaptureBalanceHold(ctx, hold.ID, tx.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	hold.Status, hold.TransactionID = "CAPTURED", &tx.ID
+
+	s.emailReceipt(ctx, tx)
+	return hold, nil
+}
+
+// ReleaseBalanceHold gives a held amount back to the wallet. Admins can
+// release any hold, users only their own.
+func (s *financeService) ReleaseBalanceHold(ctx context.Context, userID uuid.UUID, asAdmin bool, holdID uuid.UUID) (*models.BalanceHold, error) {
+	hold, err := s.standaloneHold(ctx, userID, asAdmin, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		_, err := refundHold(ctx, repo, hold, "RELEASED")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	hold.Status = "RELEASED"
+	return hold, nil
+}
+
+// standaloneHold loads a HELD hold the caller may settle. Holds placed towards
+// an invoice are settled by the invoice and cannot be settled directly.
+func (s *financeService) standaloneHold(ctx context.Context, userID uuid.UUID, asAdmin bool, holdID uuid.UUID) (*models.BalanceHold, error) {
+	hold, err := s.repo.GetBalanceHold(ctx, holdID)
+	if err != nil {
+		return nil, err
+	}
+	if !asAdmin && hold.UserID != userID {
+		return nil, models.ErrNotFound
+	}
+	if hold.TransactionID != nil {
+		return nil, fmt.Errorf("%w: hold belongs to a pending payment", models.ErrInvalidInput)
+	}
+	if hold.Status != "HELD" {
+		return nil, fmt.Errorf("%w: hold is already %s", models.ErrInvalidInput, hold.Status)
+	}
+	return hold, nil
+}
+
+func (s *financeService) ListBalanceHolds(ctx context.Context, userID uuid.UUID) ([]models.BalanceHold, error) {
+	return s.repo.ListBalanceHoldsByUserID(ctx, userID)
+}
+
+// ExpireBalanceHolds returns the funds of every hold past its expiry to the
+// wallet. A failed hold is logged and retried on the next run.
+func (s *financeService) ExpireBalanceHolds(ctx context.Context) error {
+	holds, err := s.repo.ListExpiredBalanceHolds(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for i := range holds {
+		err := s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+			_, err := refundHold(ctx, repo, &holds[i], "EXPIRED")
+			return err
+		})
+		if err != nil {
+			log.Printf("Failed to expire balance hold %s: %v", holds[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// placeHold takes the hold's amount out of the wallet and records the hold.
+// The debit fails with ErrInsufficientBalance rather than overdrawing.
+func placeHold(ctx context.Context, repo repository.FinanceRepository, hold *models.BalanceHold) error {
+	if err := repo.DebitWallet(ctx, hold.UserID, hold.Amount, hold.Currency); err != nil {
+		return err
+	}
+	return repo.CreateBalanceHold(ctx, hold)
+}
+
+// refundHold settles a HELD hold as status and credits its amount back,
+// returning the amount refunded. A hold that was already settled is left
+// alone and nothing is refunded.
+func refundHold(ctx context.Context, repo repository.FinanceRepository, hold *models.BalanceHold, status string) (int64, error) {
+	if err := repo.SettleBalanceHold(ctx, hold.ID, status); err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return hold.Amount, repo.UpdateWalletBalance(ctx, hold.UserID, hold.Amount, hold.Currency)
+}
+
+// holdBalance reserves amount of the wallet of tx's owner towards tx until
+// the rest of the payment settles. Callers run it inside WithTransaction
+// after tx has been stored.
+func holdBalance(ctx context.Context, repo repository.FinanceRepository, tx *models.Transaction, amount int64) error {
+	txID := tx.ID
+	return placeHold(ctx, repo, &models.BalanceHold{
+		UserID:        tx.UserID,
+		TransactionID: &txID,
+		Amount:        amount,
+		Currency:      tx.Currency,
+		Type:          tx.Type,
+		ReferenceID:   tx.ReferenceID,
+		Status:        "HELD",
+	})
+}
+
+// captureHold spends the funds held for a transaction once it is paid. It is
+// a no-op when nothing is held.
+func captureHold(ctx context.Context, repo repository.FinanceRepository, txID uuid.UUID) error {
+	hold, err := repo.GetBalanceHoldByTransactionID(ctx, txID)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := repo.SettleBalanceHold(ctx, hold.ID, "CAPTURED"); err != nil && !errors.Is(err, models.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// releaseHold gives the funds held for an unpaid transaction back to the
+// wallet and returns how much that was. A hold that was already settled is
+// left alone.
+func releaseHold(ctx context.Context, repo repository.FinanceRepository, txID uuid.UUID) (int64, error) {
+	hold, err := repo.GetBalanceHoldByTransactionID(ctx, txID)
+	if errors.Is(err, models.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return refundHold(ctx, repo, hold, "RELEASED")
+}
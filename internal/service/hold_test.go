@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+func TestFinanceService_CreateBalanceHold(t *testing.T) {
+	userID := uuid.New()
+	repo := &mockFinanceRepo{getBalanceValue: 200000}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	hold, err := svc.CreateBalanceHold(context.Background(), userID, &pb.CreateBalanceHoldRequest{
+		Amount:      150000,
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "intent-1",
+		Description: "Tree bought at the nursery open day",
+	})
+	if err != nil {
+		t.Fatalf("CreateBalanceHold() error = %v", err)
+	}
+
+	if repo.debitUserID != userID || repo.debitAmount != 150000 {
+		t.Fatalf("held funds should leave the available balance, got %d", repo.debitAmount)
+	}
+	if repo.createdHold != hold || hold.Status != "HELD" || hold.Currency != "IDR" || hold.Type != "ADOPT" || hold.TransactionID != nil {
+		t.Fatalf("unexpected hold: %+v", hold)
+	}
+	if hold.ExpiresAt == nil || time.Until(*hold.ExpiresAt) < 23*time.Hour {
+		t.Fatalf("hold should expire in 24 hours by default, got %v", hold.ExpiresAt)
+	}
+}
+
+func TestFinanceService_CreateBalanceHold_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *pb.CreateBalanceHoldRequest
+		debitErr error
+		wantErr  error
+	}{
+		{"non-positive amount", &pb.CreateBalanceHoldRequest{Amount: 0, Type: pb.TransactionType_ADOPT}, nil, models.ErrInvalidInput},
+		{"deposit", &pb.CreateBalanceHoldRequest{Amount: 1000, Type: pb.TransactionType_DEPOSIT}, nil, models.ErrInvalidInput},
+		{"ttl too long", &pb.CreateBalanceHoldRequest{Amount: 1000, Type: pb.TransactionType_CARE, TtlSeconds: 8 * 24 * 3600}, nil, models.ErrInvalidInput},
+		{"insufficient balance", &pb.CreateBalanceHoldRequest{Amount: 1000, Type: pb.TransactionType_ADOPT}, models.ErrInsufficientBalance, models.ErrInsufficientBalance},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockFinanceRepo{debitErr: tt.debitErr}
+			svc := NewFinanceService(repo, "key", nil, nil)
+
+			if _, err := svc.CreateBalanceHold(context.Background(), uuid.New(), tt.req); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateBalanceHold() error = %v, want %v", err, tt.wantErr)
+			}
+			if repo.createdHold != nil {
+				t.Fatalf("no hold should be recorded")
+			}
+		})
+	}
+}
+
+func standaloneTestHold(userID uuid.UUID) *models.BalanceHold {
+	expiresAt := time.Now().Add(time.Hour)
+	return &models.BalanceHold{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Amount:      150000,
+		Currency:    "IDR",
+		Type:        "ADOPT",
+		ReferenceID: "intent-1",
+		Status:      "HELD",
+		ExpiresAt:   &expiresAt,
+	}
+}
+
+func TestFinanceService_CaptureBalanceHold(t *testing.T) {
+	userID := uuid.New()
+	hold := standaloneTestHold(userID)
+	repo := &mockFinanceRepo{holdByID: hold}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	// An admin confirming the purchase captures the sponsor's hold
+	captured, err := svc.CaptureBalanceHold(context.Background(), hold.ID)
+	if err != nil {
+		t.Fatalf("CaptureBalanceHold() error = %v", err)
+	}
+
+	tx := repo.createTx
+	if tx == nil || tx.Type != "ADOPT" || tx.UserID != userID || tx.Amount != 150000 || tx.ReferenceID != "intent-1" || tx.Payment.Status != "SUCCESS" {
+		t.Fatalf("capture should record a settled adoption, got %+v", tx)
+	}
+	if repo.settledHoldID != hold.ID || repo.settledHoldStatus != "CAPTURED" || repo.capturedHoldTxID != tx.ID {
+		t.Fatalf("hold should be captured by the transaction, got %s %q", repo.settledHoldID, repo.settledHoldStatus)
+	}
+	if captured.Status != "CAPTURED" || captured.TransactionID == nil {
+		t.Fatalf("unexpected hold: %+v", captured)
+	}
+	if repo.balanceDelta != 0 {
+		t.Fatalf("captured funds must not go back to the wallet, got %d", repo.balanceDelta)
+	}
+}
+
+func TestFinanceService_ReleaseBalanceHold(t *testing.T) {
+	userID := uuid.New()
+	hold := standaloneTestHold(userID)
+	repo := &mockFinanceRepo{holdByID: hold}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	released, err := svc.ReleaseBalanceHold(context.Background(), userID, false, hold.ID)
+	if err != nil {
+		t.Fatalf("ReleaseBalanceHold() error = %v", err)
+	}
+	if released.Status != "RELEASED" || repo.settledHoldStatus != "RELEASED" {
+		t.Fatalf("hold should be released, got %q", released.Status)
+	}
+	if repo.balanceUserID != userID || repo.balanceDelta != 150000 || repo.balanceCurrency != "IDR" {
+		t.Fatalf("held funds should be returned, got %d %s", repo.balanceDelta, repo.balanceCurrency)
+	}
+}
+
+func TestFinanceService_SettleBalanceHold_Rejected(t *testing.T) {
+	userID := uuid.New()
+	txID := uuid.New()
+	tests := []struct {
+		name    string
+		caller  uuid.UUID
+		modify  func(h *models.BalanceHold)
+		wantErr error
+	}{
+		{"other user", uuid.New(), func(h *models.BalanceHold) {}, models.ErrNotFound},
+		{"already captured", userID, func(h *models.BalanceHold) { h.Status = "CAPTURED" }, models.ErrInvalidInput},
+		{"held for an invoice", userID, func(h *models.BalanceHold) { h.TransactionID = &txID }, models.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hold := standaloneTestHold(userID)
+			tt.modify(hold)
+			repo := &mockFinanceRepo{holdByID: hold}
+			svc := NewFinanceService(repo, "key", nil, nil)
+
+			if tt.wantErr == models.ErrInvalidInput {
+				if _, err := svc.CaptureBalanceHold(context.Background(), hold.ID); !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CaptureBalanceHold() error = %v, want %v", err, tt.wantErr)
+				}
+			}
+			if _, err := svc.ReleaseBalanceHold(context.Background(), tt.caller, false, hold.ID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReleaseBalanceHold() error = %v, want %v", err, tt.wantErr)
+			}
+			if repo.settledHoldStatus != "" || repo.createTx != nil || repo.balanceDelta != 0 {
+				t.Fatalf("hold must be left alone")
+			}
+		})
+	}
+}
+
+func TestFinanceService_ExpireBalanceHolds(t *testing.T) {
+	userID := uuid.New()
+	repo := &mockFinanceRepo{
+		expiredHolds: []models.BalanceHold{
+			*standaloneTestHold(userID),
+			{ID: uuid.New(), UserID: userID, Amount: 999, Currency: "IDR", Status: "RELEASED"},
+		},
+	}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	if err := svc.ExpireBalanceHolds(context.Background()); err != nil {
+		t.Fatalf("ExpireBalanceHolds() error = %v", err)
+	}
+	if repo.settledHolds != 1 || repo.settledHoldStatus != "EXPIRED" {
+		t.Fatalf("only the held hold should expire, got %d settled as %q", repo.settledHolds, repo.settledHoldStatus)
+	}
+	if repo.balanceUserID != userID || repo.balanceDelta != 150000 {
+		t.Fatalf("expired funds should be returned, got %d", repo.balanceDelta)
+	}
+}
+
+func TestFinanceService_GetBalance_IncludesHeldFunds(t *testing.T) {
+	repo := &mockFinanceRepo{getBalanceValue: 160000, heldBalance: 320000}
+	svc := NewFinanceService(repo, "x", nil, testRates(t))
+
+	bal, err := svc.GetBalance(context.Background(), uuid.New(), "USD")
+	if err != nil {
+		t.Fatalf("GetBalance() error = %v", err)
+	}
+	if bal.Balance != 480000 || bal.Available != 160000 || bal.Currency != "IDR" {
+		t.Fatalf("expected total 480000 and available 160000, got %+v", bal)
+	}
+	if bal.DisplayBalance != 3000 || bal.DisplayAvailable != 1000 || bal.DisplayCurrency != "USD" {
+		t.Fatalf("expected USD 30.00 total and USD 10.00 available, got %+v", bal)
+	}
+}
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/money"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+// JournalAccount is an account of the chart the accounting export books to.
+type JournalAccount struct {
+	Code string
+	Name string
+}
+
+var (
+	accountGatewayClearing  = JournalAccount{"1110", "Xendit Clearing"}
+	accountCareReceivable   = JournalAccount{"1210", "Care Invoices Receivable"}
+	accountWalletBalances   = JournalAccount{"2110", "Sponsor Wallet Balances"}
+	accountDeferredCare     = JournalAccount{"2310", "Deferred Care Revenue"}
+	accountAdoptionRevenue  = JournalAccount{"4110", "Tree Adoption Revenue"}
+	accountCareRevenue      = JournalAccount{"4120", "Tree Care Revenue"}
+	accountVoucherDiscounts = JournalAccount{"4910", "Voucher Discounts"}
+	accountGatewayFees      = JournalAccount{"6110", "Payment Gateway Fees"}
+	accountAdjustments      = JournalAccount{"6910", "Wallet Balance Adjustments"}
+)
+
+// journalNamespace seeds the entry IDs. Changing it would make every
+// re-export look new to the books.
+var journalNamespace = uuid.MustParse("5b0f7c1e-3d2a-4e86-9a51-0c7d2f1e8b44")
+
+// JournalEntry is one balanced double-entry posting. Its ID is derived from
+// the transaction and the kind of posting, so exporting the same period
+// again yields the same IDs and importers can skip what they already have.
+type JournalEntry struct {
+	ID              string
+	Date            time.Time
+	TransactionID   uuid.UUID
+	TransactionType string
+	UserID          uuid.UUID
+	Currency        string
+	Memo            string
+	Lines           []JournalLine
+}
+
+// JournalLine debits or credits one account, in minor units of the entry
+// currency.
+type JournalLine struct {
+	Account JournalAccount
+	Debit   int64
+	Credit  int64
+}
+
+var journalCSVHeader = []string{"entry_id", "line", "date", "account_code", "account_name", "debit", "credit", "currency", "transaction_id", "transaction_type", "user_id", "memo"}
+
+// ExportJournal renders the accounting entries dated in [from, to) as a
+// journal CSV or an IIF file, defaulting to the last 30 days.
+func (s *reportService) ExportJournal(ctx context.Context, from, to time.Time, format pb.DocumentFormat) (*models.Document, error) {
+	from, to, err := s.reportRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if format != pb.DocumentFormat_CSV && format != pb.DocumentFormat_IIF {
+		return nil, fmt.Errorf("%w: the journal is exported as CSV or IIF", models.ErrInvalidInput)
+	}
+
+	entries, err := s.journal(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return renderJournal(entries, from, to, format)
+}
+
+// MailJournal sends last month's journal, in both formats, to recipient.
+// It backs the monthly accounting export job.
+func (s *reportService) MailJournal(ctx context.Context, recipient string) error {
+	if s.emailSender == nil || recipient == "" {
+		return fmt.Errorf("no accounting export recipient configured")
+	}
+
+	now := s.now().UTC()
+	to := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, -1, 0)
+	entries, err := s.journal(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	var attachments []Attachment
+	for _, format := range []pb.DocumentFormat{pb.DocumentFormat_CSV, pb.DocumentFormat_IIF} {
+		doc, err := renderJournal(entries, from, to, format)
+		if err != nil {
+			return err
+		}
+		attachments = append(attachments, Attachment{FileName: doc.FileName, ContentType: doc.ContentType, Content: doc.Content})
+	}
+
+	label := from.Format("January 2006")
+	body := fmt.Sprintf("The ReForest accounting journal for %s is attached, %d entries.\nEntry IDs are stable, so entries already imported from an earlier export can be skipped.", label, len(entries))
+	return s.emailSender.SendWithAttachments(recipient, "ReForest Journal "+label, body, attachments...)
+}
+
+// journal books the transactions of the period. Entries reflect the
+// payment status at the time of the export and are sorted by date.
+func (s *reportService) journal(ctx context.Context, from, to time.Time) ([]JournalEntry, error) {
+	txs, err := s.repo.ListJournalTransactions(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []JournalEntry
+	for i := range txs {
+		for _, entry := range journalEntries(&txs[i]) {
+			if !entry.Date.Before(from) && entry.Date.Before(to) {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	// Entries are collected in transaction order, so a stable sort keeps
+	// the entries of one transaction together
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+	return entries, nil
+}
+
+// journalEntries books a single transaction. Paid invoices go through the
+// Xendit clearing account less the gateway fee; whatever was paid from the
+// wallet reduces the wallet liability. Care invoices are booked as deferred
+// revenue when issued and recognised once paid. An adoption invoice paid
+// after it expired is booked through the deposit it was credited as.
+func journalEntries(tx *models.Transaction) []JournalEntry {
+	p := tx.Payment
+	gross := tx.Amount + tx.DiscountAmount
+	paidAt := tx.CreatedAt
+	if p.PaidAt != nil {
+		paidAt = *p.PaidAt
+	}
+	// Only part of an adoption or care payment may have been invoiced, the
+	// rest comes out of the wallet. Top ups are always paid at the gateway.
+	var gateway int64
+	if tx.Type == "DEPOSIT" || p.PaymentURL != "" {
+		gateway = p.Amount
+	}
+	wallet := tx.Amount - gateway
+	if tx.Type == "DEPOSIT" {
+		wallet = 0
+	}
+
+	var entries []JournalEntry
+	add := func(kind string, date time.Time, memo string, lines ...JournalLine) {
+		entries = append(entries, newJournalEntry(tx, kind, date, memo, lines))
+	}
+
+	switch tx.Type {
+	case "DEPOSIT":
+		if p.Status == "SUCCESS" {
+			// A top-up voucher is made up by us, so the wallet gets the gross
+			add("settlement", paidAt, "Wallet top up",
+				JournalLine{Account: accountGatewayClearing, Debit: gateway - p.FeeAmount},
+				JournalLine{Account: accountGatewayFees, Debit: p.FeeAmount},
+				JournalLine{Account: accountVoucherDiscounts, Debit: tx.DiscountAmount},
+				JournalLine{Account: accountWalletBalances, Credit: gross},
+			)
+		}
+	case "ADOPT":
+		if p.Status == "SUCCESS" {
+			add("sale", paidAt, "Tree adoption "+tx.ReferenceID,
+				JournalLine{Account: accountGatewayClearing, Debit: gateway - p.FeeAmount},
+				JournalLine{Account: accountGatewayFees, Debit: p.FeeAmount},
+				JournalLine{Account: accountWalletBalances, Debit: wallet},
+				JournalLine{Account: accountVoucherDiscounts, Debit: tx.DiscountAmount},
+				JournalLine{Account: accountAdoptionRevenue, Credit: gross},
+			)
+		}
+	case "CARE":
+		if gateway > 0 {
+			add("invoice", tx.CreatedAt, "Tree care invoice "+tx.ReferenceID,
+				JournalLine{Account: accountCareReceivable, Debit: gateway},
+				JournalLine{Account: accountDeferredCare, Credit: gateway},
+			)
+		}
+		switch p.Status {
+		case "SUCCESS":
+			if gateway > 0 {
+				add("settlement", paidAt, "Tree care payment "+tx.ReferenceID,
+					JournalLine{Account: accountGatewayClearing, Debit: gateway - p.FeeAmount},
+					JournalLine{Account: accountGatewayFees, Debit: p.FeeAmount},
+					JournalLine{Account: accountCareReceivable, Credit: gateway},
+				)
+			}
+			add("revenue", paidAt, "Tree care "+tx.ReferenceID,
+				JournalLine{Account: accountDeferredCare, Debit: gateway},
+				JournalLine{Account: accountWalletBalances, Debit: wallet},
+				JournalLine{Account: accountVoucherDiscounts, Debit: tx.DiscountAmount},
+				JournalLine{Account: accountCareRevenue, Credit: gross},
+			)
+		case "EXPIRED":
+			if gateway > 0 {
+				add("void", p.UpdatedAt, "Tree care invoice expired "+tx.ReferenceID,
+					JournalLine{Account: accountDeferredCare, Debit: gateway},
+					JournalLine{Account: accountCareReceivable, Credit: gateway},
+				)
+			}
+		}
+	case "ADJUSTMENT":
+		credit := JournalLine{Account: accountWalletBalances, Credit: tx.Amount}
+		debit := JournalLine{Account: accountAdjustments, Debit: tx.Amount}
+		if tx.Direction == "OUT" {
+			credit = JournalLine{Account: accountAdjustments, Credit: tx.Amount}
+			debit = JournalLine{Account: accountWalletBalances, Debit: tx.Amount}
+		}
+		add("adjustment", tx.CreatedAt, "Balance adjustment "+tx.ReferenceID, debit, credit)
+	}
+	return entries
+}
+
+// newJournalEntry drops the zero lines of an entry and stamps its ID.
+func newJournalEntry(tx *models.Transaction, kind string, date time.Time, memo string, lines []JournalLine) JournalEntry {
+	entry := JournalEntry{
+		ID:              uuid.NewSHA1(journalNamespace, []byte(tx.ID.String()+"/"+kind)).String(),
+		Date:            date.UTC(),
+		TransactionID:   tx.ID,
+		TransactionType: tx.Type,
+		UserID:          tx.UserID,
+		Currency:        tx.Currency,
+		Memo:            strings.TrimSpace(memo),
+	}
+	for _, line := range lines {
+		if line.Debit != 0 || line.Credit != 0 {
+			entry.Lines = append(entry.Lines, line)
+		}
+	}
+	return entry
+}
+
+func renderJournal(entries []JournalEntry, from, to time.Time, format pb.DocumentFormat) (*models.Document, error) {
+	name := "journal-" + from.UTC().Format("2006-01-02") + "-" + to.UTC().Format("2006-01-02")
+
+	switch format {
+	case pb.DocumentFormat_CSV:
+		content, err := writeJournalCSV(entries)
+		if err != nil {
+			return nil, err
+		}
+		return &models.Document{FileName: name + ".csv", ContentType: "text/csv", Content: content}, nil
+	case pb.DocumentFormat_IIF:
+		return &models.Document{FileName: name + ".iif", ContentType: "text/plain", Content: writeJournalIIF(entries)}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported document format", models.ErrInvalidInput)
+}
+
+func writeJournalCSV(entries []JournalEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(journalCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		for i, line := range entry.Lines {
+			record := []string{
+				entry.ID,
+				strconv.Itoa(i + 1),
+				entry.Date.Format("2006-01-02"),
+				line.Account.Code,
+				line.Account.Name,
+				money.Decimal(line.Debit, entry.Currency),
+				money.Decimal(line.Credit, entry.Currency),
+				entry.Currency,
+				entry.TransactionID.String(),
+				entry.TransactionType,
+				entry.UserID.String(),
+				entry.Memo,
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// writeJournalIIF writes the entries as GENERAL JOURNAL transactions. IIF
+// has no currency column, so entries outside money.DefaultCurrency name
+// their currency in the memo. DOCNUM carries the entry ID.
+func writeJournalIIF(entries []JournalEntry) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("!TRNS\tTRNSTYPE\tDATE\tACCNT\tAMOUNT\tDOCNUM\tMEMO\n")
+	buf.WriteString("!SPL\tTRNSTYPE\tDATE\tACCNT\tAMOUNT\tDOCNUM\tMEMO\n")
+	buf.WriteString("!ENDTRNS\n")
+	for _, entry := range entries {
+		memo := entry.Memo
+		if entry.Currency != money.DefaultCurrency {
+			memo += " (" + entry.Currency + ")"
+		}
+		memo = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ").Replace(memo)
+		for i, line := range entry.Lines {
+			row := "SPL"
+			if i == 0 {
+				row = "TRNS"
+			}
+			fmt.Fprintf(&buf, "%s\tGENERAL JOURNAL\t%s\t%s\t%s\t%s\t%s\n",
+				row, entry.Date.Format("01/02/2006"), line.Account.Name,
+				money.Decimal(line.Debit-line.Credit, entry.Currency), entry.ID, memo)
+		}
+		buf.WriteString("ENDTRNS\n")
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+var (
+	journalFrom = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	journalTo   = journalFrom.AddDate(0, 1, 0)
+)
+
+func journalDay(day int) time.Time {
+	return journalFrom.AddDate(0, 0, day-1).Add(10 * time.Hour)
+}
+
+// testJournalTransactions covers every kind of posting: a top up with a
+// voucher, an adoption partly paid from the wallet, a care invoice issued
+// in the period but paid after it, a care invoice issued before the period
+// and expired in it, and a debit adjustment.
+func testJournalTransactions() []models.Transaction {
+	paid := journalDay(3)
+	return []models.Transaction{
+		{
+			ID: uuid.New(), UserID: uuid.New(), Type: "DEPOSIT", Amount: 95000, DiscountAmount: 5000, Currency: "IDR", CreatedAt: journalDay(2),
+			Payment: models.Payment{Amount: 95000, Status: "SUCCESS", ExternalID: "inv-1", PaymentURL: "https://pay.xendit.co/1", FeeAmount: 4995, PaidAt: &paid},
+		},
+		{
+			ID: uuid.New(), UserID: uuid.New(), Type: "ADOPT", Amount: 150000, Currency: "IDR", ReferenceID: "intent-1", CreatedAt: journalDay(4),
+			Payment: models.Payment{Amount: 100000, Status: "SUCCESS", ExternalID: "inv-2", PaymentURL: "https://pay.xendit.co/2", FeeAmount: 4995, PaidAt: &paid},
+		},
+		{
+			ID: uuid.New(), UserID: uuid.New(), Type: "CARE", Amount: 60000, Currency: "IDR", ReferenceID: "tree-1", CreatedAt: journalDay(30),
+			Payment: models.Payment{Amount: 60000, Status: "PENDING", ExternalID: "inv-3", PaymentURL: "https://pay.xendit.co/3"},
+		},
+		{
+			ID: uuid.New(), UserID: uuid.New(), Type: "CARE", Amount: 1500, Currency: "USD", ReferenceID: "tree-2", CreatedAt: journalFrom.AddDate(0, 0, -2),
+			Payment: models.Payment{Amount: 1500, Status: "EXPIRED", ExternalID: "inv-4", PaymentURL: "https://pay.xendit.co/4", UpdatedAt: journalDay(1)},
+		},
+		{
+			ID: uuid.New(), UserID: uuid.New(), Type: "ADJUSTMENT", Direction: "OUT", Amount: 25000, Currency: "IDR", CreatedAt: journalDay(5),
+			Payment: models.Payment{Amount: 25000, Status: "SUCCESS"},
+		},
+	}
+}
+
+func TestReportService_ExportJournal_CSV(t *testing.T) {
+	repo := &mockReportRepo{journal: testJournalTransactions()}
+	svc := NewReportService(repo, nil, nil)
+
+	doc, err := svc.ExportJournal(context.Background(), journalFrom, journalTo, pb.DocumentFormat_CSV)
+	if err != nil {
+		t.Fatalf("ExportJournal() error = %v", err)
+	}
+	if doc.FileName != "journal-2026-09-01-2026-10-01.csv" || !repo.from.Equal(journalFrom) || !repo.to.Equal(journalTo) {
+		t.Fatalf("unexpected export %q for %v - %v", doc.FileName, repo.from, repo.to)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(string(doc.Content))).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid csv: %v", err)
+	}
+	var got []string
+	for _, r := range records[1:] {
+		got = append(got, r[2]+" "+r[3]+" "+r[5]+"/"+r[6]+" "+r[7])
+	}
+	want := []string{
+		// The expired USD care invoice is voided
+		"2026-09-01 2310 15.00/0.00 USD",
+		"2026-09-01 1210 0.00/15.00 USD",
+		// Top up paid on the 3rd, less the gateway fee, voucher made up by us
+		"2026-09-03 1110 90005/0 IDR",
+		"2026-09-03 6110 4995/0 IDR",
+		"2026-09-03 4910 5000/0 IDR",
+		"2026-09-03 2110 0/100000 IDR",
+		// Adoption partly paid from the wallet
+		"2026-09-03 1110 95005/0 IDR",
+		"2026-09-03 6110 4995/0 IDR",
+		"2026-09-03 2110 50000/0 IDR",
+		"2026-09-03 4110 0/150000 IDR",
+		// Debit adjustment
+		"2026-09-05 2110 25000/0 IDR",
+		"2026-09-05 6910 0/25000 IDR",
+		// Unpaid care is deferred
+		"2026-09-30 1210 60000/0 IDR",
+		"2026-09-30 2310 0/60000 IDR",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected journal:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestJournalEntries_LateCredit(t *testing.T) {
+	paid := journalDay(3)
+	adopt := models.Transaction{
+		ID: uuid.New(), Type: "ADOPT", Amount: 75000, Currency: "IDR", CreatedAt: journalDay(1),
+		Payment: models.Payment{Amount: 75000, Status: "CREDITED", ExternalID: "inv-1", PaymentURL: "https://pay.xendit.co/1", FeeAmount: 4995, PaidAt: &paid},
+	}
+	if entries := journalEntries(&adopt); len(entries) != 0 {
+		t.Fatalf("a credited adoption is booked through its deposit, got %+v", entries)
+	}
+
+	// The deposit it was credited as books the fee like an on-time payment
+	deposit := models.Transaction{
+		ID: uuid.New(), Type: "DEPOSIT", Amount: 75000, Currency: "IDR", ReferenceID: adopt.ID.String(), CreatedAt: paid,
+		Payment: models.Payment{Amount: 75000, Status: "SUCCESS", FeeAmount: 4995, PaidAt: &paid},
+	}
+	entries := journalEntries(&deposit)
+	want := []JournalLine{
+		{Account: accountGatewayClearing, Debit: 70005},
+		{Account: accountGatewayFees, Debit: 4995},
+		{Account: accountWalletBalances, Credit: 75000},
+	}
+	if len(entries) != 1 || !slices.Equal(entries[0].Lines, want) {
+		t.Fatalf("unexpected deposit entries %+v", entries)
+	}
+}
+
+func TestJournalEntries_WalletPaidWithExternalID(t *testing.T) {
+	// As in expireInvoice, only an invoice URL means money at the gateway;
+	// an external ID alone does not
+	tx := models.Transaction{
+		ID: uuid.New(), Type: "ADOPT", Amount: 50000, Currency: "IDR", ReferenceID: "intent-1", CreatedAt: journalDay(2),
+		Payment: models.Payment{Amount: 50000, Status: "SUCCESS", ExternalID: "inv-9"},
+	}
+	entries := journalEntries(&tx)
+	want := []JournalLine{
+		{Account: accountWalletBalances, Debit: 50000},
+		{Account: accountAdoptionRevenue, Credit: 50000},
+	}
+	if len(entries) != 1 || !slices.Equal(entries[0].Lines, want) {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+}
+
+func TestReportService_ExportJournal_StableIDs(t *testing.T) {
+	txs := testJournalTransactions()
+	svc := NewReportService(&mockReportRepo{journal: txs}, nil, nil)
+
+	first, err := svc.ExportJournal(context.Background(), journalFrom, journalTo, pb.DocumentFormat_CSV)
+	if err != nil {
+		t.Fatalf("ExportJournal() error = %v", err)
+	}
+	again, err := svc.ExportJournal(context.Background(), journalFrom, journalTo, pb.DocumentFormat_CSV)
+	if err != nil {
+		t.Fatalf("ExportJournal() error = %v", err)
+	}
+	if string(first.Content) != string(again.Content) {
+		t.Fatalf("re-exporting the same period should give the same journal")
+	}
+
+	// Paying the care invoice adds entries but keeps the invoice entry
+	records, _ := csv.NewReader(strings.NewReader(string(first.Content))).ReadAll()
+	invoiceID := records[len(records)-1][0]
+	paid := journalDay(30).Add(time.Hour)
+	txs[2].Payment.Status, txs[2].Payment.PaidAt = "SUCCESS", &paid
+	later, err := svc.ExportJournal(context.Background(), journalFrom, journalTo, pb.DocumentFormat_CSV)
+	if err != nil {
+		t.Fatalf("ExportJournal() error = %v", err)
+	}
+	if !strings.Contains(string(later.Content), invoiceID) || !strings.Contains(string(later.Content), ",4120,Tree Care Revenue,0,60000,") {
+		t.Fatalf("expected the invoice entry kept and the revenue recognised, got:\n%s", later.Content)
+	}
+}
+
+func TestReportService_ExportJournal_IIF(t *testing.T) {
+	svc := NewReportService(&mockReportRepo{journal: testJournalTransactions()[4:]}, nil, nil)
+
+	doc, err := svc.ExportJournal(context.Background(), journalFrom, journalTo, pb.DocumentFormat_IIF)
+	if err != nil {
+		t.Fatalf("ExportJournal() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(doc.Content)), "\n")
+	if len(lines) != 6 || !strings.HasPrefix(lines[0], "!TRNS\t") || lines[5] != "ENDTRNS" {
+		t.Fatalf("unexpected iif:\n%s", doc.Content)
+	}
+	if !strings.HasPrefix(lines[3], "TRNS\tGENERAL JOURNAL\t09/05/2026\tSponsor Wallet Balances\t25000\t") ||
+		!strings.HasPrefix(lines[4], "SPL\tGENERAL JOURNAL\t09/05/2026\tWallet Balance Adjustments\t-25000\t") {
+		t.Fatalf("unexpected iif:\n%s", doc.Content)
+	}
+}
+
+func TestReportService_ExportJournal_Invalid(t *testing.T) {
+	svc := NewReportService(&mockReportRepo{}, nil, nil)
+
+	if _, err := svc.ExportJournal(context.Background(), journalFrom, journalTo, pb.DocumentFormat_PDF); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected PDF rejected, got %v", err)
+	}
+	if _, err := svc.ExportJournal(context.Background(), journalTo, journalFrom, pb.DocumentFormat_CSV); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected empty range rejected, got %v", err)
+	}
+}
+
+func TestReportService_MailJournal(t *testing.T) {
+	repo := &mockReportRepo{journal: testJournalTransactions()}
+	sender := &mockEmailSender{}
+	svc := NewReportService(repo, nil, sender).(*reportService)
+	svc.now = func() time.Time { return time.Date(2026, 10, 1, 2, 0, 0, 0, time.UTC) }
+
+	if err := svc.MailJournal(context.Background(), "books@example.com"); err != nil {
+		t.Fatalf("MailJournal() error = %v", err)
+	}
+	if !repo.from.Equal(journalFrom) || !repo.to.Equal(journalTo) {
+		t.Fatalf("expected last month exported, got %v - %v", repo.from, repo.to)
+	}
+	if sender.to != "books@example.com" || sender.subject != "ReForest Journal September 2026" || len(sender.attachments) != 2 {
+		t.Fatalf("unexpected mail %q to %s with %d attachments", sender.subject, sender.to, len(sender.attachments))
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+const maxMatchRatio = 10
+
+var emailDomainPattern = regexp.MustCompile(`^[a-z0-9-]+(\.[a-z0-9-]+)+$`)
+
+// publicEmailDomains cannot be matched on, as they do not identify an
+// employer.
+var publicEmailDomains = map[string]bool{
+	"gmail.com":   true,
+	"yahoo.com":   true,
+	"yahoo.co.id": true,
+	"outlook.com": true,
+	"hotmail.com": true,
+	"icloud.com":  true,
+}
+
+// adoptionMatchedEvent asks the tree service to plant the trees a matching
+// campaign funded next to an employee's adoption.
+type adoptionMatchedEvent struct {
+	ReferenceID  string `json:"reference_id"` // the employee's adoption intent
+	MatchID      string `json:"match_id"`
+	Organization string `json:"organization"`
+	Trees        int    `json:"trees"`
+}
+
+func (s *financeService) CreateMatchingCampaign(ctx context.Context, creatorID uuid.UUID, c *models.MatchingCampaign) (*models.MatchingCampaign, error) {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Organization = strings.TrimSpace(c.Organization)
+	if c.Name == "" || c.Organization == "" {
+		return nil, fmt.Errorf("%w: name and organization are required", models.ErrInvalidInput)
+	}
+
+	domains, err := normalizeEmailDomains(c.EmailDomains)
+	if err != nil {
+		return nil, err
+	}
+	c.EmailDomains = domains
+
+	if c.MatchRatio == 0 {
+		c.MatchRatio = 1
+	}
+	if c.MatchRatio < 1 || c.MatchRatio > maxMatchRatio {
+		return nil, fmt.Errorf("%w: match ratio must be between 1 and %d", models.ErrInvalidInput, maxMatchRatio)
+	}
+	if c.MaxTreesPerEmployee < 0 {
+		return nil, fmt.Errorf("%w: per-employee cap cannot be negative", models.ErrInvalidInput)
+	}
+	if c.BudgetAmount <= 0 {
+		return nil, fmt.Errorf("%w: budget must be positive", models.ErrInvalidInput)
+	}
+	if c.Currency, err = money.Normalize(c.Currency); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+	if c.StartsAt != nil && c.EndsAt != nil && !c.EndsAt.After(*c.StartsAt) {
+		return nil, fmt.Errorf("%w: campaign must end after it starts", models.ErrInvalidInput)
+	}
+
+	c.SpentAmount = 0
+	c.Status = "ACTIVE"
+	c.CreatedBy = creatorID
+	if err := s.repo.CreateMatchingCampaign(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// normalizeEmailDomains lower-cases a comma separated list of domains and
+// drops duplicates and leading '@'s.
+func normalizeEmailDomains(list string) (string, error) {
+	var domains []string
+	seen := make(map[string]bool)
+	for _, d := range strings.Split(list, ",") {
+		d = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(d)), "@")
+		if d == "" || seen[d] {
+			continue
+		}
+		if !emailDomainPattern.MatchString(d) {
+			return "", fmt.Errorf("%w: invalid email domain %q", models.ErrInvalidInput, d)
+		}
+		if publicEmailDomains[d] {
+			return "", fmt.Errorf("%w: %s is a public email provider", models.ErrInvalidInput, d)
+		}
+		seen[d] = true
+		domains = append(domains, d)
+	}
+	if len(domains) == 0 {
+		return "", fmt.Errorf("%w: at least one email domain is required", models.ErrInvalidInput)
+	}
+	return strings.Join(domains, ","), nil
+}
+
+func (s *financeService) ListMatchingCampaigns(ctx context.Context) ([]models.MatchingCampaign, error) {
+	return s.repo.ListMatchingCampaigns(ctx)
+}
+
+// UpdateMatchingCampaignStatus pauses, resumes or ends a campaign. Ending it
+// is final; the unspent budget is no longer drawn on.
+func (s *financeService) UpdateMatchingCampaignStatus(ctx context.Context, id uuid.UUID, status string) (*models.MatchingCampaign, error) {
+	status = strings.ToUpper(strings.TrimSpace(status))
+	switch status {
+	case "ACTIVE", "PAUSED", "ENDED":
+	default:
+		return nil, fmt.Errorf("%w: status must be ACTIVE, PAUSED or ENDED", models.ErrInvalidInput)
+	}
+
+	campaign, err := s.repo.GetMatchingCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status == "ENDED" {
+		return nil, fmt.Errorf("%w: campaign has ended", models.ErrInvalidInput)
+	}
+	if err := s.repo.UpdateMatchingCampaignStatus(ctx, id, status); err != nil {
+		return nil, err
+	}
+	campaign.Status = status
+	return campaign, nil
+}
+
+func (s *financeService) ListCampaignMatches(ctx context.Context, campaignID uuid.UUID) ([]models.CampaignMatch, error) {
+	if _, err := s.repo.GetMatchingCampaign(ctx, campaignID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListCampaignMatches(ctx, campaignID)
+}
+
+// matchAdoption funds trees from the first running campaign that covers the
+// email domain of a settled adoption's sponsor and can still afford one. Each
+// matched tree costs the adoption's list price, converted into the campaign
+// currency. Callers run it inside the WithTransaction that settles tx, so the
+// match, the budget it took and the adoption.matched event commit with the
+// payment. It returns nil when nothing was matched.
+func (s *financeService) matchAdoption(ctx context.Context, repo repository.FinanceRepository, tx *models.Transaction) (*models.CampaignMatch, *models.MatchingCampaign, error) {
+	if tx.Type != "ADOPT" {
+		return nil, nil, nil
+	}
+	email, _, err := repo.GetUserEmailAndBalance(ctx, tx.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return nil, nil, nil
+	}
+	campaigns, err := repo.FindMatchingCampaigns(ctx, strings.ToLower(email[at+1:]), time.Now())
+	if err != nil || len(campaigns) == 0 {
+		return nil, nil, err
+	}
+
+	price, currency := tx.Amount+tx.DiscountAmount, tx.Currency
+	if tx.OriginalCurrency != "" {
+		price, currency = tx.OriginalAmount, tx.OriginalCurrency
+	}
+
+	for i := range campaigns {
+		c := &campaigns[i]
+		cost, _, err := s.rates.Convert(price, currency, c.Currency)
+		if err != nil || cost <= 0 {
+			log.Printf("WARN: cannot price adoption %s for campaign %s: %v", tx.ID, c.ID, err)
+			continue
+		}
+
+		trees := c.MatchRatio
+		if c.MaxTreesPerEmployee > 0 {
+			matched, err := repo.SumMatchedTrees(ctx, c.ID, tx.UserID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if left := c.MaxTreesPerEmployee - int(matched); trees > left {
+				trees = left
+			}
+		}
+		if affordable := (c.BudgetAmount - c.SpentAmount) / cost; int64(trees) > affordable {
+			trees = int(affordable)
+		}
+		if trees <= 0 {
+			continue
+		}
+
+		if err := repo.SpendMatchingBudget(ctx, c.ID, cost*int64(trees)); err != nil {
+			if errors.Is(err, models.ErrLimitExceeded) {
+				continue
+			}
+			return nil, nil, err
+		}
+		match := &models.CampaignMatch{
+			CampaignID:    c.ID,
+			UserID:        tx.UserID,
+			TransactionID: tx.ID,
+			ReferenceID:   tx.ReferenceID,
+			Trees:         trees,
+			Amount:        cost * int64(trees),
+			Currency:      c.Currency,
+		}
+		if err := repo.CreateCampaignMatch(ctx, match); err != nil {
+			return nil, nil, err
+		}
+		err = repo.EnqueueEvent(ctx, "adoption.matched", adoptionMatchedEvent{
+			ReferenceID:  tx.ReferenceID,
+			MatchID:      match.ID.String(),
+			Organization: c.Organization,
+			Trees:        trees,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return match, c, nil
+	}
+	return nil, nil, nil
+}
+
+// notifyMatched tells the employee which company matched their adoption.
+func (s *financeService) notifyMatched(ctx context.Context, tx *models.Transaction, match *models.CampaignMatch, campaign *models.MatchingCampaign) {
+	if match == nil {
+		return
+	}
+	s.notify(ctx, noticeAdoptionMatched, tx, walletNoticeData{
+		Organization: campaign.Organization,
+		Campaign:     campaign.Name,
+		MatchedTrees: match.Trees,
+	}, nil)
+}
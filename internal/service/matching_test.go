@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+func TestFinanceService_CreateMatchingCampaign(t *testing.T) {
+	t.Run("normalizes domains", func(t *testing.T) {
+		repo := &mockFinanceRepo{}
+		svc := NewFinanceService(repo, "x", nil, nil)
+
+		campaign, err := svc.CreateMatchingCampaign(context.Background(), uuid.New(), &models.MatchingCampaign{
+			Name:         "Earth Month",
+			Organization: "Acme",
+			EmailDomains: " @Acme.com, acme.co.id,acme.com",
+			BudgetAmount: 5000000,
+			Currency:     "idr",
+		})
+		if err != nil {
+			t.Fatalf("CreateMatchingCampaign() error = %v", err)
+		}
+		if campaign.EmailDomains != "acme.com,acme.co.id" || campaign.MatchRatio != 1 || campaign.Currency != "IDR" || campaign.Status != "ACTIVE" {
+			t.Fatalf("unexpected campaign %+v", campaign)
+		}
+	})
+
+	for name, c := range map[string]models.MatchingCampaign{
+		"public email provider": {Name: "x", Organization: "Acme", EmailDomains: "gmail.com", BudgetAmount: 1, Currency: "IDR"},
+		"invalid domain":        {Name: "x", Organization: "Acme", EmailDomains: "acme", BudgetAmount: 1, Currency: "IDR"},
+		"ratio too high":        {Name: "x", Organization: "Acme", EmailDomains: "acme.com", MatchRatio: 11, BudgetAmount: 1, Currency: "IDR"},
+		"no budget":             {Name: "x", Organization: "Acme", EmailDomains: "acme.com", Currency: "IDR"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := &mockFinanceRepo{}
+			svc := NewFinanceService(repo, "x", nil, nil)
+
+			if _, err := svc.CreateMatchingCampaign(context.Background(), uuid.New(), &c); !errors.Is(err, models.ErrInvalidInput) {
+				t.Fatalf("expected ErrInvalidInput, got %v", err)
+			}
+			if repo.createdCampaign != nil {
+				t.Fatalf("campaign should not be stored")
+			}
+		})
+	}
+}
+
+func TestFinanceService_CreateTransaction_MatchesAdoption(t *testing.T) {
+	campaign := models.MatchingCampaign{
+		ID:           uuid.New(),
+		Name:         "Earth Month",
+		Organization: "Acme",
+		MatchRatio:   3,
+		BudgetAmount: 500000,
+		SpentAmount:  300000,
+		Currency:     "IDR",
+	}
+	repo := &mockFinanceRepo{
+		getBalanceValue: 1000000,
+		getEmail:        "Budi@Acme.com",
+		campaigns:       []models.MatchingCampaign{campaign},
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	tx, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:      uuid.New().String(),
+		Amount:      100000,
+		Currency:    "IDR",
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "intent-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateTransaction() error = %v", err)
+	}
+
+	if repo.campaignDomain != "acme.com" {
+		t.Fatalf("campaigns should be looked up by email domain, got %q", repo.campaignDomain)
+	}
+	// 3:1, but the budget left only covers two trees
+	match := repo.createdMatch
+	if match == nil || match.Trees != 2 || match.Amount != 200000 || match.TransactionID != tx.ID || repo.spentAmount != 200000 {
+		t.Fatalf("expected two trees matched, got %+v (spent %d)", match, repo.spentAmount)
+	}
+	if len(repo.events) != 2 || repo.events[1].routingKey != "adoption.matched" {
+		t.Fatalf("expected adoption.matched after payment.success, got %+v", repo.events)
+	}
+	if event := repo.events[1].payload.(adoptionMatchedEvent); event.ReferenceID != "intent-1" || event.Trees != 2 || event.MatchID != match.ID.String() {
+		t.Fatalf("unexpected event %+v", event)
+	}
+	if !strings.Contains(sender.body, "Acme menggandakan adopsi kamu: 2 pohon tambahan") {
+		t.Fatalf("employee should be told about the match, got %q", sender.body)
+	}
+}
+
+func TestFinanceService_MatchAdoption_EmployeeCapReached(t *testing.T) {
+	repo := &mockFinanceRepo{
+		getEmail:     "budi@acme.com",
+		matchedTrees: 5,
+		campaigns: []models.MatchingCampaign{{
+			ID:                  uuid.New(),
+			MatchRatio:          1,
+			MaxTreesPerEmployee: 5,
+			BudgetAmount:        1000000,
+			Currency:            "IDR",
+		}},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil).(*financeService)
+
+	match, _, err := svc.matchAdoption(context.Background(), repo, &models.Transaction{ID: uuid.New(), Type: "ADOPT", Amount: 100000, Currency: "IDR"})
+	if err != nil || match != nil {
+		t.Fatalf("expected no match once the employee cap is reached, got %+v, %v", match, err)
+	}
+	if len(repo.events) != 0 || repo.spentAmount != 0 {
+		t.Fatalf("no budget should be spent")
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"text/template"
+
+	"reforest/internal/models"
+	"reforest/pkg/money"
+	"reforest/pkg/pb"
+)
+
+// walletNotice is an email sent to a sponsor when one of their payments
+// changes status.
+type walletNotice struct {
+	Subject string
+	Body    *template.Template
+}
+
+func newWalletNotice(subject, body string) *walletNotice {
+	return &walletNotice{
+		Subject: subject,
+		Body:    template.Must(template.New(subject).Parse(body)),
+	}
+}
+
+var (
+	noticeInvoiceCreated = newWalletNotice("Tagihan ReForest Menunggu Pembayaran", `Halo,
+
+Tagihan {{.Purpose}} sebesar {{.Invoiced}} sudah dibuat.
+{{if .Held}}Saldo sebesar {{.Held}} dari wallet kamu ditahan untuk pembayaran ini.
+{{end}}Selesaikan pembayaran sebelum {{.ExpiresAt}} melalui tautan berikut:
+{{.PaymentURL}}
+
+No. transaksi: {{.TransactionID}}`)
+
+	noticeInvoiceReminder = newWalletNotice("Pengingat: Tagihan ReForest Segera Kedaluwarsa", `Halo,
+
+Tagihan {{.Purpose}} sebesar {{.Invoiced}} akan kedaluwarsa dalam {{.TimeLeft}}, pada {{.ExpiresAt}}.
+Setelah itu, tempat pohon yang kamu pilih akan dilepas untuk sponsor lain.
+Selesaikan pembayaran melalui tautan berikut:
+{{.PaymentURL}}
+
+No. transaksi: {{.TransactionID}}`)
+
+	noticeTopUpCredited = newWalletNotice("Top Up Berhasil", `Halo,
+
+Pembayaran top up kamu sudah kami terima.
+Saldo kamu bertambah {{.Credited}}.
+Total saldo kamu sekarang: {{.Balance}}
+
+No. transaksi: {{.TransactionID}}
+Bukti pembayaran terlampir.`)
+
+	noticePaymentExpired = newWalletNotice("Tagihan Kedaluwarsa", `Halo,
+
+Tagihan {{.Purpose}} sebesar {{.Invoiced}} sudah kedaluwarsa dan tidak bisa dibayar lagi.
+{{if .Released}}Saldo sebesar {{.Released}} yang ditahan sudah dikembalikan ke wallet kamu.
+{{end}}Saldo kamu sekarang: {{.Balance}}
+
+No. transaksi: {{.TransactionID}}`)
+
+	noticePaymentCancelled = newWalletNotice("Tagihan Dibatalkan", `Halo,
+
+Tagihan {{.Purpose}} sebesar {{.Invoiced}} sudah kamu batalkan.
+{{if .Released}}Saldo sebesar {{.Released}} yang ditahan sudah dikembalikan ke wallet kamu.
+{{end}}Saldo kamu sekarang: {{.Balance}}
+
+No. transaksi: {{.TransactionID}}`)
+
+	noticePaymentRefunded = newWalletNotice("Pembayaran Dikembalikan ke Saldo", `Halo,
+
+Pembayaran {{.Purpose}} sebesar {{.Credited}} kami terima setelah tagihannya kedaluwarsa, sehingga tidak dapat diproses lagi.
+Dana tersebut sudah dikreditkan ke wallet kamu.
+Total saldo kamu sekarang: {{.Balance}}
+
+No. transaksi: {{.TransactionID}}
+Bukti pembayaran terlampir.`)
+
+	noticeBalanceAdjusted = newWalletNotice("Penyesuaian Saldo", `Halo,
+
+Tim ReForest telah menyesuaikan saldo wallet kamu.
+{{if .Credited}}Saldo kamu bertambah {{.Credited}}.{{else}}Saldo kamu berkurang {{.Debited}}.{{end}}
+Alasan: {{.Reason}}
+Total saldo kamu sekarang: {{.Balance}}
+
+No. transaksi: {{.TransactionID}}`)
+
+	noticeAdoptionMatched = newWalletNotice("Adopsi Kamu Digandakan", `Halo,
+
+Terima kasih sudah mengadopsi pohon.
+Lewat program {{.Campaign}}, {{.Organization}} menggandakan adopsi kamu: {{.MatchedTrees}} pohon tambahan akan ditanam atas nama kamu.
+
+No. transaksi: {{.TransactionID}}`)
+)
+
+// walletNoticeData fills a walletNotice. Amounts are formatted in the
+// transaction currency; empty ones are left out of the message.
+type walletNoticeData struct {
+	Purpose       string
+	Invoiced      string
+	Held          string
+	Released      string
+	Credited      string
+	Debited       string
+	Balance       string
+	Reason        string
+	Organization  string
+	Campaign      string
+	MatchedTrees  int
+	PaymentURL    string
+	ExpiresAt     string
+	TimeLeft      string
+	TransactionID string
+}
+
+// notify emails notice about tx to its owner, attaching the receipt of
+// receiptOf when given. It runs after the status change was committed, so
+// the balance quoted is the settled one. Failures are only logged.
+func (s *financeService) notify(ctx context.Context, notice *walletNotice, tx *models.Transaction, data walletNoticeData, receiptOf *models.Transaction) {
+	if s.emailSender == nil {
+		return
+	}
+
+	email, balance, err := s.repo.GetUserEmailAndBalance(ctx, tx.UserID)
+	if err != nil {
+		log.Printf("WARN: failed to fetch user email for %q notification of %s: %v", notice.Subject, tx.ID, err)
+		return
+	}
+
+	data.Purpose = paymentPurpose(tx.Type)
+	data.Invoiced = money.Format(tx.Payment.Amount, tx.Currency)
+	data.Balance = money.Format(balance, tx.Currency)
+	data.TransactionID = tx.ID.String()
+	var body strings.Builder
+	if err := notice.Body.Execute(&body, data); err != nil {
+		log.Printf("WARN: failed to render %q notification of %s: %v", notice.Subject, tx.ID, err)
+		return
+	}
+
+	var attachments []Attachment
+	if receiptOf != nil {
+		doc, err := renderReceipt(receiptOf, email, pb.DocumentFormat_PDF)
+		if err != nil {
+			log.Printf("WARN: failed to render receipt %s: %v", receiptOf.ID, err)
+		} else {
+			attachments = append(attachments, Attachment{FileName: doc.FileName, ContentType: doc.ContentType, Content: doc.Content})
+		}
+	}
+	if err := s.emailSender.SendWithAttachments(email, notice.Subject, body.String(), attachments...); err != nil {
+		log.Printf("WARN: failed to send %q notification to %s: %v", notice.Subject, email, err)
+	}
+}
+
+// notifyInvoiceCreated sends the link to pay a freshly issued invoice.
+func (s *financeService) notifyInvoiceCreated(ctx context.Context, tx *models.Transaction) {
+	data := walletNoticeData{
+		PaymentURL: tx.Payment.PaymentURL,
+		ExpiresAt:  tx.Payment.ExpiresAt.Format("02 Jan 2006 15:04 MST"),
+	}
+	if held := tx.Amount - tx.Payment.Amount; held > 0 {
+		data.Held = money.Format(held, tx.Currency)
+	}
+	s.notify(ctx, noticeInvoiceCreated, tx, data, nil)
+}
+
+func paymentPurpose(txType string) string {
+	switch txType {
+	case "DEPOSIT":
+		return "top up"
+	case "ADOPT":
+		return "adopsi pohon"
+	case "CARE":
+		return "perawatan pohon"
+	case "ADJUSTMENT":
+		return "penyesuaian saldo"
+	}
+	return strings.ToLower(txType)
+}
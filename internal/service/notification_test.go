@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"reforest/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestFinanceService_TopUpWallet_MailsPaymentLink(t *testing.T) {
+	repo := &mockFinanceRepo{getEmail: "sponsor@example.com", getEmailBalanceVal: 5000}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		body := `{"id":"inv-1","invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"2030-01-01T00:00:00Z"}`
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	if _, err := svc.TopUpWallet(context.Background(), uuid.New(), 50000, "", 3600, "", models.PaymentMethod{}); err != nil {
+		t.Fatalf("TopUpWallet() error = %v", err)
+	}
+
+	if len(sender.recipients) != 1 || sender.to != "sponsor@example.com" || sender.subject != "Tagihan ReForest Menunggu Pembayaran" {
+		t.Fatalf("expected one invoice mail to the sponsor, got %q to %v", sender.subject, sender.recipients)
+	}
+	if !strings.Contains(sender.body, "https://pay.xendit.co/abc") || !strings.Contains(sender.body, "IDR 50.000") {
+		t.Fatalf("invoice mail should carry the amount and payment link, got %q", sender.body)
+	}
+	if strings.Contains(sender.body, "bertambah") {
+		t.Fatalf("nothing has been credited yet, got %q", sender.body)
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_PaidTopUpQuotesSettledBalance(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:             txID,
+			UserID:         uuid.New(),
+			Amount:         45000,
+			Currency:       "IDR",
+			Type:           "DEPOSIT",
+			DiscountAmount: 5000,
+			Payment:        models.Payment{Amount: 45000, Status: "PENDING"},
+		},
+		getEmail:           "sponsor@example.com",
+		getEmailBalanceVal: 150000,
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+
+	if len(sender.recipients) != 1 || sender.subject != "Top Up Berhasil" {
+		t.Fatalf("expected one top up mail, got %q to %v", sender.subject, sender.recipients)
+	}
+	if !strings.Contains(sender.body, "bertambah IDR 50.000") || !strings.Contains(sender.body, "sekarang: IDR 150.000") {
+		t.Fatalf("mail should quote the credited amount and settled balance, got %q", sender.body)
+	}
+	if len(sender.attachments) != 1 {
+		t.Fatalf("expected the receipt attached, got %d attachments", len(sender.attachments))
+	}
+}
+
+func TestFinanceService_CheckPaymentExpiry_MailsReleasedFunds(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		pendingBefore: []models.Transaction{
+			{ID: txID, UserID: uuid.New(), Amount: 100000, Currency: "IDR", Type: "ADOPT", Payment: models.Payment{Amount: 70000, Status: "PENDING"}},
+		},
+		holdByTx:           &models.BalanceHold{ID: uuid.New(), Amount: 30000, Currency: "IDR", Status: "HELD"},
+		getEmail:           "sponsor@example.com",
+		getEmailBalanceVal: 30000,
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	if err := svc.CheckPaymentExpiry(context.Background()); err != nil {
+		t.Fatalf("CheckPaymentExpiry() error = %v", err)
+	}
+
+	if sender.subject != "Tagihan Kedaluwarsa" {
+		t.Fatalf("expected an expiry mail, got %q", sender.subject)
+	}
+	for _, want := range []string{"adopsi pohon sebesar IDR 70.000", "IDR 30.000 yang ditahan", "Saldo kamu sekarang: IDR 30.000", txID.String()} {
+		if !strings.Contains(sender.body, want) {
+			t.Fatalf("expiry mail should contain %q, got %q", want, sender.body)
+		}
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_LatePaymentMailsRefund(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:       txID,
+			UserID:   uuid.New(),
+			Amount:   100000,
+			Currency: "IDR",
+			Type:     "ADOPT",
+			Payment:  models.Payment{Amount: 70000, Status: "EXPIRED"},
+		},
+		getEmail:           "sponsor@example.com",
+		getEmailBalanceVal: 100000,
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+
+	if sender.subject != "Pembayaran Dikembalikan ke Saldo" || !strings.Contains(sender.body, "sebesar IDR 70.000") {
+		t.Fatalf("expected a refund mail for the invoiced part, got %q: %q", sender.subject, sender.body)
+	}
+	if len(sender.attachments) != 1 {
+		t.Fatalf("expected the deposit receipt attached, got %d attachments", len(sender.attachments))
+	}
+}
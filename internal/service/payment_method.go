@@ -0,0 +1,90 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"reforest/internal/models"
+)
+
+// Payment methods a sponsor can ask for at checkout.
+const (
+	paymentMethodVirtualAccount = "VIRTUAL_ACCOUNT"
+	paymentMethodQRIS           = "QRIS"
+	paymentMethodEWallet        = "EWALLET"
+	paymentMethodCard           = "CARD"
+)
+
+// paymentChannels lists the Xendit channel codes offered for each method. An
+// invoice for a method without a channel offers all of them.
+var paymentChannels = map[string][]string{
+	paymentMethodVirtualAccount: {"BCA", "BNI", "BRI", "MANDIRI", "PERMATA", "BSI", "CIMB"},
+	paymentMethodQRIS:           {"QRIS"},
+	paymentMethodEWallet:        {"OVO", "DANA", "SHOPEEPAY", "LINKAJA", "ASTRAPAY"},
+	paymentMethodCard:           {"CREDIT_CARD"},
+}
+
+// xenditPaymentMethods maps the method Xendit reports on a paid invoice to
+// ours. Anything else, such as retail outlets, is kept as reported.
+var xenditPaymentMethods = map[string]string{
+	"BANK_TRANSFER": paymentMethodVirtualAccount,
+	"QR_CODE":       paymentMethodQRIS,
+	"EWALLET":       paymentMethodEWallet,
+	"CREDIT_CARD":   paymentMethodCard,
+}
+
+// checkPaymentMethod validates the method and channel a sponsor asked for
+// and normalises their case. Only cards are offered outside IDR.
+func checkPaymentMethod(method, channel, currency string) (models.PaymentMethod, error) {
+	pm := models.PaymentMethod{
+		Method:  strings.ToUpper(strings.TrimSpace(method)),
+		Channel: strings.ToUpper(strings.TrimSpace(channel)),
+	}
+	if pm.Method == "" {
+		if pm.Channel != "" {
+			return pm, fmt.Errorf("%w: a payment channel needs a payment method", models.ErrInvalidInput)
+		}
+		return pm, nil
+	}
+
+	channels, ok := paymentChannels[pm.Method]
+	if !ok {
+		return pm, fmt.Errorf("%w: unknown payment method %q, must be VIRTUAL_ACCOUNT, QRIS, EWALLET or CARD", models.ErrInvalidInput, method)
+	}
+	if pm.Method != paymentMethodCard && currency != "IDR" {
+		return pm, fmt.Errorf("%w: %s is only available for IDR payments", models.ErrInvalidInput, pm.Method)
+	}
+	if pm.Channel == "" {
+		return pm, nil
+	}
+	for _, c := range channels {
+		if c == pm.Channel {
+			return pm, nil
+		}
+	}
+	return pm, fmt.Errorf("%w: %s is not offered for %s, must be one of %s", models.ErrInvalidInput, pm.Channel, pm.Method, strings.Join(channels, ", "))
+}
+
+// invoicePaymentMethods returns the Xendit channel codes an invoice should
+// offer, or nil to offer all of them.
+func invoicePaymentMethods(pm models.PaymentMethod) []string {
+	if pm.Method == "" {
+		return nil
+	}
+	if pm.Channel != "" {
+		return []string{pm.Channel}
+	}
+	return paymentChannels[pm.Method]
+}
+
+// paidWith returns the method reported on a paid invoice, or fallback when
+// the provider did not say.
+func paidWith(method, channel string, fallback models.PaymentMethod) models.PaymentMethod {
+	if method == "" {
+		return fallback
+	}
+	if m, ok := xenditPaymentMethods[method]; ok {
+		method = m
+	}
+	return models.PaymentMethod{Method: method, Channel: channel}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+func TestCheckPaymentMethod(t *testing.T) {
+	tests := []struct {
+		name            string
+		method, channel string
+		currency        string
+		want            models.PaymentMethod
+		wantErr         bool
+	}{
+		{name: "any method", currency: "IDR"},
+		{name: "method only", method: "qris", currency: "IDR", want: models.PaymentMethod{Method: "QRIS"}},
+		{name: "bank", method: "VIRTUAL_ACCOUNT", channel: "bca", currency: "IDR", want: models.PaymentMethod{Method: "VIRTUAL_ACCOUNT", Channel: "BCA"}},
+		{name: "card in USD", method: "CARD", currency: "USD", want: models.PaymentMethod{Method: "CARD"}},
+		{name: "e-wallet in USD", method: "EWALLET", channel: "OVO", currency: "USD", wantErr: true},
+		{name: "channel of another method", method: "EWALLET", channel: "BCA", currency: "IDR", wantErr: true},
+		{name: "channel without method", channel: "OVO", currency: "IDR", wantErr: true},
+		{name: "unknown method", method: "CASH", currency: "IDR", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkPaymentMethod(tt.method, tt.channel, tt.currency)
+			if tt.wantErr {
+				if !errors.Is(err, models.ErrInvalidInput) {
+					t.Fatalf("expected ErrInvalidInput, got %v", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("checkPaymentMethod() = %+v, %v, want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinanceService_TopUpWallet_OffersChosenMethod(t *testing.T) {
+	repo := &mockFinanceRepo{}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	var sent xenditInvoiceRequest
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Fatalf("invalid invoice request: %v", err)
+		}
+		body := `{"id":"inv-1","invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"2030-01-01T00:00:00Z"}`
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	tx, err := svc.TopUpWallet(context.Background(), uuid.New(), 50000, "", 3600, "", models.PaymentMethod{Method: "ewallet"})
+	if err != nil {
+		t.Fatalf("TopUpWallet() error = %v", err)
+	}
+	if !reflect.DeepEqual(sent.PaymentMethods, paymentChannels[paymentMethodEWallet]) {
+		t.Fatalf("invoice should offer the e-wallets only, got %v", sent.PaymentMethods)
+	}
+	if tx.Payment.Method != "EWALLET" || tx.Payment.Status != "PENDING" {
+		t.Fatalf("preferred method should be kept on the payment, got %+v", tx.Payment)
+	}
+}
+
+func TestFinanceService_CreateTransaction_RejectsUnknownMethod(t *testing.T) {
+	repo := &mockFinanceRepo{}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	_, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:         uuid.New().String(),
+		Amount:         50000,
+		Currency:       "IDR",
+		Type:           pb.TransactionType_ADOPT,
+		ReferenceId:    "intent-1",
+		PaymentMethod:  "VIRTUAL_ACCOUNT",
+		PaymentChannel: "OVO",
+	})
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+	if repo.createTx != nil {
+		t.Fatalf("no transaction should be created")
+	}
+}
+
+func TestFinanceService_HandleWalletWebhook_RecordsMethodUsed(t *testing.T) {
+	txID := uuid.New()
+	repo := &mockFinanceRepo{txByID: &models.Transaction{
+		ID:       txID,
+		UserID:   uuid.New(),
+		Amount:   50000,
+		Currency: "IDR",
+		Type:     "DEPOSIT",
+		Payment:  models.Payment{Amount: 50000, Status: "PENDING", PaymentMethod: models.PaymentMethod{Method: "VIRTUAL_ACCOUNT"}},
+	}}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	payload := `{"external_id":"` + txID.String() + `","status":"PAID","payment_method":"BANK_TRANSFER","payment_channel":"BNI"}`
+	if err := svc.HandleWalletWebhook(context.Background(), "INVOICE_CALLBACK", []byte(payload)); err != nil {
+		t.Fatalf("HandleWalletWebhook() error = %v", err)
+	}
+	if want := (models.PaymentMethod{Method: "VIRTUAL_ACCOUNT", Channel: "BNI"}); repo.settledMethod != want {
+		t.Fatalf("expected %+v recorded, got %+v", want, repo.settledMethod)
+	}
+}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+	"reforest/pkg/pb"
+	"reforest/pkg/pdf"
+
+	"github.com/google/uuid"
+)
+
+// PayoutService manages the landowners hosting our plots and the monthly
+// share of plot revenue we owe them. Revenue is counted the way the revenue
+// report counts it: settled ADOPT and CARE transactions, attributed to the
+// plot of the adoption intent they reference.
+type PayoutService interface {
+	CreatePartner(ctx context.Context, partner *models.Partner) (*models.Partner, error)
+	UpdatePartner(ctx context.Context, partner *models.Partner) (*models.Partner, error)
+	ListPartners(ctx context.Context) ([]models.Partner, error)
+	CalculatePayouts(ctx context.Context, year int, month time.Month) ([]models.Payout, error)
+	CalculateLastMonth(ctx context.Context) error
+	ListPayouts(ctx context.Context, partnerID uuid.UUID, status string) ([]models.Payout, error)
+	UpdatePayoutStatus(ctx context.Context, id uuid.UUID, status, reference, note string) (*models.Payout, error)
+	GetPayoutStatement(ctx context.Context, id uuid.UUID, format pb.DocumentFormat) (*models.Document, error)
+}
+
+type payoutService struct {
+	repo       repository.PartnerRepository
+	reports    ReportService
+	treeClient pb.TreeServiceClient
+	now        func() time.Time
+}
+
+func NewPayoutService(repo repository.PartnerRepository, reports ReportService, treeClient pb.TreeServiceClient) PayoutService {
+	return &payoutService{
+		repo:       repo,
+		reports:    reports,
+		treeClient: treeClient,
+		now:        time.Now,
+	}
+}
+
+func (s *payoutService) CreatePartner(ctx context.Context, partner *models.Partner) (*models.Partner, error) {
+	if err := s.checkPartner(ctx, partner); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreatePartner(ctx, partner); err != nil {
+		return nil, err
+	}
+	return partner, nil
+}
+
+// UpdatePartner replaces a partner's details and plots. Payouts already
+// calculated keep the shares they were calculated with.
+func (s *payoutService) UpdatePartner(ctx context.Context, partner *models.Partner) (*models.Partner, error) {
+	if err := s.checkPartner(ctx, partner); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdatePartner(ctx, partner); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPartner(ctx, partner.ID)
+}
+
+func (s *payoutService) ListPartners(ctx context.Context) ([]models.Partner, error) {
+	return s.repo.ListPartners(ctx)
+}
+
+// checkPartner validates a partner and normalises its fields. Every linked
+// plot must exist in the tree service.
+func (s *payoutService) checkPartner(ctx context.Context, partner *models.Partner) error {
+	partner.Name = strings.TrimSpace(partner.Name)
+	partner.Email = strings.TrimSpace(partner.Email)
+	if partner.Name == "" {
+		return fmt.Errorf("%w: name is required", models.ErrInvalidInput)
+	}
+	if partner.ShareBps <= 0 || partner.ShareBps > 10000 {
+		return fmt.Errorf("%w: share must be between 1 and 10000 basis points", models.ErrInvalidInput)
+	}
+	if len(partner.Plots) == 0 {
+		return nil
+	}
+
+	plots, err := listAllPlots(ctx, s.treeClient)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(plots))
+	for _, p := range plots {
+		known[p.Id] = true
+	}
+
+	seen := make(map[string]bool, len(partner.Plots))
+	for i := range partner.Plots {
+		plot := &partner.Plots[i]
+		plot.PlotID = strings.TrimSpace(plot.PlotID)
+		if !known[plot.PlotID] {
+			return fmt.Errorf("%w: unknown plot %q", models.ErrInvalidInput, plot.PlotID)
+		}
+		if seen[plot.PlotID] {
+			return fmt.Errorf("%w: plot %s is listed twice", models.ErrInvalidInput, plot.PlotID)
+		}
+		seen[plot.PlotID] = true
+		if plot.ShareBps < 0 || plot.ShareBps > 10000 {
+			return fmt.Errorf("%w: share of plot %s must be between 0 and 10000 basis points", models.ErrInvalidInput, plot.PlotID)
+		}
+	}
+	return nil
+}
+
+// CalculatePayouts works out what each partner is owed for a calendar month
+// that has ended, one payout per partner and currency. Payouts calculated
+// before are left alone, so running it again only adds the missing ones.
+// The new payouts are returned.
+func (s *payoutService) CalculatePayouts(ctx context.Context, year int, month time.Month) ([]models.Payout, error) {
+	if month < time.January || month > time.December {
+		return nil, fmt.Errorf("%w: month must be between 1 and 12", models.ErrInvalidInput)
+	}
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	if to.After(s.now()) {
+		return nil, fmt.Errorf("%w: %s has not ended yet", models.ErrInvalidInput, from.Format("January 2006"))
+	}
+
+	partners, err := s.repo.ListPartners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, _, _, err := s.reports.RevenueReport(ctx, pb.RevenueGrouping_PLOT, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total plot revenue: %w", err)
+	}
+
+	payouts := payoutsFor(partners, rows, from, to)
+	created := make([]models.Payout, 0, len(payouts))
+	for i := range payouts {
+		payout := &payouts[i]
+		if err := s.repo.CreatePayout(ctx, payout); err != nil {
+			if errors.Is(err, models.ErrAlreadyExists) {
+				continue
+			}
+			return created, err
+		}
+		created = append(created, *payout)
+	}
+	return created, nil
+}
+
+// CalculateLastMonth calculates the payouts for the previous calendar month.
+// It is run by the scheduler at the start of each month.
+func (s *payoutService) CalculateLastMonth(ctx context.Context) error {
+	now := s.now().UTC()
+	last := now.AddDate(0, 0, -now.Day())
+	payouts, err := s.CalculatePayouts(ctx, last.Year(), last.Month())
+	if err != nil {
+		return err
+	}
+	log.Printf("Calculated %d partner payouts for %s", len(payouts), last.Format("2006-01"))
+	return nil
+}
+
+// payoutsFor splits plot revenue between the partners owning the plots.
+// Revenue of plots without a partner stays with us.
+func payoutsFor(partners []models.Partner, rows []repository.RevenueRow, from, to time.Time) []models.Payout {
+	type owner struct {
+		partner  *models.Partner
+		shareBps int
+	}
+	owners := make(map[string]owner)
+	for i := range partners {
+		for _, plot := range partners[i].Plots {
+			share := plot.ShareBps
+			if share == 0 {
+				share = partners[i].ShareBps
+			}
+			owners[plot.PlotID] = owner{&partners[i], share}
+		}
+	}
+
+	type payoutKey struct {
+		partnerID uuid.UUID
+		currency  string
+	}
+	index := make(map[payoutKey]int)
+	var payouts []models.Payout
+	for _, row := range rows {
+		o, ok := owners[row.Key]
+		if !ok || row.Amount <= 0 {
+			continue
+		}
+		k := payoutKey{o.partner.ID, row.Currency}
+		i, ok := index[k]
+		if !ok {
+			i = len(payouts)
+			index[k] = i
+			payouts = append(payouts, models.Payout{
+				PartnerID:   o.partner.ID,
+				Partner:     *o.partner,
+				PeriodStart: from,
+				PeriodEnd:   to,
+				Currency:    row.Currency,
+				Status:      "PENDING",
+			})
+		}
+
+		line := models.PayoutLine{
+			PlotID:           row.Key,
+			PlotName:         row.Label,
+			TransactionCount: row.Count,
+			RevenueAmount:    row.Amount,
+			ShareBps:         o.shareBps,
+			Amount:           shareOf(row.Amount, o.shareBps),
+		}
+		payouts[i].Lines = append(payouts[i].Lines, line)
+		payouts[i].RevenueAmount += line.RevenueAmount
+		payouts[i].Amount += line.Amount
+	}
+
+	sort.Slice(payouts, func(i, j int) bool {
+		if payouts[i].Partner.Name != payouts[j].Partner.Name {
+			return payouts[i].Partner.Name < payouts[j].Partner.Name
+		}
+		return payouts[i].Currency < payouts[j].Currency
+	})
+	return payouts
+}
+
+// shareOf returns bps basis points of amount, rounded half up to a minor
+// unit.
+func shareOf(amount int64, bps int) int64 {
+	return (amount*int64(bps) + 5000) / 10000
+}
+
+func (s *payoutService) ListPayouts(ctx context.Context, partnerID uuid.UUID, status string) ([]models.Payout, error) {
+	return s.repo.ListPayouts(ctx, partnerID, strings.ToUpper(strings.TrimSpace(status)))
+}
+
+// UpdatePayoutStatus records a pending payout as PAID, with the reference of
+// the bank transfer, or CANCELLED.
+func (s *payoutService) UpdatePayoutStatus(ctx context.Context, id uuid.UUID, status, reference, note string) (*models.Payout, error) {
+	status = strings.ToUpper(strings.TrimSpace(status))
+	reference = strings.TrimSpace(reference)
+	var paidAt *time.Time
+	switch status {
+	case "PAID":
+		if reference == "" {
+			return nil, fmt.Errorf("%w: a paid payout needs the transfer reference", models.ErrInvalidInput)
+		}
+		now := s.now()
+		paidAt = &now
+	case "CANCELLED":
+	default:
+		return nil, fmt.Errorf("%w: status must be PAID or CANCELLED", models.ErrInvalidInput)
+	}
+
+	payout, err := s.repo.GetPayout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payout.Status != "PENDING" {
+		return nil, fmt.Errorf("%w: payout is already %s", models.ErrInvalidInput, payout.Status)
+	}
+	if err := s.repo.UpdatePayoutStatus(ctx, id, status, reference, note, paidAt); err != nil {
+		return nil, err
+	}
+	payout.Status, payout.Reference, payout.Note, payout.PaidAt = status, reference, note, paidAt
+	return payout, nil
+}
+
+// GetPayoutStatement renders the statement sent to a partner with a payout,
+// as PDF or as CSV lines.
+func (s *payoutService) GetPayoutStatement(ctx context.Context, id uuid.UUID, format pb.DocumentFormat) (*models.Document, error) {
+	payout, err := s.repo.GetPayout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return renderPayoutStatement(payout, format)
+}
+
+func renderPayoutStatement(payout *models.Payout, format pb.DocumentFormat) (*models.Document, error) {
+	period := payout.PeriodStart.Format("2006-01")
+	name := fmt.Sprintf("payout-%s-%s-%s", period, strings.ToLower(payout.Currency), payout.ID.String()[:8])
+
+	switch format {
+	case pb.DocumentFormat_CSV:
+		content, err := writePayoutCSV(payout)
+		if err != nil {
+			return nil, err
+		}
+		return &models.Document{FileName: name + ".csv", ContentType: "text/csv", Content: content}, nil
+	case pb.DocumentFormat_PDF:
+		partner := payout.Partner
+		columns := []float64{0, 200, 260, 370, 420}
+
+		doc := pdf.New("ReForest Payout Statement " + period)
+		doc.Heading("ReForest - Landowner Payout Statement")
+		doc.Blank()
+		doc.Text("Statement No : " + payout.ID.String())
+		doc.Text("Period       : " + payout.PeriodStart.Format("02 Jan 2006") + " - " + payout.PeriodEnd.AddDate(0, 0, -1).Format("02 Jan 2006"))
+		doc.Text("Partner      : " + partner.Name)
+		if partner.BankAccountNumber != "" {
+			doc.Text("Pay To       : " + partner.BankName + " " + partner.BankAccountNumber + " a/n " + partner.BankAccountName)
+		}
+		doc.Blank()
+		doc.Row(columns, "Plot", "Payments", "Revenue", "Share", "Amount")
+		for _, line := range payout.Lines {
+			doc.Row(columns,
+				line.PlotName,
+				strconv.FormatInt(line.TransactionCount, 10),
+				money.Format(line.RevenueAmount, payout.Currency),
+				formatShare(line.ShareBps),
+				money.Format(line.Amount, payout.Currency),
+			)
+		}
+		doc.Blank()
+		doc.Text("Total Revenue : " + money.Format(payout.RevenueAmount, payout.Currency))
+		doc.Bold("Amount Owed   : " + money.Format(payout.Amount, payout.Currency))
+		doc.Text("Status        : " + payout.Status)
+		if payout.PaidAt != nil {
+			doc.Text("Paid On       : " + payout.PaidAt.Format("02 Jan 2006") + " (ref. " + payout.Reference + ")")
+		}
+		doc.Blank()
+		doc.Text("Revenue is what sponsors paid for the adoption and care of trees")
+		doc.Text("on your land, after discounts, in the period above.")
+		return &models.Document{FileName: name + ".pdf", ContentType: "application/pdf", Content: doc.Bytes()}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported document format", models.ErrInvalidInput)
+}
+
+func writePayoutCSV(payout *models.Payout) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"plot_id", "plot_name", "transaction_count", "revenue_amount", "share_bps", "amount", "currency"}); err != nil {
+		return nil, err
+	}
+	for _, line := range payout.Lines {
+		record := []string{
+			line.PlotID,
+			line.PlotName,
+			strconv.FormatInt(line.TransactionCount, 10),
+			strconv.FormatInt(line.RevenueAmount, 10),
+			strconv.Itoa(line.ShareBps),
+			strconv.FormatInt(line.Amount, 10),
+			payout.Currency,
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// formatShare prints basis points as a percentage, e.g. 2500 as 25.00%.
+func formatShare(bps int) string {
+	return fmt.Sprintf("%d.%02d%%", bps/100, bps%100)
+}
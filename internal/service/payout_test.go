@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+type mockPartnerRepo struct {
+	partners       []models.Partner
+	createdPartner *models.Partner
+	payouts        []models.Payout
+	existing       map[uuid.UUID]bool
+	payoutByID     *models.Payout
+	updatedStatus  string
+}
+
+func (m *mockPartnerRepo) CreatePartner(ctx context.Context, partner *models.Partner) error {
+	partner.ID = uuid.New()
+	m.createdPartner = partner
+	return nil
+}
+
+func (m *mockPartnerRepo) UpdatePartner(ctx context.Context, partner *models.Partner) error {
+	return nil
+}
+
+func (m *mockPartnerRepo) GetPartner(ctx context.Context, id uuid.UUID) (*models.Partner, error) {
+	return nil, models.ErrNotFound
+}
+
+func (m *mockPartnerRepo) ListPartners(ctx context.Context) ([]models.Partner, error) {
+	return m.partners, nil
+}
+
+func (m *mockPartnerRepo) CreatePayout(ctx context.Context, payout *models.Payout) error {
+	if m.existing[payout.PartnerID] {
+		return models.ErrAlreadyExists
+	}
+	payout.ID = uuid.New()
+	m.payouts = append(m.payouts, *payout)
+	return nil
+}
+
+func (m *mockPartnerRepo) GetPayout(ctx context.Context, id uuid.UUID) (*models.Payout, error) {
+	if m.payoutByID == nil {
+		return nil, models.ErrNotFound
+	}
+	return m.payoutByID, nil
+}
+
+func (m *mockPartnerRepo) ListPayouts(ctx context.Context, partnerID uuid.UUID, status string) ([]models.Payout, error) {
+	return m.payouts, nil
+}
+
+func (m *mockPartnerRepo) UpdatePayoutStatus(ctx context.Context, id uuid.UUID, status, reference, note string, paidAt *time.Time) error {
+	m.updatedStatus = status
+	return nil
+}
+
+func TestPayoutService_CalculatePayouts(t *testing.T) {
+	now := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	sari := models.Partner{ID: uuid.New(), Name: "Sari", ShareBps: 2000, Plots: []models.PartnerPlot{
+		{PlotID: "plot-a"},
+		{PlotID: "plot-b", ShareBps: 3333},
+	}}
+	budi := models.Partner{ID: uuid.New(), Name: "Budi", ShareBps: 1500, Plots: []models.PartnerPlot{{PlotID: "plot-c"}}}
+	reports := &mockReportRepo{revenue: []repository.RevenueRow{
+		{Key: "intent-1", Currency: "IDR", Count: 2, Amount: 100000},
+		{Key: "intent-2", Currency: "IDR", Count: 1, Amount: 50000},
+		{Key: "intent-3", Currency: "USD", Count: 1, Amount: 1000},
+		{Key: "intent-4", Currency: "IDR", Count: 4, Amount: 400000},
+		{Key: "intent-5", Currency: "IDR", Count: 1, Amount: 70000},
+	}}
+	tree := &mockTreeClient{
+		intents: []*pb.AdoptionIntent{
+			{Id: "intent-1", PlotId: "plot-a"},
+			{Id: "intent-2", PlotId: "plot-b"},
+			{Id: "intent-3", PlotId: "plot-a"},
+			{Id: "intent-4", PlotId: "plot-c"},
+			{Id: "intent-5", PlotId: "plot-unowned"},
+		},
+		plots: []*pb.Plot{{Id: "plot-a", LocationName: "Bogor"}, {Id: "plot-b", LocationName: "Lombok"}, {Id: "plot-c", LocationName: "Bali"}},
+	}
+	repo := &mockPartnerRepo{partners: []models.Partner{sari, budi}, existing: map[uuid.UUID]bool{budi.ID: true}}
+	svc := NewPayoutService(repo, NewReportService(reports, tree, nil), tree).(*payoutService)
+	svc.now = func() time.Time { return now }
+
+	payouts, err := svc.CalculatePayouts(context.Background(), 2026, time.September)
+	if err != nil {
+		t.Fatalf("CalculatePayouts() error = %v", err)
+	}
+
+	if !reports.from.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) || !reports.to.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("revenue should be totalled over September, got %v - %v", reports.from, reports.to)
+	}
+	if len(payouts) != 2 {
+		t.Fatalf("expected Sari's IDR and USD payouts only, got %+v", payouts)
+	}
+	idr, usd := payouts[0], payouts[1]
+	if idr.PartnerID != sari.ID || idr.Currency != "IDR" || idr.RevenueAmount != 150000 || idr.Amount != 20000+16665 {
+		t.Fatalf("unexpected IDR payout: %+v", idr)
+	}
+	if len(idr.Lines) != 2 || idr.Lines[1].PlotName != "Lombok" || idr.Lines[1].ShareBps != 3333 {
+		t.Fatalf("plot share should override the partner's, got %+v", idr.Lines)
+	}
+	if usd.Currency != "USD" || usd.Amount != 200 || usd.Status != "PENDING" {
+		t.Fatalf("unexpected USD payout: %+v", usd)
+	}
+}
+
+func TestPayoutService_CalculatePayouts_MonthNotOver(t *testing.T) {
+	svc := NewPayoutService(&mockPartnerRepo{}, nil, nil).(*payoutService)
+	svc.now = func() time.Time { return time.Date(2026, 9, 30, 23, 0, 0, 0, time.UTC) }
+
+	if _, err := svc.CalculatePayouts(context.Background(), 2026, time.September); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestPayoutService_CreatePartner_UnknownPlot(t *testing.T) {
+	repo := &mockPartnerRepo{}
+	tree := &mockTreeClient{plots: []*pb.Plot{{Id: "plot-a"}}}
+	svc := NewPayoutService(repo, nil, tree)
+
+	_, err := svc.CreatePartner(context.Background(), &models.Partner{
+		Name:     "Sari",
+		ShareBps: 2000,
+		Plots:    []models.PartnerPlot{{PlotID: "plot-a"}, {PlotID: "plot-x"}},
+	})
+	if !errors.Is(err, models.ErrInvalidInput) || repo.createdPartner != nil {
+		t.Fatalf("expected the unknown plot rejected, got %v", err)
+	}
+}
+
+func TestPayoutService_UpdatePayoutStatus(t *testing.T) {
+	t.Run("paid needs a reference", func(t *testing.T) {
+		repo := &mockPartnerRepo{payoutByID: &models.Payout{ID: uuid.New(), Status: "PENDING"}}
+		svc := NewPayoutService(repo, nil, nil)
+
+		if _, err := svc.UpdatePayoutStatus(context.Background(), repo.payoutByID.ID, "paid", " ", ""); !errors.Is(err, models.ErrInvalidInput) {
+			t.Fatalf("expected ErrInvalidInput, got %v", err)
+		}
+		payout, err := svc.UpdatePayoutStatus(context.Background(), repo.payoutByID.ID, "paid", "TRF-0901", "")
+		if err != nil {
+			t.Fatalf("UpdatePayoutStatus() error = %v", err)
+		}
+		if repo.updatedStatus != "PAID" || payout.PaidAt == nil || payout.Reference != "TRF-0901" {
+			t.Fatalf("expected the payout marked paid, got %+v", payout)
+		}
+	})
+
+	t.Run("already settled", func(t *testing.T) {
+		repo := &mockPartnerRepo{payoutByID: &models.Payout{ID: uuid.New(), Status: "CANCELLED"}}
+		svc := NewPayoutService(repo, nil, nil)
+
+		if _, err := svc.UpdatePayoutStatus(context.Background(), repo.payoutByID.ID, "PAID", "TRF-0901", ""); !errors.Is(err, models.ErrInvalidInput) {
+			t.Fatalf("expected ErrInvalidInput, got %v", err)
+		}
+		if repo.updatedStatus != "" {
+			t.Fatalf("a cancelled payout must not change")
+		}
+	})
+}
+
+func TestRenderPayoutStatement_CSV(t *testing.T) {
+	payout := &models.Payout{
+		ID:          uuid.New(),
+		PeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		Currency:    "IDR",
+		Lines:       []models.PayoutLine{{PlotID: "plot-a", PlotName: "Bogor", TransactionCount: 2, RevenueAmount: 100000, ShareBps: 2000, Amount: 20000}},
+	}
+
+	doc, err := renderPayoutStatement(payout, pb.DocumentFormat_CSV)
+	if err != nil {
+		t.Fatalf("renderPayoutStatement() error = %v", err)
+	}
+	if !strings.HasPrefix(doc.FileName, "payout-2026-09-idr-") || !strings.Contains(string(doc.Content), "plot-a,Bogor,2,100000,2000,20000,IDR") {
+		t.Fatalf("unexpected statement %s: %q", doc.FileName, doc.Content)
+	}
+}
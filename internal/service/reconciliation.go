@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+)
+
+// reconciliationWindow is how long expired invoices keep being re-checked, so
+// a payment whose webhook was lost is still picked up after local expiry.
+const reconciliationWindow = 7 * 24 * time.Hour
+
+const (
+	discrepancyMissedPayment    = "MISSED_PAYMENT"
+	discrepancyLatePayment      = "LATE_PAYMENT"
+	discrepancyMissedExpiry     = "MISSED_EXPIRY"
+	discrepancyStatusDrift      = "STATUS_DRIFT"
+	discrepancyAmountMismatch   = "AMOUNT_MISMATCH"
+	discrepancyCurrencyMismatch = "CURRENCY_MISMATCH"
+	discrepancyLookupFailed     = "LOOKUP_FAILED"
+
+	reconcileActionSettled  = "SETTLED"
+	reconcileActionCredited = "CREDITED_TO_WALLET"
+	reconcileActionExpired  = "EXPIRED"
+	reconcileActionClosed   = "INVOICE_EXPIRED"
+	reconcileActionNone     = "NONE"
+)
+
+// ReconcilePayments compares every pending or recently expired invoice with
+// the payment provider, repairs status drift and stores the discrepancies as
+// a report for admins.
+func (s *financeService) ReconcilePayments(ctx context.Context) (*models.ReconciliationReport, error) {
+	report := &models.ReconciliationReport{StartedAt: time.Now()}
+
+	txs, err := s.repo.GetReconciliationCandidates(ctx, report.StartedAt.Add(-reconciliationWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range txs {
+		report.Checked++
+		d := s.reconcileTransaction(ctx, &txs[i])
+		if d == nil {
+			continue
+		}
+		if d.Action != reconcileActionNone {
+			report.Fixed++
+		}
+		report.Discrepancies = append(report.Discrepancies, *d)
+	}
+
+	report.FinishedAt = time.Now()
+	if err := s.repo.CreateReconciliationReport(ctx, report); err != nil {
+		return nil, err
+	}
+	log.Printf("Payment reconciliation checked %d invoices, %d discrepancies, %d fixed", report.Checked, len(report.Discrepancies), report.Fixed)
+	return report, nil
+}
+
+func (s *financeService) ListReconciliationReports(ctx context.Context, limit int) ([]models.ReconciliationReport, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return s.repo.ListReconciliationReports(ctx, limit)
+}
+
+// reconcileTransaction returns nil when the provider agrees with the local
+// status. Paid invoices whose amount or currency differ are only reported.
+func (s *financeService) reconcileTransaction(ctx context.Context, tx *models.Transaction) *models.ReconciliationDiscrepancy {
+	d := &models.ReconciliationDiscrepancy{
+		TransactionID: tx.ID,
+		UserID:        tx.UserID,
+		Type:          tx.Type,
+		Amount:        tx.Amount,
+		Currency:      tx.Currency,
+		LocalStatus:   tx.Payment.Status,
+		Action:        reconcileActionNone,
+	}
+
+	invoice, err := s.fetchInvoice(ctx, tx.ID.String())
+	if err != nil {
+		d.Kind, d.Detail = discrepancyLookupFailed, err.Error()
+		return d
+	}
+	d.ProviderStatus = invoice.Status
+
+	var action string
+	switch invoice.Status {
+	case "PAID", "SETTLED":
+		if invoice.Currency != "" && invoice.Currency != tx.Currency {
+			d.Kind, d.Detail = discrepancyCurrencyMismatch, fmt.Sprintf("provider charged in %s", invoice.Currency)
+			return d
+		}
+		if invoice.Amount != money.ToMajor(tx.Payment.Amount, tx.Currency) {
+			d.Kind, d.Detail = discrepancyAmountMismatch, fmt.Sprintf("provider charged %v %s", invoice.Amount, tx.Currency)
+			return d
+		}
+
+		tx.Payment.FeeAmount = money.ToMinor(invoice.FeesPaidAmount, tx.Currency)
+		tx.Payment.PaymentMethod = paidWith(invoice.PaymentMethod, invoice.PaymentChannel, tx.Payment.PaymentMethod)
+		if tx.Payment.Status == "PENDING" {
+			d.Kind, action = discrepancyMissedPayment, reconcileActionSettled
+			err = s.markPaid(ctx, tx)
+		} else if tx.Type == "ADOPT" {
+			d.Kind, action = discrepancyLatePayment, reconcileActionCredited
+			err = s.creditLatePayment(ctx, tx)
+		} else {
+			d.Kind, action = discrepancyLatePayment, reconcileActionSettled
+			err = s.markPaid(ctx, tx)
+		}
+
+	case "EXPIRED":
+		if tx.Payment.Status == "EXPIRED" {
+			return nil
+		}
+		d.Kind, action = discrepancyMissedExpiry, reconcileActionExpired
+		err = s.expireTransaction(ctx, tx, noticePaymentExpired)
+
+	default:
+		if tx.Payment.Status == "PENDING" {
+			return nil
+		}
+		d.Kind, d.Detail = discrepancyStatusDrift, "invoice is still payable at the provider"
+		if tx.Payment.Status != "EXPIRED" {
+			return d
+		}
+		// The expiry job could not close the invoice, so do it now
+		if tx.Payment.ExternalID == "" {
+			tx.Payment.ExternalID = invoice.ID
+		}
+		action = reconcileActionClosed
+		err = s.expireInvoice(ctx, tx)
+	}
+
+	if err != nil {
+		d.Detail = fmt.Sprintf("failed to apply %s: %v", action, err)
+		return d
+	}
+	d.Action = action
+	return d
+}
+
+// creditLatePayment handles an adoption invoice paid after it expired. The
+// reserved plot space was already released, so instead of completing the
+// adoption the money is credited to the wallet as a deposit and the original
+// payment is marked CREDITED. Only the invoiced part is credited, as any
+// balance held towards the adoption was given back when it expired. The
+// deposit carries the provider fee, so the journal books it like any paid
+// invoice. A payment that was credited in the meantime is left as it is.
+func (s *financeService) creditLatePayment(ctx context.Context, tx *models.Transaction) error {
+	paidAt := time.Now()
+	deposit := &models.Transaction{
+		UserID:      tx.UserID,
+		Amount:      tx.Payment.Amount,
+		Currency:    tx.Currency,
+		Type:        "DEPOSIT",
+		ReferenceID: tx.ID.String(),
+		Payment: models.Payment{
+			Amount:        tx.Payment.Amount,
+			Status:        "SUCCESS",
+			PaymentMethod: tx.Payment.PaymentMethod,
+			FeeAmount:     tx.Payment.FeeAmount,
+			PaidAt:        &paidAt,
+		},
+	}
+	var credited bool
+	err := s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		// A webhook and a reconciliation run crediting the same payment
+		// must not both credit the wallet
+		var err error
+		credited, err = repo.TransitionPaymentStatus(ctx, tx.ID, "CREDITED", "EXPIRED")
+		if err != nil || !credited {
+			return err
+		}
+		if err := repo.UpdatePaymentSettlement(ctx, tx.ID, tx.Payment.PaymentMethod, tx.Payment.FeeAmount, paidAt); err != nil {
+			return err
+		}
+		if err := repo.CreateTransaction(ctx, deposit); err != nil {
+			return err
+		}
+		return repo.UpdateWalletBalance(ctx, tx.UserID, tx.Payment.Amount, tx.Currency)
+	})
+	if err != nil || !credited {
+		return err
+	}
+	tx.Payment.Status = "CREDITED"
+
+	s.notify(ctx, noticePaymentRefunded, tx, walletNoticeData{Credited: money.Format(deposit.Amount, deposit.Currency)}, deposit)
+	return nil
+}
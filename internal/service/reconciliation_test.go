@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func mockXenditLookup(t *testing.T, tx *models.Transaction, statusCode int, body string) func() {
+	t.Helper()
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method != http.MethodGet || r.URL.Query().Get("external_id") != tx.ID.String() {
+			t.Fatalf("unexpected provider call: %s %s", r.Method, r.URL.String())
+		}
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     make(http.Header),
+		}, nil
+	})
+	return func() { http.DefaultTransport = origTransport }
+}
+
+func reconciliationTx(txType, status string) models.Transaction {
+	id := uuid.New()
+	return models.Transaction{
+		ID:       id,
+		UserID:   uuid.New(),
+		Amount:   50000,
+		Currency: "IDR",
+		Type:     txType,
+		Payment: models.Payment{
+			TransactionID: id,
+			Amount:        50000,
+			Status:        status,
+			PaymentURL:    "https://pay.xendit.co/abc",
+		},
+	}
+}
+
+func TestFinanceService_ReconcilePayments_SettlesMissedPayment(t *testing.T) {
+	tx := reconciliationTx("DEPOSIT", "PENDING")
+	repo := &mockFinanceRepo{candidates: []models.Transaction{tx}}
+	svc := NewFinanceService(repo, "key", nil, nil)
+	defer mockXenditLookup(t, &tx, 200, `[{"status":"PAID","amount":50000,"currency":"IDR"}]`)()
+
+	report, err := svc.ReconcilePayments(context.Background())
+	if err != nil {
+		t.Fatalf("ReconcilePayments() error = %v", err)
+	}
+
+	if repo.updatedStatusID != tx.ID || repo.updatedStatusValue != "SUCCESS" {
+		t.Fatalf("expected transaction marked SUCCESS, got %s", repo.updatedStatusValue)
+	}
+	if repo.balanceUserID != tx.UserID || repo.balanceDelta != 50000 || repo.balanceCurrency != "IDR" {
+		t.Fatalf("expected wallet credited 50000 IDR, got %d %s", repo.balanceDelta, repo.balanceCurrency)
+	}
+	if repo.savedReport != report {
+		t.Fatalf("report should be stored")
+	}
+	if time.Since(repo.candidatesSince) < reconciliationWindow {
+		t.Fatalf("expired invoices should be re-checked for the whole window")
+	}
+	if report.Checked != 1 || report.Fixed != 1 || len(report.Discrepancies) != 1 {
+		t.Fatalf("unexpected report counts: %+v", report)
+	}
+	d := report.Discrepancies[0]
+	if d.Kind != discrepancyMissedPayment || d.Action != reconcileActionSettled || d.LocalStatus != "PENDING" || d.ProviderStatus != "PAID" {
+		t.Fatalf("unexpected discrepancy: %+v", d)
+	}
+}
+
+func TestFinanceService_ReconcilePayments_CreditsLateAdoptionPayment(t *testing.T) {
+	tx := reconciliationTx("ADOPT", "EXPIRED")
+	repo := &mockFinanceRepo{candidates: []models.Transaction{tx}}
+	svc := NewFinanceService(repo, "key", nil, nil)
+	defer mockXenditLookup(t, &tx, 200, `[{"status":"SETTLED","amount":50000,"currency":"IDR"}]`)()
+
+	report, err := svc.ReconcilePayments(context.Background())
+	if err != nil {
+		t.Fatalf("ReconcilePayments() error = %v", err)
+	}
+
+	if repo.updatedStatusValue != "CREDITED" {
+		t.Fatalf("expected adoption payment marked CREDITED, got %s", repo.updatedStatusValue)
+	}
+	deposit := repo.createTx
+	if deposit == nil || deposit.Type != "DEPOSIT" || deposit.ReferenceID != tx.ID.String() || deposit.Payment.Status != "SUCCESS" {
+		t.Fatalf("expected a settled deposit referencing the adoption, got %+v", deposit)
+	}
+	if repo.balanceDelta != 50000 {
+		t.Fatalf("expected wallet credited, got %d", repo.balanceDelta)
+	}
+	d := report.Discrepancies[0]
+	if d.Kind != discrepancyLatePayment || d.Action != reconcileActionCredited {
+		t.Fatalf("unexpected discrepancy: %+v", d)
+	}
+}
+
+func TestFinanceService_ReconcilePayments_ReportsOnly(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		statusCode int
+		body       string
+		kind       string
+	}{
+		{"amount mismatch", "PENDING", 200, `[{"status":"PAID","amount":40000,"currency":"IDR"}]`, discrepancyAmountMismatch},
+		{"currency mismatch", "PENDING", 200, `[{"status":"PAID","amount":50000,"currency":"USD"}]`, discrepancyCurrencyMismatch},
+		{"payable after credit", "CREDITED", 200, `[{"status":"PENDING","amount":50000,"currency":"IDR"}]`, discrepancyStatusDrift},
+		{"unknown invoice", "PENDING", 200, `[]`, discrepancyLookupFailed},
+		{"provider error", "PENDING", 500, `{}`, discrepancyLookupFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := reconciliationTx("DEPOSIT", tt.status)
+			repo := &mockFinanceRepo{candidates: []models.Transaction{tx}}
+			svc := NewFinanceService(repo, "key", nil, nil)
+			defer mockXenditLookup(t, &tx, tt.statusCode, tt.body)()
+
+			report, err := svc.ReconcilePayments(context.Background())
+			if err != nil {
+				t.Fatalf("ReconcilePayments() error = %v", err)
+			}
+			if repo.updatedStatusValue != "" || repo.balanceDelta != 0 {
+				t.Fatalf("discrepancy should only be reported")
+			}
+			if report.Fixed != 0 || len(report.Discrepancies) != 1 {
+				t.Fatalf("unexpected report counts: %+v", report)
+			}
+			if d := report.Discrepancies[0]; d.Kind != tt.kind || d.Action != reconcileActionNone {
+				t.Fatalf("unexpected discrepancy: %+v", d)
+			}
+		})
+	}
+}
+
+func TestFinanceService_ReconcilePayments_ClosesInvoiceStillPayable(t *testing.T) {
+	tx := reconciliationTx("ADOPT", "EXPIRED")
+	repo := &mockFinanceRepo{candidates: []models.Transaction{tx}}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	var expired string
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		body := `[{"id":"inv-1","status":"PENDING","amount":50000,"currency":"IDR"}]`
+		if r.Method == http.MethodPost {
+			expired, body = r.URL.String(), `{}`
+		}
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	report, err := svc.ReconcilePayments(context.Background())
+	if err != nil {
+		t.Fatalf("ReconcilePayments() error = %v", err)
+	}
+	if expired != "https://api.xendit.co/invoices/inv-1/expire!" {
+		t.Fatalf("invoice should be expired at the provider, got %q", expired)
+	}
+	if report.Fixed != 1 || len(report.Discrepancies) != 1 {
+		t.Fatalf("unexpected report counts: %+v", report)
+	}
+	if d := report.Discrepancies[0]; d.Kind != discrepancyStatusDrift || d.Action != reconcileActionClosed {
+		t.Fatalf("unexpected discrepancy: %+v", d)
+	}
+	if repo.updatedStatusValue != "" {
+		t.Fatalf("local status should be left alone, got %q", repo.updatedStatusValue)
+	}
+}
+
+func TestFinanceService_ReconcilePayments_InSync(t *testing.T) {
+	tx := reconciliationTx("DEPOSIT", "PENDING")
+	repo := &mockFinanceRepo{candidates: []models.Transaction{tx}}
+	svc := NewFinanceService(repo, "key", nil, nil)
+	defer mockXenditLookup(t, &tx, 200, `[{"status":"PENDING","amount":50000,"currency":"IDR"}]`)()
+
+	report, err := svc.ReconcilePayments(context.Background())
+	if err != nil {
+		t.Fatalf("ReconcilePayments() error = %v", err)
+	}
+	if report.Checked != 1 || len(report.Discrepancies) != 0 {
+		t.Fatalf("expected no discrepancies, got %+v", report)
+	}
+}
+
+func TestFinanceService_ListReconciliationReports_DefaultLimit(t *testing.T) {
+	repo := &mockFinanceRepo{}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	if _, err := svc.ListReconciliationReports(context.Background(), 0); err != nil {
+		t.Fatalf("ListReconciliationReports() error = %v", err)
+	}
+	if repo.reportsLimit != 20 {
+		t.Fatalf("expected default limit 20, got %d", repo.reportsLimit)
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// ParseReminderOffsets parses a comma separated list of durations such as
+// "12h,1h" into distinct positive offsets, longest first.
+func ParseReminderOffsets(list string) ([]time.Duration, error) {
+	var offsets []time.Duration
+	seen := make(map[time.Duration]bool)
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		d, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder offset %q: %w", part, err)
+		}
+		if d < time.Minute {
+			return nil, fmt.Errorf("reminder offset %q must be at least a minute", part)
+		}
+		if !seen[d] {
+			seen[d] = true
+			offsets = append(offsets, d)
+		}
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] > offsets[j] })
+	return offsets, nil
+}
+
+// SendInvoiceReminders emails the payment link of pending adoption invoices
+// that expire within one of the offsets, longest first. An invoice only gets
+// the reminder of the shortest offset it has reached, so one that was missed
+// is not sent late alongside the next, and none whose offset reaches back to
+// when the invoice was issued, as the invoice email covers it. Each reminder
+// is recorded before it is sent, so it goes out at most once even if sending
+// fails.
+func (s *financeService) SendInvoiceReminders(ctx context.Context, offsets []time.Duration) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+	now := time.Now()
+	txs, err := s.repo.GetPendingInvoicesExpiringBetween(ctx, "ADOPT", now, now.Add(offsets[0]))
+	if err != nil || len(txs) == 0 {
+		return err
+	}
+
+	ids := make([]uuid.UUID, len(txs))
+	for i := range txs {
+		ids[i] = txs[i].ID
+	}
+	reminders, err := s.repo.ListPaymentReminders(ctx, ids)
+	if err != nil {
+		return err
+	}
+	sent := make(map[uuid.UUID]map[int]bool)
+	for _, r := range reminders {
+		if sent[r.TransactionID] == nil {
+			sent[r.TransactionID] = make(map[int]bool)
+		}
+		sent[r.TransactionID][r.OffsetMinutes] = true
+	}
+
+	for i := range txs {
+		tx := &txs[i]
+		left := tx.Payment.ExpiresAt.Sub(now)
+		offset, ok := reminderDue(offsets, left)
+		if !ok || tx.Payment.ExpiresAt.Sub(tx.CreatedAt) <= offset {
+			continue
+		}
+		minutes := int(offset / time.Minute)
+		if sent[tx.ID][minutes] {
+			continue
+		}
+
+		err := s.repo.CreatePaymentReminder(ctx, &models.PaymentReminder{
+			TransactionID: tx.ID,
+			OffsetMinutes: minutes,
+			SentAt:        now,
+		})
+		if errors.Is(err, models.ErrAlreadyExists) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Failed to record reminder for transaction %s: %v", tx.ID, err)
+			continue
+		}
+		s.notify(ctx, noticeInvoiceReminder, tx, walletNoticeData{
+			PaymentURL: tx.Payment.PaymentURL,
+			ExpiresAt:  tx.Payment.ExpiresAt.Format("02 Jan 2006 15:04 MST"),
+			TimeLeft:   formatTimeLeft(left),
+		}, nil)
+	}
+	return nil
+}
+
+// reminderDue returns the shortest of offsets, sorted longest first, that
+// left has reached.
+func reminderDue(offsets []time.Duration, left time.Duration) (time.Duration, bool) {
+	var due time.Duration
+	for _, offset := range offsets {
+		if left <= offset {
+			due = offset
+		}
+	}
+	return due, due > 0
+}
+
+// formatTimeLeft renders a duration the way the reminder email states it,
+// to the nearest hour or, under an hour, minute.
+func formatTimeLeft(d time.Duration) string {
+	if d >= time.Hour {
+		return fmt.Sprintf("%d jam", int(d.Round(time.Hour)/time.Hour))
+	}
+	if d < time.Minute {
+		d = time.Minute
+	}
+	return fmt.Sprintf("%d menit", int(d.Round(time.Minute)/time.Minute))
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestParseReminderOffsets(t *testing.T) {
+	offsets, err := ParseReminderOffsets(" 1h, 12h,,1h,30m")
+	if err != nil {
+		t.Fatalf("ParseReminderOffsets() error = %v", err)
+	}
+	if len(offsets) != 3 || offsets[0] != 12*time.Hour || offsets[1] != time.Hour || offsets[2] != 30*time.Minute {
+		t.Fatalf("expected distinct offsets longest first, got %v", offsets)
+	}
+
+	for _, list := range []string{"12", "-1h", "30s"} {
+		if _, err := ParseReminderOffsets(list); err == nil {
+			t.Fatalf("expected %q rejected", list)
+		}
+	}
+}
+
+func TestFinanceService_SendInvoiceReminders(t *testing.T) {
+	now := time.Now()
+	invoice := func(created, expires time.Duration) models.Transaction {
+		return models.Transaction{
+			ID:        uuid.New(),
+			UserID:    uuid.New(),
+			Type:      "ADOPT",
+			Amount:    150000,
+			Currency:  "IDR",
+			CreatedAt: now.Add(-created),
+			Payment: models.Payment{
+				Status:     "PENDING",
+				PaymentURL: "https://checkout.xendit.co/inv-1",
+				ExpiresAt:  now.Add(expires),
+			},
+		}
+	}
+	due := invoice(12*time.Hour, 11*time.Hour+50*time.Minute)
+	alreadySent := invoice(23*time.Hour, 50*time.Minute)
+	shortLived := invoice(30*time.Minute, 30*time.Minute)
+	missed := invoice(23*time.Hour+30*time.Minute, 30*time.Minute)
+
+	repo := &mockFinanceRepo{
+		getEmail:         "budi@example.com",
+		expiringInvoices: []models.Transaction{due, alreadySent, shortLived, missed},
+		reminders:        []models.PaymentReminder{{TransactionID: alreadySent.ID, OffsetMinutes: 60}},
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "x", sender, nil)
+
+	if err := svc.SendInvoiceReminders(context.Background(), []time.Duration{12 * time.Hour, time.Hour}); err != nil {
+		t.Fatalf("SendInvoiceReminders() error = %v", err)
+	}
+
+	if got := repo.expiringTo.Sub(repo.expiringFrom); got != 12*time.Hour {
+		t.Fatalf("invoices should be looked up up to the longest offset ahead, got %v", got)
+	}
+	// the short-lived invoice's email already covered the hour; the missed
+	// 12h reminder gives way to the 1h one
+	if len(repo.createdReminders) != 2 {
+		t.Fatalf("expected two reminders, got %+v", repo.createdReminders)
+	}
+	if r := repo.createdReminders[0]; r.TransactionID != due.ID || r.OffsetMinutes != 720 {
+		t.Fatalf("unexpected reminder %+v", r)
+	}
+	if r := repo.createdReminders[1]; r.TransactionID != missed.ID || r.OffsetMinutes != 60 {
+		t.Fatalf("unexpected reminder %+v", r)
+	}
+	if !strings.Contains(sender.body, "akan kedaluwarsa dalam 30 menit") || !strings.Contains(sender.body, "https://checkout.xendit.co/inv-1") {
+		t.Fatalf("reminder should carry the time left and payment link, got %q", sender.body)
+	}
+}
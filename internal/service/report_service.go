@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/pb"
+)
+
+const defaultReportRange = 30 * 24 * time.Hour
+
+// ReportService serves the admin financial reports. Revenue counts settled
+// ADOPT and CARE payments; top ups are reported as wallet liabilities until
+// they are spent.
+type ReportService interface {
+	RevenueReport(ctx context.Context, groupBy pb.RevenueGrouping, from, to time.Time) ([]repository.RevenueRow, time.Time, time.Time, error)
+	PendingInvoices(ctx context.Context) ([]models.Transaction, []repository.CurrencyTotal, error)
+	WalletLiabilities(ctx context.Context) ([]repository.CurrencyTotal, error)
+	PaymentMethods(ctx context.Context, from, to time.Time) ([]repository.PaymentMethodRow, time.Time, time.Time, error)
+	ExportJournal(ctx context.Context, from, to time.Time, format pb.DocumentFormat) (*models.Document, error)
+	MailJournal(ctx context.Context, recipient string) error
+}
+
+type reportService struct {
+	repo        repository.ReportRepository
+	treeClient  pb.TreeServiceClient
+	emailSender EmailSender
+	now         func() time.Time
+}
+
+func NewReportService(repo repository.ReportRepository, treeClient pb.TreeServiceClient, emailSender EmailSender) ReportService {
+	return &reportService{
+		repo:        repo,
+		treeClient:  treeClient,
+		emailSender: emailSender,
+		now:         time.Now,
+	}
+}
+
+// reportRange fills in the default range of a report, the 30 days up to
+// now.
+func (s *reportService) reportRange(from, to time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = s.now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultReportRange)
+	}
+	if !from.Before(to) {
+		return from, to, fmt.Errorf("%w: from must be before to", models.ErrInvalidInput)
+	}
+	return from, to, nil
+}
+
+// RevenueReport totals revenue in [from, to), defaulting to the last 30 days.
+// The range actually used is returned with the rows.
+func (s *reportService) RevenueReport(ctx context.Context, groupBy pb.RevenueGrouping, from, to time.Time) ([]repository.RevenueRow, time.Time, time.Time, error) {
+	from, to, err := s.reportRange(from, to)
+	if err != nil {
+		return nil, from, to, err
+	}
+
+	var rows []repository.RevenueRow
+	switch groupBy {
+	case pb.RevenueGrouping_DAY:
+		rows, err = s.repo.SumRevenue(ctx, repository.RevenueByDay, from, to)
+	case pb.RevenueGrouping_MONTH:
+		rows, err = s.repo.SumRevenue(ctx, repository.RevenueByMonth, from, to)
+	case pb.RevenueGrouping_TYPE:
+		rows, err = s.repo.SumRevenue(ctx, repository.RevenueByType, from, to)
+	case pb.RevenueGrouping_SPECIES, pb.RevenueGrouping_PLOT:
+		rows, err = s.repo.SumRevenue(ctx, repository.RevenueByReference, from, to)
+		if err == nil {
+			rows, err = s.groupByIntent(ctx, rows, groupBy)
+		}
+	default:
+		return nil, from, to, fmt.Errorf("%w: unknown grouping %v", models.ErrInvalidInput, groupBy)
+	}
+	if err != nil {
+		return nil, from, to, err
+	}
+
+	for i := range rows {
+		if rows[i].Label == "" {
+			rows[i].Label = rows[i].Key
+		}
+	}
+	return rows, from, to, nil
+}
+
+// groupByIntent regroups revenue keyed by ReferenceID under the species or
+// plot of the adoption intent each reference points at. References that do
+// not resolve to an intent are grouped under an empty key.
+func (s *reportService) groupByIntent(ctx context.Context, byRef []repository.RevenueRow, groupBy pb.RevenueGrouping) ([]repository.RevenueRow, error) {
+	refs := make([]string, 0, len(byRef))
+	for _, row := range byRef {
+		refs = append(refs, row.Key)
+	}
+	intents, err := s.treeClient.ListAdoptionIntents(ctx, &pb.IdsRequest{Ids: refs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up adoption intents: %w", err)
+	}
+
+	keyByRef := make(map[string]string, len(intents.Intents))
+	for _, intent := range intents.Intents {
+		if groupBy == pb.RevenueGrouping_SPECIES {
+			keyByRef[intent.Id] = intent.SpeciesId
+		} else {
+			keyByRef[intent.Id] = intent.PlotId
+		}
+	}
+	labels, err := s.intentLabels(ctx, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	type groupKey struct{ key, currency string }
+	index := make(map[groupKey]int)
+	var rows []repository.RevenueRow
+	for _, row := range byRef {
+		k := groupKey{keyByRef[row.Key], row.Currency}
+		i, ok := index[k]
+		if !ok {
+			i = len(rows)
+			index[k] = i
+			label := "Unknown"
+			if k.key != "" {
+				label = labels[k.key]
+			}
+			rows = append(rows, repository.RevenueRow{Key: k.key, Label: label, Currency: k.currency})
+		}
+		rows[i].Count += row.Count
+		rows[i].Amount += row.Amount
+		rows[i].DiscountAmount += row.DiscountAmount
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Amount != rows[j].Amount {
+			return rows[i].Amount > rows[j].Amount
+		}
+		if rows[i].Key != rows[j].Key {
+			return rows[i].Key < rows[j].Key
+		}
+		return rows[i].Currency < rows[j].Currency
+	})
+	return rows, nil
+}
+
+func (s *reportService) intentLabels(ctx context.Context, groupBy pb.RevenueGrouping) (map[string]string, error) {
+	labels := make(map[string]string)
+	if groupBy == pb.RevenueGrouping_SPECIES {
+		species, err := listAllSpecies(ctx, s.treeClient)
+		if err != nil {
+			return nil, err
+		}
+		for _, sp := range species {
+			labels[sp.Id] = sp.CommonName
+		}
+		return labels, nil
+	}
+
+	plots, err := listAllPlots(ctx, s.treeClient)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range plots {
+		labels[p.Id] = p.LocationName
+	}
+	return labels, nil
+}
+
+// listAllSpecies pages through every species of the tree service.
+func listAllSpecies(ctx context.Context, client pb.TreeServiceClient) ([]*pb.Species, error) {
+	var species []*pb.Species
+	req := &pb.ListSpeciesRequest{PageSize: maxPageSize}
+	for {
+		res, err := client.ListSpecies(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list species: %w", err)
+		}
+		species = append(species, res.Species...)
+		if res.NextPageToken == "" {
+			return species, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// listAllPlots pages through every plot of the tree service.
+func listAllPlots(ctx context.Context, client pb.TreeServiceClient) ([]*pb.Plot, error) {
+	var plots []*pb.Plot
+	req := &pb.ListPlotsRequest{PageSize: maxPageSize}
+	for {
+		res, err := client.ListPlots(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list plots: %w", err)
+		}
+		plots = append(plots, res.Plots...)
+		if res.NextPageToken == "" {
+			return plots, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// PendingInvoices lists the open invoices with their outstanding total per
+// currency.
+func (s *reportService) PendingInvoices(ctx context.Context) ([]models.Transaction, []repository.CurrencyTotal, error) {
+	txs, err := s.repo.ListPendingInvoices(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var totals []repository.CurrencyTotal
+	index := make(map[string]int)
+	for _, tx := range txs {
+		i, ok := index[tx.Currency]
+		if !ok {
+			i = len(totals)
+			index[tx.Currency] = i
+			totals = append(totals, repository.CurrencyTotal{Currency: tx.Currency})
+		}
+		totals[i].Count++
+		totals[i].Amount += tx.Payment.Amount
+	}
+	sort.Slice(totals, func(i, j int) bool { return totals[i].Currency < totals[j].Currency })
+	return txs, totals, nil
+}
+
+// WalletLiabilities totals the wallet balances owed to sponsors.
+func (s *reportService) WalletLiabilities(ctx context.Context) ([]repository.CurrencyTotal, error) {
+	return s.repo.SumWalletBalances(ctx)
+}
+
+// PaymentMethods breaks down the invoices paid in [from, to) by payment
+// method and channel, defaulting to the last 30 days.
+func (s *reportService) PaymentMethods(ctx context.Context, from, to time.Time) ([]repository.PaymentMethodRow, time.Time, time.Time, error) {
+	from, to, err := s.reportRange(from, to)
+	if err != nil {
+		return nil, from, to, err
+	}
+	rows, err := s.repo.SumPaymentMethods(ctx, from, to)
+	return rows, from, to, err
+}
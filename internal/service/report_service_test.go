@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/pb"
+
+	"google.golang.org/grpc"
+)
+
+type mockReportRepo struct {
+	revenue     []repository.RevenueRow
+	groupBy     string
+	from, to    time.Time
+	pending     []models.Transaction
+	liabilities []repository.CurrencyTotal
+	journal     []models.Transaction
+	methods     []repository.PaymentMethodRow
+}
+
+func (m *mockReportRepo) SumRevenue(ctx context.Context, groupBy string, from, to time.Time) ([]repository.RevenueRow, error) {
+	m.groupBy, m.from, m.to = groupBy, from, to
+	return m.revenue, nil
+}
+
+func (m *mockReportRepo) ListPendingInvoices(ctx context.Context) ([]models.Transaction, error) {
+	return m.pending, nil
+}
+
+func (m *mockReportRepo) ListJournalTransactions(ctx context.Context, from, to time.Time) ([]models.Transaction, error) {
+	m.from, m.to = from, to
+	return m.journal, nil
+}
+
+func (m *mockReportRepo) SumPaymentMethods(ctx context.Context, from, to time.Time) ([]repository.PaymentMethodRow, error) {
+	m.from, m.to = from, to
+	return m.methods, nil
+}
+
+func (m *mockReportRepo) SumWalletBalances(ctx context.Context) ([]repository.CurrencyTotal, error) {
+	return m.liabilities, nil
+}
+
+// mockTreeClient only implements the lookups reports make; any other call
+// panics on the nil embedded client.
+type mockTreeClient struct {
+	pb.TreeServiceClient
+	intents   []*pb.AdoptionIntent
+	species   []*pb.Species
+	plots     []*pb.Plot
+	lookedUp  []string
+	lookupErr error
+}
+
+func (m *mockTreeClient) ListAdoptionIntents(ctx context.Context, in *pb.IdsRequest, opts ...grpc.CallOption) (*pb.AdoptionIntentList, error) {
+	m.lookedUp = in.Ids
+	if m.lookupErr != nil {
+		return nil, m.lookupErr
+	}
+	return &pb.AdoptionIntentList{Intents: m.intents}, nil
+}
+
+func (m *mockTreeClient) ListSpecies(ctx context.Context, in *pb.ListSpeciesRequest, opts ...grpc.CallOption) (*pb.SpeciesList, error) {
+	return &pb.SpeciesList{Species: m.species}, nil
+}
+
+func (m *mockTreeClient) ListPlots(ctx context.Context, in *pb.ListPlotsRequest, opts ...grpc.CallOption) (*pb.PlotList, error) {
+	return &pb.PlotList{Plots: m.plots}, nil
+}
+
+func TestReportService_RevenueReport_DefaultRange(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	repo := &mockReportRepo{revenue: []repository.RevenueRow{{Key: "2026-03", Currency: "IDR", Count: 2, Amount: 100000}}}
+	svc := NewReportService(repo, nil, nil).(*reportService)
+	svc.now = func() time.Time { return now }
+
+	rows, from, to, err := svc.RevenueReport(context.Background(), pb.RevenueGrouping_MONTH, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("RevenueReport() error = %v", err)
+	}
+	if repo.groupBy != repository.RevenueByMonth || !to.Equal(now) || !from.Equal(now.Add(-30*24*time.Hour)) || !repo.from.Equal(from) {
+		t.Fatalf("unexpected query: %s %v %v", repo.groupBy, from, to)
+	}
+	if rows[0].Label != "2026-03" {
+		t.Fatalf("label should default to the key, got %q", rows[0].Label)
+	}
+
+	if _, _, _, err := svc.RevenueReport(context.Background(), pb.RevenueGrouping_DAY, now, now); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected empty range rejected, got %v", err)
+	}
+}
+
+func TestReportService_RevenueReport_BySpecies(t *testing.T) {
+	repo := &mockReportRepo{revenue: []repository.RevenueRow{
+		{Key: "intent-1", Currency: "IDR", Count: 1, Amount: 50000},
+		{Key: "intent-2", Currency: "IDR", Count: 1, Amount: 60000, DiscountAmount: 5000},
+		{Key: "intent-3", Currency: "IDR", Count: 1, Amount: 70000},
+		{Key: "intent-4", Currency: "USD", Count: 1, Amount: 400},
+		{Key: "gone", Currency: "IDR", Count: 1, Amount: 10000},
+	}}
+	tree := &mockTreeClient{
+		intents: []*pb.AdoptionIntent{
+			{Id: "intent-1", SpeciesId: "jati", PlotId: "plot-a"},
+			{Id: "intent-2", SpeciesId: "jati", PlotId: "plot-b"},
+			{Id: "intent-3", SpeciesId: "mahoni", PlotId: "plot-a"},
+			{Id: "intent-4", SpeciesId: "jati", PlotId: "plot-a"},
+		},
+		species: []*pb.Species{{Id: "jati", CommonName: "Jati"}, {Id: "mahoni", CommonName: "Mahoni"}},
+	}
+	svc := NewReportService(repo, tree, nil)
+
+	rows, _, _, err := svc.RevenueReport(context.Background(), pb.RevenueGrouping_SPECIES, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("RevenueReport() error = %v", err)
+	}
+	if repo.groupBy != repository.RevenueByReference || len(tree.lookedUp) != 5 {
+		t.Fatalf("expected revenue by reference joined to intents, got %s %v", repo.groupBy, tree.lookedUp)
+	}
+
+	want := []repository.RevenueRow{
+		{Key: "jati", Label: "Jati", Currency: "IDR", Count: 2, Amount: 110000, DiscountAmount: 5000},
+		{Key: "mahoni", Label: "Mahoni", Currency: "IDR", Count: 1, Amount: 70000},
+		{Key: "", Label: "Unknown", Currency: "IDR", Count: 1, Amount: 10000},
+		{Key: "jati", Label: "Jati", Currency: "USD", Count: 1, Amount: 400},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("expected %d rows, got %+v", len(want), rows)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("row %d = %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+}
+
+func TestReportService_RevenueReport_ByPlotLookupFails(t *testing.T) {
+	repo := &mockReportRepo{revenue: []repository.RevenueRow{{Key: "intent-1", Currency: "IDR", Amount: 1}}}
+	svc := NewReportService(repo, &mockTreeClient{lookupErr: errors.New("unavailable")}, nil)
+
+	if _, _, _, err := svc.RevenueReport(context.Background(), pb.RevenueGrouping_PLOT, time.Time{}, time.Time{}); err == nil {
+		t.Fatalf("expected lookup failure to be returned")
+	}
+}
+
+func TestReportService_PendingInvoices_Totals(t *testing.T) {
+	repo := &mockReportRepo{pending: []models.Transaction{
+		{Currency: "IDR", Payment: models.Payment{Amount: 45000}},
+		{Currency: "USD", Payment: models.Payment{Amount: 300}},
+		{Currency: "IDR", Payment: models.Payment{Amount: 50000}},
+	}}
+	svc := NewReportService(repo, nil, nil)
+
+	txs, totals, err := svc.PendingInvoices(context.Background())
+	if err != nil {
+		t.Fatalf("PendingInvoices() error = %v", err)
+	}
+	if len(txs) != 3 {
+		t.Fatalf("expected all invoices listed, got %d", len(txs))
+	}
+	want := []repository.CurrencyTotal{{Currency: "IDR", Count: 2, Amount: 95000}, {Currency: "USD", Count: 1, Amount: 300}}
+	if len(totals) != 2 || totals[0] != want[0] || totals[1] != want[1] {
+		t.Fatalf("unexpected totals: %+v", totals)
+	}
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/money"
+	"reforest/pkg/pb"
+	"reforest/pkg/pdf"
+
+	"github.com/google/uuid"
+)
+
+var csvHeader = []string{"transaction_id", "date", "type", "reference_id", "amount", "currency", "status"}
+
+func (s *financeService) GetReceipt(ctx context.Context, userID, txID uuid.UUID, format pb.DocumentFormat) (*models.Document, error) {
+	tx, err := s.repo.GetTransactionByID(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	if tx.UserID != userID {
+		return nil, models.ErrNotFound
+	}
+	if tx.Payment.Status != "SUCCESS" {
+		return nil, fmt.Errorf("%w: receipts are only available for settled transactions", models.ErrInvalidInput)
+	}
+
+	email, _, err := s.repo.GetUserEmailAndBalance(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user email: %w", err)
+	}
+
+	return renderReceipt(tx, email, format)
+}
+
+func (s *financeService) GetStatement(ctx context.Context, userID uuid.UUID, req *pb.StatementRequest) (*models.Document, error) {
+	from, to, label, err := statementRange(req.Period, int(req.Year), int(req.Month))
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.repo.GetSettledTransactionsByUserIDBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	email, _, err := s.repo.GetUserEmailAndBalance(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user email: %w", err)
+	}
+
+	return renderStatement(txs, email, label, req.Format)
+}
+
+// emailReceipt sends the PDF receipt of a settled transaction to its owner.
+// Failures are only logged so they never roll back a settlement.
+func (s *financeService) emailReceipt(ctx context.Context, tx *models.Transaction) {
+	if s.emailSender == nil {
+		return
+	}
+
+	email, _, err := s.repo.GetUserEmailAndBalance(ctx, tx.UserID)
+	if err != nil {
+		log.Printf("WARN: failed to fetch user email for receipt %s: %v", tx.ID, err)
+		return
+	}
+
+	doc, err := renderReceipt(tx, email, pb.DocumentFormat_PDF)
+	if err != nil {
+		log.Printf("WARN: failed to render receipt %s: %v", tx.ID, err)
+		return
+	}
+
+	body := fmt.Sprintf("Terima kasih!\nPembayaran %s sebesar %s telah kami terima.\nBukti pembayaran terlampir.", tx.Type, money.Format(tx.Amount, tx.Currency))
+	attachment := Attachment{FileName: doc.FileName, ContentType: doc.ContentType, Content: doc.Content}
+	if err := s.emailSender.SendWithAttachments(email, "Bukti Pembayaran ReForest", body, attachment); err != nil {
+		log.Printf("WARN: failed to send receipt email to %s: %v", email, err)
+	}
+}
+
+func statementRange(period pb.StatementPeriod, year, month int) (time.Time, time.Time, string, error) {
+	if year < 2000 || year > 9999 {
+		return time.Time{}, time.Time{}, "", fmt.Errorf("%w: invalid statement year", models.ErrInvalidInput)
+	}
+
+	switch period {
+	case pb.StatementPeriod_MONTHLY:
+		if month < 1 || month > 12 {
+			return time.Time{}, time.Time{}, "", fmt.Errorf("%w: invalid statement month", models.ErrInvalidInput)
+		}
+		from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(0, 1, 0), from.Format("2006-01"), nil
+	case pb.StatementPeriod_ANNUAL:
+		from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(1, 0, 0), strconv.Itoa(year), nil
+	}
+	return time.Time{}, time.Time{}, "", fmt.Errorf("%w: unknown statement period", models.ErrInvalidInput)
+}
+
+func renderReceipt(tx *models.Transaction, email string, format pb.DocumentFormat) (*models.Document, error) {
+	name := "receipt-" + tx.ID.String()
+
+	switch format {
+	case pb.DocumentFormat_CSV:
+		content, err := writeCSV([]models.Transaction{*tx})
+		if err != nil {
+			return nil, err
+		}
+		return &models.Document{FileName: name + ".csv", ContentType: "text/csv", Content: content}, nil
+	case pb.DocumentFormat_PDF:
+		doc := pdf.New("ReForest Receipt")
+		doc.Heading("ReForest - Payment Receipt")
+		doc.Blank()
+		doc.Text("Receipt No : " + tx.ID.String())
+		doc.Text("Date       : " + tx.CreatedAt.Format("02 Jan 2006 15:04 MST"))
+		doc.Text("Billed To  : " + email)
+		doc.Blank()
+		doc.Text("Type       : " + tx.Type)
+		if tx.ReferenceID != "" {
+			doc.Text("Reference  : " + tx.ReferenceID)
+		}
+		doc.Text("Paid With  : " + paymentChannel(tx))
+		doc.Text("Status     : " + tx.Payment.Status)
+		doc.Blank()
+		if tx.OriginalCurrency != "" {
+			doc.Text("Price      : " + money.Format(tx.OriginalAmount, tx.OriginalCurrency) + " @ " + tx.ExchangeRate)
+		}
+		if tx.VoucherCode != "" {
+			doc.Text("Voucher    : " + tx.VoucherCode + " (-" + money.Format(tx.DiscountAmount, tx.Currency) + ")")
+		}
+		doc.Bold("Total Paid : " + money.Format(tx.Amount, tx.Currency))
+		doc.Blank()
+		doc.Text("Thank you for helping us reforest Indonesia.")
+		return &models.Document{FileName: name + ".pdf", ContentType: "application/pdf", Content: doc.Bytes()}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported document format", models.ErrInvalidInput)
+}
+
+func renderStatement(txs []models.Transaction, email, label string, format pb.DocumentFormat) (*models.Document, error) {
+	name := "statement-" + label
+
+	switch format {
+	case pb.DocumentFormat_CSV:
+		content, err := writeCSV(txs)
+		if err != nil {
+			return nil, err
+		}
+		return &models.Document{FileName: name + ".csv", ContentType: "text/csv", Content: content}, nil
+	case pb.DocumentFormat_PDF:
+		topUps := map[string]int64{}
+		spent := map[string]int64{}
+		received := map[string]int64{}
+		sent := map[string]int64{}
+		adjusted := map[string]int64{}
+		columns := []float64{0, 90, 160, 400}
+
+		doc := pdf.New("ReForest Statement " + label)
+		doc.Heading("ReForest - Wallet Statement " + label)
+		doc.Blank()
+		doc.Text("Account : " + email)
+		doc.Text("Issued  : " + time.Now().UTC().Format("02 Jan 2006"))
+		doc.Blank()
+		doc.Row(columns, "Date", "Type", "Reference", "Amount")
+		for _, tx := range txs {
+			doc.Row(columns, tx.CreatedAt.Format("2006-01-02"), tx.Type, tx.ReferenceID, money.Format(tx.Amount, tx.Currency))
+			switch {
+			case tx.Type == "DEPOSIT":
+				topUps[tx.Currency] += tx.Amount
+			case tx.Type == "ADJUSTMENT" && tx.Direction == "OUT":
+				adjusted[tx.Currency] -= tx.Amount
+			case tx.Type == "ADJUSTMENT":
+				adjusted[tx.Currency] += tx.Amount
+			case tx.Direction == "IN":
+				received[tx.Currency] += tx.Amount
+			case tx.Direction == "OUT":
+				sent[tx.Currency] += tx.Amount
+			default:
+				spent[tx.Currency] += tx.Amount
+			}
+		}
+		if len(txs) == 0 {
+			doc.Text("No settled transactions in this period.")
+		}
+		doc.Blank()
+		for _, currency := range statementCurrencies(txs) {
+			doc.Bold("Total Top Ups : " + money.Format(topUps[currency], currency))
+			doc.Bold("Total Spent   : " + money.Format(spent[currency], currency))
+			if received[currency] != 0 || sent[currency] != 0 {
+				doc.Bold("Transfers In  : " + money.Format(received[currency], currency))
+				doc.Bold("Transfers Out : " + money.Format(sent[currency], currency))
+			}
+			if adjusted[currency] != 0 {
+				doc.Bold("Adjustments   : " + money.Format(adjusted[currency], currency))
+			}
+		}
+		return &models.Document{FileName: name + ".pdf", ContentType: "application/pdf", Content: doc.Bytes()}, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported document format", models.ErrInvalidInput)
+}
+
+func writeCSV(txs []models.Transaction) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		record := []string{
+			tx.ID.String(),
+			tx.CreatedAt.UTC().Format(time.RFC3339),
+			tx.Type,
+			tx.ReferenceID,
+			strconv.FormatInt(tx.Amount, 10),
+			tx.Currency,
+			tx.Payment.Status,
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func paymentChannel(tx *models.Transaction) string {
+	if tx.Payment.PaymentURL == "" {
+		return "ReForest Wallet"
+	}
+	return "Xendit Invoice"
+}
+
+// statementCurrencies lists the currencies present in txs in a stable order,
+// so totals are never summed across currencies.
+func statementCurrencies(txs []models.Transaction) []string {
+	seen := map[string]bool{}
+	var currencies []string
+	for _, tx := range txs {
+		if !seen[tx.Currency] {
+			seen[tx.Currency] = true
+			currencies = append(currencies, tx.Currency)
+		}
+	}
+	sort.Strings(currencies)
+	return currencies
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+func TestFinanceService_GetReceipt_PDF(t *testing.T) {
+	userID := uuid.New()
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		getEmail: "sponsor@example.com",
+		txByID: &models.Transaction{
+			ID:       txID,
+			UserID:   userID,
+			Amount:   150000,
+			Currency: "IDR",
+			Type:     "ADOPT",
+			Payment:  models.Payment{Status: "SUCCESS"},
+		},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	doc, err := svc.GetReceipt(context.Background(), userID, txID, pb.DocumentFormat_PDF)
+	if err != nil {
+		t.Fatalf("GetReceipt() error = %v", err)
+	}
+	if doc.ContentType != "application/pdf" || doc.FileName != "receipt-"+txID.String()+".pdf" {
+		t.Fatalf("unexpected document metadata: %s %s", doc.FileName, doc.ContentType)
+	}
+	if !bytes.HasPrefix(doc.Content, []byte("%PDF")) {
+		t.Fatalf("receipt content is not a PDF")
+	}
+	if !bytes.Contains(doc.Content, []byte("IDR 150.000")) {
+		t.Fatalf("receipt should contain formatted amount")
+	}
+}
+
+func TestFinanceService_GetReceipt_CSV(t *testing.T) {
+	userID := uuid.New()
+	txID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{
+			ID:       txID,
+			UserID:   userID,
+			Amount:   2000,
+			Currency: "IDR",
+			Type:     "DEPOSIT",
+			Payment:  models.Payment{Status: "SUCCESS"},
+		},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	doc, err := svc.GetReceipt(context.Background(), userID, txID, pb.DocumentFormat_CSV)
+	if err != nil {
+		t.Fatalf("GetReceipt() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(doc.Content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got %d lines", len(lines))
+	}
+	if !strings.HasPrefix(lines[1], txID.String()+",") || !strings.HasSuffix(lines[1], ",2000,IDR,SUCCESS") {
+		t.Fatalf("unexpected csv row: %s", lines[1])
+	}
+}
+
+func TestFinanceService_GetReceipt_OtherUser(t *testing.T) {
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{ID: uuid.New(), UserID: uuid.New(), Payment: models.Payment{Status: "SUCCESS"}},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	_, err := svc.GetReceipt(context.Background(), uuid.New(), repo.txByID.ID, pb.DocumentFormat_PDF)
+	if !errors.Is(err, models.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for another user's transaction, got %v", err)
+	}
+}
+
+func TestFinanceService_GetReceipt_NotSettled(t *testing.T) {
+	userID := uuid.New()
+	repo := &mockFinanceRepo{
+		txByID: &models.Transaction{ID: uuid.New(), UserID: userID, Payment: models.Payment{Status: "PENDING"}},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	_, err := svc.GetReceipt(context.Background(), userID, repo.txByID.ID, pb.DocumentFormat_PDF)
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for pending transaction, got %v", err)
+	}
+}
+
+func TestFinanceService_GetStatement_Monthly(t *testing.T) {
+	repo := &mockFinanceRepo{
+		settledBetween: []models.Transaction{
+			{ID: uuid.New(), Amount: 5000, Currency: "IDR", Type: "DEPOSIT", Payment: models.Payment{Status: "SUCCESS"}},
+			{ID: uuid.New(), Amount: 3000, Currency: "IDR", Type: "ADOPT", Payment: models.Payment{Status: "SUCCESS"}},
+		},
+	}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	doc, err := svc.GetStatement(context.Background(), uuid.New(), &pb.StatementRequest{
+		Period: pb.StatementPeriod_MONTHLY,
+		Year:   2026,
+		Month:  2,
+		Format: pb.DocumentFormat_PDF,
+	})
+	if err != nil {
+		t.Fatalf("GetStatement() error = %v", err)
+	}
+	if doc.FileName != "statement-2026-02.pdf" {
+		t.Fatalf("unexpected file name %s", doc.FileName)
+	}
+	if !repo.settledFrom.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)) || !repo.settledTo.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected statement range %v - %v", repo.settledFrom, repo.settledTo)
+	}
+	if !bytes.Contains(doc.Content, []byte("Total Top Ups : IDR 5.000")) || !bytes.Contains(doc.Content, []byte("Total Spent   : IDR 3.000")) {
+		t.Fatalf("statement totals not rendered")
+	}
+}
+
+func TestFinanceService_GetStatement_InvalidMonth(t *testing.T) {
+	svc := NewFinanceService(&mockFinanceRepo{}, "x", nil, nil)
+
+	_, err := svc.GetStatement(context.Background(), uuid.New(), &pb.StatementRequest{
+		Period: pb.StatementPeriod_MONTHLY,
+		Year:   2026,
+		Month:  13,
+	})
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestStatementRange_Annual(t *testing.T) {
+	from, to, label, err := statementRange(pb.StatementPeriod_ANNUAL, 2025, 0)
+	if err != nil {
+		t.Fatalf("statementRange() error = %v", err)
+	}
+	if label != "2025" || from.Year() != 2025 || to.Year() != 2026 || to.YearDay() != 1 {
+		t.Fatalf("unexpected annual range %v - %v (%s)", from, to, label)
+	}
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+// Top-up limits in minor units of money.DefaultCurrency. Wallets held in
+// another currency get the converted equivalent.
+const (
+	minTopUpAmount   = 10_000
+	maxTopUpAmount   = 20_000_000
+	dailyTopUpVolume = 50_000_000
+)
+
+const (
+	minInvoiceDuration = 5 * time.Minute
+	maxInvoiceDuration = 7 * 24 * time.Hour
+	// maxTopUpsPerHour is how many top-ups a user can start in an hour
+	// before the next one is flagged.
+	maxTopUpsPerHour = 5
+	// maxSameAmountTopUps is how many top-ups of one amount a user can start
+	// in 24 hours before the next one is flagged.
+	maxSameAmountTopUps = 3
+)
+
+// Codes of the rules that flag a top-up for review.
+const (
+	topUpRuleVelocity        = "VELOCITY"
+	topUpRuleDailyVolume     = "DAILY_VOLUME"
+	topUpRuleDuplicateAmount = "DUPLICATE_AMOUNT"
+)
+
+// topUpCheck is a top-up as the fraud rules see it, before anything is
+// stored. Amount is what the user asked for, before any voucher.
+type topUpCheck struct {
+	UserID   uuid.UUID
+	Amount   int64
+	Currency string
+	Duration time.Duration
+}
+
+// topUpFlag is a rule that wants a top-up looked at by an admin.
+type topUpFlag struct {
+	Rule   string
+	Reason string
+}
+
+// topUpRule inspects a top-up. It rejects the top-up by returning an error
+// and flags it for review by returning a flag.
+type topUpRule func(ctx context.Context, check topUpCheck) (*topUpFlag, error)
+
+// screenTopUp runs every top-up rule in turn. The first rejection wins;
+// otherwise the flags of all rules that fired are returned.
+func (s *financeService) screenTopUp(ctx context.Context, check topUpCheck) ([]topUpFlag, error) {
+	rules := []topUpRule{
+		s.checkTopUpAmount,
+		s.checkInvoiceDuration,
+		s.checkTopUpVelocity,
+		s.checkDailyTopUpVolume,
+		s.checkDuplicateTopUps,
+	}
+
+	var flags []topUpFlag
+	for _, rule := range rules {
+		flag, err := rule(ctx, check)
+		if err != nil {
+			return nil, err
+		}
+		if flag != nil {
+			flags = append(flags, *flag)
+		}
+	}
+	return flags, nil
+}
+
+func (s *financeService) checkTopUpAmount(ctx context.Context, check topUpCheck) (*topUpFlag, error) {
+	minAmount, err := s.convertLimit(minTopUpAmount, check.Currency, "top ups")
+	if err != nil {
+		return nil, err
+	}
+	maxAmount, err := s.convertLimit(maxTopUpAmount, check.Currency, "top ups")
+	if err != nil {
+		return nil, err
+	}
+	if check.Amount < minAmount {
+		return nil, fmt.Errorf("%w: a top up must be at least %s", models.ErrInvalidInput, money.Format(minAmount, check.Currency))
+	}
+	if check.Amount > maxAmount {
+		return nil, fmt.Errorf("%w: a single top up cannot exceed %s", models.ErrLimitExceeded, money.Format(maxAmount, check.Currency))
+	}
+	return nil, nil
+}
+
+func (s *financeService) checkInvoiceDuration(ctx context.Context, check topUpCheck) (*topUpFlag, error) {
+	if check.Duration < minInvoiceDuration || check.Duration > maxInvoiceDuration {
+		return nil, fmt.Errorf("%w: duration must be between %s and %s", models.ErrInvalidInput, minInvoiceDuration, maxInvoiceDuration)
+	}
+	return nil, nil
+}
+
+func (s *financeService) checkTopUpVelocity(ctx context.Context, check topUpCheck) (*topUpFlag, error) {
+	started, err := s.repo.CountDepositsSince(ctx, check.UserID, time.Now().Add(-time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if started >= maxTopUpsPerHour {
+		return &topUpFlag{
+			Rule:   topUpRuleVelocity,
+			Reason: fmt.Sprintf("%d earlier top ups in the last hour, over the limit of %d", started, maxTopUpsPerHour),
+		}, nil
+	}
+	return nil, nil
+}
+
+func (s *financeService) checkDailyTopUpVolume(ctx context.Context, check topUpCheck) (*topUpFlag, error) {
+	limit, err := s.convertLimit(dailyTopUpVolume, check.Currency, "top ups")
+	if err != nil {
+		return nil, err
+	}
+	volume, err := s.repo.SumDepositsSince(ctx, check.UserID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if volume+check.Amount > limit {
+		return &topUpFlag{
+			Rule:   topUpRuleDailyVolume,
+			Reason: fmt.Sprintf("%s topped up in 24 hours, over the %s limit", money.Format(volume+check.Amount, check.Currency), money.Format(limit, check.Currency)),
+		}, nil
+	}
+	return nil, nil
+}
+
+func (s *financeService) checkDuplicateTopUps(ctx context.Context, check topUpCheck) (*topUpFlag, error) {
+	repeats, err := s.repo.CountDepositsOfAmountSince(ctx, check.UserID, check.Amount, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if repeats >= maxSameAmountTopUps {
+		return &topUpFlag{
+			Rule:   topUpRuleDuplicateAmount,
+			Reason: fmt.Sprintf("%d earlier top ups of %s in 24 hours", repeats, money.Format(check.Amount, check.Currency)),
+		}, nil
+	}
+	return nil, nil
+}
+
+// holdTopUpForReview stores a flagged top-up, and the redemption of voucher if
+// one was applied, without issuing an invoice. The payment waits in REVIEW
+// until an admin decides on the review.
+func (s *financeService) holdTopUpForReview(ctx context.Context, tx *models.Transaction, voucher *models.Voucher, flags []topUpFlag, duration int) (*models.Transaction, error) {
+	tx.Payment.Amount = tx.Amount
+	tx.Payment.Status = "REVIEW"
+
+	rules := make([]string, len(flags))
+	reasons := make([]string, len(flags))
+	for i, flag := range flags {
+		rules[i], reasons[i] = flag.Rule, flag.Reason
+	}
+	err := s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		if err := saveTransaction(ctx, repo, tx, voucher); err != nil {
+			return err
+		}
+		return repo.CreateTopUpReview(ctx, &models.TopUpReview{
+			TransactionID:   tx.ID,
+			UserID:          tx.UserID,
+			Amount:          tx.Amount,
+			Currency:        tx.Currency,
+			Rules:           strings.Join(rules, ","),
+			Reasons:         strings.Join(reasons, "; "),
+			InvoiceDuration: duration,
+			Status:          "PENDING",
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (s *financeService) ListTopUpReviews(ctx context.Context, status string) ([]models.TopUpReview, error) {
+	return s.repo.ListTopUpReviews(ctx, strings.ToUpper(strings.TrimSpace(status)))
+}
+
+// ApproveTopUpReview lets a flagged top-up through and issues its invoice.
+// Should the invoice fail the payment stays PENDING without a link and
+// expires like any other unpaid top-up.
+func (s *financeService) ApproveTopUpReview(ctx context.Context, reviewerID, reviewID uuid.UUID, note string) (*models.TopUpReview, error) {
+	review, tx, err := s.pendingTopUpReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := review.InvoiceDuration
+	if duration <= 0 {
+		duration = 86400
+	}
+	expiresAt := time.Now().Add(time.Duration(duration) * time.Second)
+	err = s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		if err := repo.DecideTopUpReview(ctx, review.ID, "APPROVED", reviewerID, note); err != nil {
+			return err
+		}
+		if err := repo.UpdateTransactionStatus(ctx, tx.ID, "PENDING"); err != nil {
+			return err
+		}
+		return repo.UpdateTransactionInvoiceDetails(ctx, tx.ID, "", "", expiresAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx.Payment.Status, tx.Payment.ExpiresAt = "PENDING", expiresAt
+	decideReview(review, "APPROVED", reviewerID, note)
+
+	if err := s.issueInvoice(ctx, tx, duration); err != nil {
+		return nil, fmt.Errorf("top up %s approved but its invoice could not be issued: %w", tx.ID, err)
+	}
+	s.notifyInvoiceCreated(ctx, tx)
+	return review, nil
+}
+
+// RejectTopUpReview turns a flagged top-up down. No invoice was ever issued,
+// so only the voucher use it took needs giving back.
+func (s *financeService) RejectTopUpReview(ctx context.Context, reviewerID, reviewID uuid.UUID, note string) (*models.TopUpReview, error) {
+	review, tx, err := s.pendingTopUpReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		if err := repo.DecideTopUpReview(ctx, review.ID, "REJECTED", reviewerID, note); err != nil {
+			return err
+		}
+		if err := repo.UpdateTransactionStatus(ctx, tx.ID, "REJECTED"); err != nil {
+			return err
+		}
+		if tx.VoucherCode != "" {
+			return repo.ReleaseVoucherRedemption(ctx, tx.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	decideReview(review, "REJECTED", reviewerID, note)
+	return review, nil
+}
+
+// pendingTopUpReview loads a review that is still awaiting a decision along
+// with its transaction.
+func (s *financeService) pendingTopUpReview(ctx context.Context, reviewID uuid.UUID) (*models.TopUpReview, *models.Transaction, error) {
+	review, err := s.repo.GetTopUpReview(ctx, reviewID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if review.Status != "PENDING" {
+		return nil, nil, fmt.Errorf("%w: review is already %s", models.ErrInvalidInput, review.Status)
+	}
+	tx, err := s.repo.GetTransactionByID(ctx, review.TransactionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load transaction %s: %w", review.TransactionID, err)
+	}
+	return review, tx, nil
+}
+
+func decideReview(review *models.TopUpReview, status string, reviewerID uuid.UUID, note string) {
+	now := time.Now()
+	review.Status = status
+	review.ReviewerID = &reviewerID
+	review.Note = note
+	review.ReviewedAt = &now
+}
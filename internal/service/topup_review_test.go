@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"reforest/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// failXendit fails the test if anything talks to the payment provider.
+func failXendit(t *testing.T) {
+	t.Helper()
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected call to %s", r.URL)
+		return nil, nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = origTransport })
+}
+
+func TestFinanceService_TopUpWallet_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		duration int32
+		started  int64
+		wantErr  error
+	}{
+		{"below minimum", 5000, 3600, 0, models.ErrInvalidInput},
+		{"above maximum", 25_000_000, 3600, 0, models.ErrLimitExceeded},
+		{"duration too short", 50000, 60, 0, models.ErrInvalidInput},
+		{"duration too long", 50000, 8 * 24 * 3600, 0, models.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failXendit(t)
+			repo := &mockFinanceRepo{depositsStarted: tt.started}
+			svc := NewFinanceService(repo, "x", nil, nil)
+
+			if _, err := svc.TopUpWallet(context.Background(), uuid.New(), tt.amount, "", tt.duration, "", models.PaymentMethod{}); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TopUpWallet() error = %v, want %v", err, tt.wantErr)
+			}
+			if repo.createTx != nil {
+				t.Fatalf("no transaction should be created")
+			}
+		})
+	}
+}
+
+func TestFinanceService_TopUpWallet_FlaggedForReview(t *testing.T) {
+	failXendit(t)
+	repo := &mockFinanceRepo{depositsStarted: 5, depositVolume: 45_000_000, depositsOfAmount: 3}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	userID := uuid.New()
+	tx, err := svc.TopUpWallet(context.Background(), userID, 10_000_000, "", 7200, "", models.PaymentMethod{})
+	if err != nil {
+		t.Fatalf("TopUpWallet() error = %v", err)
+	}
+
+	if repo.createTx != tx || tx.Payment.Status != "REVIEW" || tx.Payment.PaymentURL != "" {
+		t.Fatalf("flagged top up should wait for review without an invoice, got %+v", tx.Payment)
+	}
+	review := repo.createdReview
+	if review == nil || review.TransactionID != tx.ID || review.UserID != userID || review.Status != "PENDING" {
+		t.Fatalf("expected a pending review for the top up, got %+v", review)
+	}
+	if review.Rules != "VELOCITY,DAILY_VOLUME,DUPLICATE_AMOUNT" || review.InvoiceDuration != 7200 {
+		t.Fatalf("unexpected review: %+v", review)
+	}
+}
+
+func testTopUpReview(userID uuid.UUID) (*models.TopUpReview, *models.Transaction) {
+	tx := &models.Transaction{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Amount:      10_000_000,
+		Currency:    "IDR",
+		Type:        "DEPOSIT",
+		VoucherCode: "BONUS5K",
+		Payment:     models.Payment{Amount: 10_000_000, Status: "REVIEW"},
+	}
+	review := &models.TopUpReview{
+		ID:              uuid.New(),
+		TransactionID:   tx.ID,
+		UserID:          userID,
+		Amount:          tx.Amount,
+		Currency:        "IDR",
+		Rules:           "DAILY_VOLUME",
+		InvoiceDuration: 7200,
+		Status:          "PENDING",
+	}
+	return review, tx
+}
+
+func TestFinanceService_ApproveTopUpReview(t *testing.T) {
+	review, tx := testTopUpReview(uuid.New())
+	repo := &mockFinanceRepo{reviewByID: review, txByID: tx}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	var invoiced bool
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		invoiced = true
+		body := `{"id":"inv-1","invoice_url":"https://pay.xendit.co/abc","status":"PENDING","expiry_date":"2030-01-01T00:00:00Z"}`
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	adminID := uuid.New()
+	decided, err := svc.ApproveTopUpReview(context.Background(), adminID, review.ID, "known donor")
+	if err != nil {
+		t.Fatalf("ApproveTopUpReview() error = %v", err)
+	}
+
+	if repo.decidedReviewID != review.ID || repo.decidedStatus != "APPROVED" || repo.decidedReviewer != adminID {
+		t.Fatalf("review should be approved by the admin, got %q", repo.decidedStatus)
+	}
+	if repo.updatedStatusValue != "PENDING" || !invoiced || repo.invoiceUpdateURL != "https://pay.xendit.co/abc" {
+		t.Fatalf("approved top up should be invoiced")
+	}
+	if decided.Status != "APPROVED" || decided.Note != "known donor" || decided.ReviewedAt == nil {
+		t.Fatalf("unexpected review: %+v", decided)
+	}
+}
+
+func TestFinanceService_RejectTopUpReview(t *testing.T) {
+	failXendit(t)
+	review, tx := testTopUpReview(uuid.New())
+	repo := &mockFinanceRepo{reviewByID: review, txByID: tx}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	decided, err := svc.RejectTopUpReview(context.Background(), uuid.New(), review.ID, "")
+	if err != nil {
+		t.Fatalf("RejectTopUpReview() error = %v", err)
+	}
+	if repo.decidedStatus != "REJECTED" || decided.Status != "REJECTED" {
+		t.Fatalf("review should be rejected, got %q", repo.decidedStatus)
+	}
+	if repo.updatedStatusID != tx.ID || repo.updatedStatusValue != "REJECTED" {
+		t.Fatalf("payment should be rejected, got %q", repo.updatedStatusValue)
+	}
+	if repo.releasedTxID != tx.ID {
+		t.Fatalf("voucher use should be given back")
+	}
+}
+
+func TestFinanceService_DecideTopUpReview_AlreadyDecided(t *testing.T) {
+	failXendit(t)
+	review, tx := testTopUpReview(uuid.New())
+	review.Status = "REJECTED"
+	repo := &mockFinanceRepo{reviewByID: review, txByID: tx}
+	svc := NewFinanceService(repo, "x", nil, nil)
+
+	if _, err := svc.ApproveTopUpReview(context.Background(), uuid.New(), review.ID, ""); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("ApproveTopUpReview() error = %v, want ErrInvalidInput", err)
+	}
+	if repo.decidedStatus != "" {
+		t.Fatalf("decided review must be left alone")
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+// Transfer limits in minor units of money.DefaultCurrency. Wallets held in
+// another currency get the converted equivalent.
+const (
+	maxTransferAmount  = 5_000_000
+	dailyTransferLimit = 10_000_000
+)
+
+// TransferBalance moves amount from the sender's wallet to the wallet of the
+// user registered with recipientEmail. Both sides are recorded as TRANSFER
+// transactions in one database transaction; the outgoing one is returned.
+func (s *financeService) TransferBalance(ctx context.Context, senderID uuid.UUID, recipientEmail string, amount int64) (*models.Transaction, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", models.ErrInvalidInput)
+	}
+	recipientEmail = strings.TrimSpace(recipientEmail)
+	if recipientEmail == "" {
+		return nil, fmt.Errorf("%w: recipient email is required", models.ErrInvalidInput)
+	}
+
+	recipientID, err := s.repo.GetUserIDByEmail(ctx, recipientEmail)
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, fmt.Errorf("%w: no user registered with %s", models.ErrNotFound, recipientEmail)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if recipientID == senderID {
+		return nil, fmt.Errorf("%w: cannot transfer to yourself", models.ErrInvalidInput)
+	}
+
+	_, currency, err := s.repo.GetUserBalance(ctx, senderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender wallet: %w", err)
+	}
+	_, recipientCurrency, err := s.repo.GetUserBalance(ctx, recipientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipient wallet: %w", err)
+	}
+	if recipientCurrency != currency {
+		return nil, fmt.Errorf("%w: recipient wallet is held in %s, yours in %s", models.ErrCurrencyMismatch, recipientCurrency, currency)
+	}
+
+	maxAmount, err := s.convertLimit(maxTransferAmount, currency, "transfers")
+	if err != nil {
+		return nil, err
+	}
+	dailyLimit, err := s.convertLimit(dailyTransferLimit, currency, "transfers")
+	if err != nil {
+		return nil, err
+	}
+	if amount > maxAmount {
+		return nil, fmt.Errorf("%w: a single transfer cannot exceed %s", models.ErrLimitExceeded, money.Format(maxAmount, currency))
+	}
+
+	out := &models.Transaction{
+		ID:             uuid.New(),
+		UserID:         senderID,
+		Amount:         amount,
+		Currency:       currency,
+		Type:           "TRANSFER",
+		Direction:      "OUT",
+		CounterpartyID: &recipientID,
+		Payment:        models.Payment{Amount: amount, Status: "SUCCESS"},
+	}
+	in := &models.Transaction{
+		ID:             uuid.New(),
+		UserID:         recipientID,
+		Amount:         amount,
+		Currency:       currency,
+		Type:           "TRANSFER",
+		Direction:      "IN",
+		CounterpartyID: &senderID,
+		ReferenceID:    out.ID.String(),
+		Payment:        models.Payment{Amount: amount, Status: "SUCCESS"},
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.FinanceRepository) error {
+		// The debit locks the sender's wallet row, so concurrent transfers
+		// see each other when the daily total is summed below.
+		if err := repo.DebitWallet(ctx, senderID, amount, currency); err != nil {
+			return err
+		}
+		sent, err := repo.SumTransfersSentSince(ctx, senderID, time.Now().Add(-24*time.Hour))
+		if err != nil {
+			return err
+		}
+		if sent+amount > dailyLimit {
+			return fmt.Errorf("%w: transfers are limited to %s per 24 hours, %s already sent", models.ErrLimitExceeded, money.Format(dailyLimit, currency), money.Format(sent, currency))
+		}
+		if err := repo.CreateTransaction(ctx, out); err != nil {
+			return err
+		}
+		if err := repo.CreateTransaction(ctx, in); err != nil {
+			return err
+		}
+		return repo.UpdateWalletBalance(ctx, recipientID, amount, currency)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.emailTransfer(ctx, out, in)
+	return out, nil
+}
+
+// convertLimit converts a limit set in money.DefaultCurrency into currency.
+// What names the limited feature in the error returned when no rate exists.
+func (s *financeService) convertLimit(limit int64, currency, what string) (int64, error) {
+	if currency == money.DefaultCurrency {
+		return limit, nil
+	}
+	converted, _, err := s.rates.Convert(limit, money.DefaultCurrency, currency)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s are not available for %s wallets: %v", models.ErrCurrencyMismatch, what, currency, err)
+	}
+	return converted, nil
+}
+
+// emailTransfer confirms a transfer to both parties. Failures are only
+// logged, the transfer itself has already been committed.
+func (s *financeService) emailTransfer(ctx context.Context, out, in *models.Transaction) {
+	if s.emailSender == nil {
+		return
+	}
+
+	senderEmail, senderBalance, err := s.repo.GetUserEmailAndBalance(ctx, out.UserID)
+	if err != nil {
+		log.Printf("WARN: failed to fetch sender email for transfer %s: %v", out.ID, err)
+		return
+	}
+	recipientEmail, recipientBalance, err := s.repo.GetUserEmailAndBalance(ctx, in.UserID)
+	if err != nil {
+		log.Printf("WARN: failed to fetch recipient email for transfer %s: %v", out.ID, err)
+		return
+	}
+
+	amount := money.Format(out.Amount, out.Currency)
+	body := fmt.Sprintf("Transfer saldo sebesar %s ke %s berhasil.\nSisa saldo kamu: %s\nNo. transaksi: %s", amount, recipientEmail, money.Format(senderBalance, out.Currency), out.ID)
+	if err := s.emailSender.Send(senderEmail, "Transfer Saldo Berhasil", body); err != nil {
+		log.Printf("WARN: failed to send transfer email to %s: %v", senderEmail, err)
+	}
+
+	body = fmt.Sprintf("Kamu menerima saldo sebesar %s dari %s.\nTotal saldo kamu sekarang: %s\nNo. transaksi: %s", amount, senderEmail, money.Format(recipientBalance, in.Currency), in.ID)
+	if err := s.emailSender.Send(recipientEmail, "Kamu Menerima Saldo", body); err != nil {
+		log.Printf("WARN: failed to send transfer email to %s: %v", recipientEmail, err)
+	}
+}
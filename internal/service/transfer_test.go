@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"reforest/internal/models"
+
+	"github.com/google/uuid"
+)
+
+func TestFinanceService_TransferBalance(t *testing.T) {
+	senderID, recipientID := uuid.New(), uuid.New()
+	repo := &mockFinanceRepo{
+		getBalanceValue: 200000,
+		getEmail:        "family@example.com",
+		usersByEmail:    map[string]uuid.UUID{"sari@example.com": recipientID},
+	}
+	sender := &mockEmailSender{}
+	svc := NewFinanceService(repo, "key", sender, nil)
+
+	out, err := svc.TransferBalance(context.Background(), senderID, " sari@example.com ", 75000)
+	if err != nil {
+		t.Fatalf("TransferBalance() error = %v", err)
+	}
+
+	if repo.debitUserID != senderID || repo.debitAmount != 75000 {
+		t.Fatalf("expected sender debited 75000, got %s %d", repo.debitUserID, repo.debitAmount)
+	}
+	if repo.balanceUserID != recipientID || repo.balanceDelta != 75000 {
+		t.Fatalf("expected recipient credited 75000, got %s %d", repo.balanceUserID, repo.balanceDelta)
+	}
+	if len(repo.createdTxs) != 2 {
+		t.Fatalf("expected both sides recorded, got %d transactions", len(repo.createdTxs))
+	}
+	in := repo.createdTxs[1]
+	if out.Type != "TRANSFER" || out.Direction != "OUT" || out.UserID != senderID || *out.CounterpartyID != recipientID {
+		t.Fatalf("unexpected outgoing transaction: %+v", out)
+	}
+	if in.Type != "TRANSFER" || in.Direction != "IN" || in.UserID != recipientID || in.ReferenceID != out.ID.String() {
+		t.Fatalf("unexpected incoming transaction: %+v", in)
+	}
+	if out.Payment.Status != "SUCCESS" || in.Payment.Status != "SUCCESS" {
+		t.Fatalf("transfers settle immediately")
+	}
+	if len(sender.recipients) != 2 {
+		t.Fatalf("expected confirmation emails to both parties, got %v", sender.recipients)
+	}
+}
+
+func TestFinanceService_TransferBalance_Rejected(t *testing.T) {
+	senderID, recipientID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		email   string
+		amount  int64
+		repo    *mockFinanceRepo
+		wantErr error
+	}{
+		{"non-positive amount", "sari@example.com", 0, &mockFinanceRepo{}, models.ErrInvalidInput},
+		{"unknown recipient", "nobody@example.com", 1000, &mockFinanceRepo{}, models.ErrNotFound},
+		{"to self", "me@example.com", 1000, &mockFinanceRepo{}, models.ErrInvalidInput},
+		{"over single limit", "sari@example.com", maxTransferAmount + 1, &mockFinanceRepo{}, models.ErrLimitExceeded},
+		{"over daily limit", "sari@example.com", 1000, &mockFinanceRepo{transfersSent: dailyTransferLimit}, models.ErrLimitExceeded},
+		{"insufficient balance", "sari@example.com", 1000, &mockFinanceRepo{debitErr: models.ErrInsufficientBalance}, models.ErrInsufficientBalance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.repo.usersByEmail = map[string]uuid.UUID{"sari@example.com": recipientID, "me@example.com": senderID}
+			svc := NewFinanceService(tt.repo, "key", nil, nil)
+
+			if _, err := svc.TransferBalance(context.Background(), senderID, tt.email, tt.amount); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.repo.balanceDelta != 0 {
+				t.Fatalf("recipient must not be credited")
+			}
+		})
+	}
+}
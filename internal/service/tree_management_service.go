@@ -3,12 +3,16 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"reforest/internal/models"
 	"reforest/internal/repository"
+	"reforest/pkg/money"
 	"reforest/pkg/mq"
 	"reforest/pkg/pb"
+	"slices"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -17,21 +21,26 @@ import (
 type TreeManagementService interface {
 	CreateSpecies(ctx context.Context, req *pb.Species) (*models.Species, error)
 	GetSpecies(ctx context.Context, id primitive.ObjectID) (*models.Species, error)
-	ListSpecies(ctx context.Context) ([]*models.Species, error)
+	ListSpecies(ctx context.Context, req *pb.ListSpeciesRequest) ([]*models.Species, models.PageInfo, error)
 	UpdateSpecies(ctx context.Context, id primitive.ObjectID, req *pb.Species) (*models.Species, error)
 	DeleteSpecies(ctx context.Context, id primitive.ObjectID) error
 
 	CreatePlot(ctx context.Context, req *pb.Plot) (*models.Plot, error)
 	GetPlot(ctx context.Context, id primitive.ObjectID) (*models.Plot, error)
-	ListPlots(ctx context.Context) ([]*models.Plot, error)
+	ListPlots(ctx context.Context, req *pb.ListPlotsRequest) ([]*models.Plot, models.PageInfo, error)
+	FindPlotsNear(ctx context.Context, req *pb.FindPlotsNearRequest) ([]*models.Plot, error)
+	FindPlotsWithin(ctx context.Context, req *pb.FindPlotsWithinRequest) ([]*models.Plot, error)
 	UpdatePlot(ctx context.Context, id primitive.ObjectID, req *pb.Plot) (*models.Plot, error)
 	DeletePlot(ctx context.Context, id primitive.ObjectID) error
 
 	AdoptTree(ctx context.Context, req *pb.AdoptTreeRequest, sponsorID string) (*models.AdoptionIntent, string, string, error)
 	GetTree(ctx context.Context, id primitive.ObjectID) (*models.Tree, error)
-	ListTrees(ctx context.Context) ([]*models.Tree, error)
+	ListTrees(ctx context.Context, req *pb.ListTreesRequest) ([]*models.Tree, models.PageInfo, error)
+	ListMyTrees(ctx context.Context, sponsorID string, req *pb.ListMyTreesRequest) (*models.Portfolio, error)
 	UpdateTree(ctx context.Context, id primitive.ObjectID, req *pb.Tree) (*models.Tree, error)
 	DeleteTree(ctx context.Context, id primitive.ObjectID) error
+	ClaimGift(ctx context.Context, code, recipientID string) (*models.Tree, error)
+	ListAdoptionIntents(ctx context.Context, ids []string) ([]*models.AdoptionIntent, error)
 
 	CreateLog(ctx context.Context, req *pb.CreateLogRequest, adminID string) (*models.LogEntry, error)
 	GetLogsByTreeID(ctx context.Context, treeID primitive.ObjectID) ([]*models.LogEntry, error)
@@ -43,18 +52,32 @@ type TreeManagementService interface {
 type treeManagementService struct {
 	repo          repository.TreeManagementRepository
 	financeClient pb.FinanceServiceClient
+	authClient    pb.AuthServiceClient
 	mqClient      *mq.Client
+	emailSender   EmailSender
 }
 
-func NewTreeManagementService(repo repository.TreeManagementRepository, financeClient pb.FinanceServiceClient, mqClient *mq.Client) TreeManagementService {
-	return &treeManagementService{repo: repo, financeClient: financeClient, mqClient: mqClient}
+func NewTreeManagementService(repo repository.TreeManagementRepository, financeClient pb.FinanceServiceClient, authClient pb.AuthServiceClient, mqClient *mq.Client, emailSender EmailSender) TreeManagementService {
+	return &treeManagementService{
+		repo:          repo,
+		financeClient: financeClient,
+		authClient:    authClient,
+		mqClient:      mqClient,
+		emailSender:   emailSender,
+	}
 }
 
 func (s *treeManagementService) CreateSpecies(ctx context.Context, req *pb.Species) (*models.Species, error) {
+	currency, err := money.Normalize(req.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+
 	species := &models.Species{
 		CommonName:      req.CommonName,
 		SpaceRequiredM2: float64(req.SpaceRequiredM2),
 		Price:           req.Price,
+		Currency:        currency,
 	}
 	return s.repo.CreateSpecies(ctx, species)
 }
@@ -63,16 +86,39 @@ func (s *treeManagementService) GetSpecies(ctx context.Context, id primitive.Obj
 	return s.repo.GetSpecies(ctx, id)
 }
 
-func (s *treeManagementService) ListSpecies(ctx context.Context) ([]*models.Species, error) {
-	return s.repo.ListSpecies(ctx)
+func (s *treeManagementService) ListSpecies(ctx context.Context, req *pb.ListSpeciesRequest) ([]*models.Species, models.PageInfo, error) {
+	opts, err := listOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending, "common_name", "price", "space_required_m2")
+	if err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	if req.MinPrice < 0 || req.MaxPrice < 0 || (req.MaxPrice > 0 && req.MaxPrice < req.MinPrice) {
+		return nil, models.PageInfo{}, fmt.Errorf("%w: invalid price range", models.ErrInvalidInput)
+	}
+	filter := models.SpeciesFilter{
+		MinPrice:           req.MinPrice,
+		MaxPrice:           req.MaxPrice,
+		MaxSpaceRequiredM2: float64(req.MaxSpaceRequiredM2),
+	}
+	if req.Currency != "" {
+		if filter.Currency, err = money.Normalize(req.Currency); err != nil {
+			return nil, models.PageInfo{}, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+		}
+	}
+	return s.repo.ListSpecies(ctx, filter, opts)
 }
 
 func (s *treeManagementService) UpdateSpecies(ctx context.Context, id primitive.ObjectID, req *pb.Species) (*models.Species, error) {
+	currency, err := money.Normalize(req.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+
 	species := &models.Species{
 		ID:              id,
 		CommonName:      req.CommonName,
 		SpaceRequiredM2: float64(req.SpaceRequiredM2),
 		Price:           req.Price,
+		Currency:        currency,
 	}
 	return s.repo.UpdateSpecies(ctx, species)
 }
@@ -82,10 +128,19 @@ func (s *treeManagementService) DeleteSpecies(ctx context.Context, id primitive.
 }
 
 func (s *treeManagementService) CreatePlot(ctx context.Context, req *pb.Plot) (*models.Plot, error) {
+	location, boundary, err := plotGeometry(req)
+	if err != nil {
+		return nil, err
+	}
 	plot := &models.Plot{
 		LocationName:     req.LocationName,
 		Address:          req.Address,
 		AvailableSpaceM2: float64(req.AvailableSpaceM2),
+		Location:         location,
+		Boundary:         boundary,
+	}
+	if err := s.checkPlotOverlap(ctx, plot); err != nil {
+		return nil, err
 	}
 	return s.repo.CreatePlot(ctx, plot)
 }
@@ -94,20 +149,69 @@ func (s *treeManagementService) GetPlot(ctx context.Context, id primitive.Object
 	return s.repo.GetPlot(ctx, id)
 }
 
-func (s *treeManagementService) ListPlots(ctx context.Context) ([]*models.Plot, error) {
-	return s.repo.ListPlots(ctx)
+func (s *treeManagementService) ListPlots(ctx context.Context, req *pb.ListPlotsRequest) ([]*models.Plot, models.PageInfo, error) {
+	opts, err := listOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending, "location_name", "available_space_m2")
+	if err != nil {
+		return nil, models.PageInfo{}, err
+	}
+	filter := models.PlotFilter{
+		Location:            strings.TrimSpace(req.Location),
+		MinAvailableSpaceM2: float64(req.MinAvailableSpaceM2),
+	}
+	return s.repo.ListPlots(ctx, filter, opts)
 }
 
 func (s *treeManagementService) UpdatePlot(ctx context.Context, id primitive.ObjectID, req *pb.Plot) (*models.Plot, error) {
+	location, boundary, err := plotGeometry(req)
+	if err != nil {
+		return nil, err
+	}
 	plot := &models.Plot{
 		ID:               id,
 		LocationName:     req.LocationName,
 		Address:          req.Address,
 		AvailableSpaceM2: float64(req.AvailableSpaceM2),
+		Location:         location,
+		Boundary:         boundary,
+	}
+	if err := s.checkPlotOverlap(ctx, plot); err != nil {
+		return nil, err
 	}
 	return s.repo.UpdatePlot(ctx, plot)
 }
 
+func (s *treeManagementService) FindPlotsNear(ctx context.Context, req *pb.FindPlotsNearRequest) ([]*models.Plot, error) {
+	if !validCoordinate(req.Latitude, req.Longitude) {
+		return nil, fmt.Errorf("%w: not a valid coordinate", models.ErrInvalidInput)
+	}
+	if req.RadiusMeters <= 0 || req.RadiusMeters > maxPlotSearchRadius {
+		return nil, fmt.Errorf("%w: radius must be between 0 and %d metres", models.ErrInvalidInput, maxPlotSearchRadius)
+	}
+	return s.repo.FindPlotsNear(ctx, models.NewGeoPoint(req.Longitude, req.Latitude), req.RadiusMeters, maxPageSize)
+}
+
+// FindPlotsWithin returns the plots in a bounding box. Boxes may not cross
+// the antimeridian or span more than half the globe.
+func (s *treeManagementService) FindPlotsWithin(ctx context.Context, req *pb.FindPlotsWithinRequest) ([]*models.Plot, error) {
+	if !validCoordinate(req.MinLatitude, req.MinLongitude) || !validCoordinate(req.MaxLatitude, req.MaxLongitude) {
+		return nil, fmt.Errorf("%w: not a valid coordinate", models.ErrInvalidInput)
+	}
+	if req.MinLatitude >= req.MaxLatitude || req.MinLongitude >= req.MaxLongitude {
+		return nil, fmt.Errorf("%w: minimum must be below maximum", models.ErrInvalidInput)
+	}
+	if req.MaxLongitude-req.MinLongitude > 180 {
+		return nil, fmt.Errorf("%w: box is too wide", models.ErrInvalidInput)
+	}
+	area := models.NewGeoPolygon([][]float64{
+		{req.MinLongitude, req.MinLatitude},
+		{req.MaxLongitude, req.MinLatitude},
+		{req.MaxLongitude, req.MaxLatitude},
+		{req.MinLongitude, req.MaxLatitude},
+		{req.MinLongitude, req.MinLatitude},
+	})
+	return s.repo.FindPlotsWithin(ctx, area, maxPageSize)
+}
+
 func (s *treeManagementService) DeletePlot(ctx context.Context, id primitive.ObjectID) error {
 	return s.repo.DeletePlot(ctx, id)
 }
@@ -123,6 +227,13 @@ func (s *treeManagementService) AdoptTree(ctx context.Context, req *pb.AdoptTree
 		return nil, "", "", models.ErrInvalidInput
 	}
 
+	var gift *models.Gift
+	if req.Gift != nil {
+		if gift, err = s.newGift(ctx, req.Gift, sponsorID); err != nil {
+			return nil, "", "", err
+		}
+	}
+
 	species, err := s.repo.GetSpecies(ctx, speciesID)
 	if err != nil {
 		return nil, "", "", err
@@ -157,11 +268,28 @@ func (s *treeManagementService) AdoptTree(ctx context.Context, req *pb.AdoptTree
 		return nil, "", "", err
 	}
 
+	if gift != nil {
+		gift.IntentID = intent.ID
+		if _, err := s.repo.CreateGift(ctx, gift); err != nil {
+			plot.AvailableSpaceM2 += species.SpaceRequiredM2
+			s.repo.UpdatePlot(ctx, plot)
+			s.repo.UpdateAdoptionIntentStatus(ctx, intent.ID, "FAILED")
+			return nil, "", "", err
+		}
+	}
+
 	tx, err := s.financeClient.CreateTransaction(ctx, &pb.TransactionRequest{
 		UserId:      sponsorID,
 		Amount:      int64(species.Price),
+		Currency:    species.Currency,
 		Type:        pb.TransactionType_ADOPT,
 		ReferenceId: intent.ID.Hex(),
+		VoucherCode: req.VoucherCode,
+		SpeciesId:   speciesID.Hex(),
+		PlotId:      plotID.Hex(),
+		// Passed through to the invoice if the wallet does not cover it
+		PaymentMethod:  req.PaymentMethod,
+		PaymentChannel: req.PaymentChannel,
 	})
 	if err != nil {
 		plot.AvailableSpaceM2 += species.SpaceRequiredM2
@@ -193,10 +321,50 @@ func (s *treeManagementService) GetTree(ctx context.Context, id primitive.Object
 	return tree, nil
 }
 
-func (s *treeManagementService) ListTrees(ctx context.Context) ([]*models.Tree, error) {
-	trees, err := s.repo.ListTrees(ctx)
+// ListAdoptionIntents returns the intents with the given IDs. IDs that are
+// malformed or unknown are skipped, callers match the results up by ID.
+func (s *treeManagementService) ListAdoptionIntents(ctx context.Context, ids []string) ([]*models.AdoptionIntent, error) {
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+			objectIDs = append(objectIDs, oid)
+		}
+	}
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+	return s.repo.ListAdoptionIntentsByIDs(ctx, objectIDs)
+}
+
+func (s *treeManagementService) ListTrees(ctx context.Context, req *pb.ListTreesRequest) ([]*models.Tree, models.PageInfo, error) {
+	opts, err := listOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending, "adopted_at", "custom_name", "last_care_date")
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
+	}
+	filter := models.TreeFilter{SponsorID: req.SponsorId}
+	if req.SpeciesId != "" {
+		if filter.SpeciesID, err = primitive.ObjectIDFromHex(req.SpeciesId); err != nil {
+			return nil, models.PageInfo{}, fmt.Errorf("%w: invalid species ID", models.ErrInvalidInput)
+		}
+	}
+	if req.PlotId != "" {
+		if filter.PlotID, err = primitive.ObjectIDFromHex(req.PlotId); err != nil {
+			return nil, models.PageInfo{}, fmt.Errorf("%w: invalid plot ID", models.ErrInvalidInput)
+		}
+	}
+	if req.AdoptedFrom != nil {
+		filter.AdoptedFrom = req.AdoptedFrom.AsTime()
+	}
+	if req.AdoptedTo != nil {
+		filter.AdoptedTo = req.AdoptedTo.AsTime()
+	}
+	if !filter.AdoptedFrom.IsZero() && !filter.AdoptedTo.IsZero() && !filter.AdoptedTo.After(filter.AdoptedFrom) {
+		return nil, models.PageInfo{}, fmt.Errorf("%w: adopted_to must be after adopted_from", models.ErrInvalidInput)
+	}
+
+	trees, page, err := s.repo.ListTrees(ctx, filter, opts)
+	if err != nil {
+		return nil, models.PageInfo{}, err
 	}
 
 	for _, tree := range trees {
@@ -207,7 +375,107 @@ func (s *treeManagementService) ListTrees(ctx context.Context) ([]*models.Tree,
 			}
 		}
 	}
-	return trees, nil
+	return trees, page, nil
+}
+
+// ListMyTrees returns a page of the sponsor's trees, each with the names of
+// its species and plot and the height and date of its latest log, along
+// with the adoptions the sponsor has yet to pay for.
+func (s *treeManagementService) ListMyTrees(ctx context.Context, sponsorID string, req *pb.ListMyTreesRequest) (*models.Portfolio, error) {
+	opts, err := listOptions(req.PageSize, req.PageToken, req.SortBy, req.Descending, "adopted_at", "custom_name", "last_care_date")
+	if err != nil {
+		return nil, err
+	}
+	trees, page, err := s.repo.ListTrees(ctx, models.TreeFilter{SponsorID: sponsorID}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	speciesNames := make(map[primitive.ObjectID]string)
+	plotNames := make(map[primitive.ObjectID]string)
+	portfolio := &models.Portfolio{Trees: make([]models.PortfolioTree, len(trees)), Page: page}
+	for i, tree := range trees {
+		logs, err := s.repo.GetLogsByTreeID(ctx, tree.ID)
+		if err != nil {
+			return nil, err
+		}
+		var latest *models.LogEntry
+		for _, log := range logs {
+			if latest == nil || log.RecordedAt.After(latest.RecordedAt) {
+				latest = log
+			}
+		}
+		if latest != nil {
+			tree.CurrentHeightMeters = latest.CurrentHeightMeters
+			if latest.RecordedAt.After(tree.LastCareDate) {
+				tree.LastCareDate = latest.RecordedAt
+			}
+		}
+
+		if _, ok := speciesNames[tree.SpeciesID]; !ok {
+			species, err := s.repo.GetSpecies(ctx, tree.SpeciesID)
+			switch {
+			case err == nil:
+				speciesNames[tree.SpeciesID] = species.CommonName
+			case errors.Is(err, models.ErrNotFound):
+				speciesNames[tree.SpeciesID] = "" // deleted since
+			default:
+				return nil, err
+			}
+		}
+		if _, ok := plotNames[tree.PlotID]; !ok {
+			plot, err := s.repo.GetPlot(ctx, tree.PlotID)
+			switch {
+			case err == nil:
+				plotNames[tree.PlotID] = plot.LocationName
+			case errors.Is(err, models.ErrNotFound):
+				plotNames[tree.PlotID] = ""
+			default:
+				return nil, err
+			}
+		}
+		portfolio.Trees[i] = models.PortfolioTree{
+			Tree:        tree,
+			SpeciesName: speciesNames[tree.SpeciesID],
+			PlotName:    plotNames[tree.PlotID],
+		}
+	}
+
+	if portfolio.PendingIntents, err = s.repo.ListAdoptionIntentsBySponsor(ctx, sponsorID, "PENDING"); err != nil {
+		return nil, err
+	}
+	return portfolio, nil
+}
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// listOptions validates the paging and sort fields of a list request. The
+// first of sortFields is the default sort.
+func listOptions(pageSize int32, pageToken, sortBy string, descending bool, sortFields ...string) (models.ListOptions, error) {
+	opts := models.ListOptions{
+		PageSize:   int(pageSize),
+		PageToken:  pageToken,
+		SortBy:     strings.ToLower(strings.TrimSpace(sortBy)),
+		Descending: descending,
+	}
+	switch {
+	case opts.PageSize < 0:
+		return opts, fmt.Errorf("%w: page_size cannot be negative", models.ErrInvalidInput)
+	case opts.PageSize == 0:
+		opts.PageSize = defaultPageSize
+	case opts.PageSize > maxPageSize:
+		opts.PageSize = maxPageSize
+	}
+	if opts.SortBy == "" {
+		opts.SortBy = sortFields[0]
+	}
+	if !slices.Contains(sortFields, opts.SortBy) {
+		return opts, fmt.Errorf("%w: sort_by must be one of %s", models.ErrInvalidInput, strings.Join(sortFields, ", "))
+	}
+	return opts, nil
 }
 
 func (s *treeManagementService) UpdateTree(ctx context.Context, id primitive.ObjectID, req *pb.Tree) (*models.Tree, error) {
@@ -219,15 +487,21 @@ func (s *treeManagementService) UpdateTree(ctx context.Context, id primitive.Obj
 	if err != nil {
 		return nil, models.ErrInvalidInput
 	}
+	location, position, err := s.treePlacement(ctx, plotID, req.Location, req.Position)
+	if err != nil {
+		return nil, err
+	}
 
 	tree := &models.Tree{
-		ID:                  id,
-		SponsorID:           req.SponsorId,
-		SpeciesID:           speciesID,
-		PlotID:              plotID,
-		CustomName:          req.CustomName,
-		LastCareDate:        req.LastCareDate.AsTime(),
-		AdoptedAt:           req.AdoptedAt.AsTime(),
+		ID:           id,
+		SponsorID:    req.SponsorId,
+		SpeciesID:    speciesID,
+		PlotID:       plotID,
+		CustomName:   req.CustomName,
+		LastCareDate: req.LastCareDate.AsTime(),
+		AdoptedAt:    req.AdoptedAt.AsTime(),
+		Location:     location,
+		Position:     position,
 	}
 	return s.repo.UpdateTree(ctx, tree)
 }
@@ -250,7 +524,28 @@ func (s *treeManagementService) CreateLog(ctx context.Context, req *pb.CreateLog
 		Note:                req.Note,
 		RecordedAt:          time.Now(),
 	}
-	return s.repo.CreateLog(ctx, log)
+	if req.Location == nil && req.Position == nil {
+		return s.repo.CreateLog(ctx, log)
+	}
+
+	// The entry records where the tree was planted or moved to.
+	tree, err := s.repo.GetTree(ctx, treeID)
+	if err != nil {
+		return nil, err
+	}
+	if log.Location, log.Position, err = s.treePlacement(ctx, tree.PlotID, req.Location, req.Position); err != nil {
+		return nil, err
+	}
+	err = s.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		if log, err = s.repo.CreateLog(ctx, log); err != nil {
+			return err
+		}
+		return s.repo.UpdateTreePlacement(ctx, treeID, log.Location, log.Position)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return log, nil
 }
 
 func (s *treeManagementService) GetLogsByTreeID(ctx context.Context, treeID primitive.ObjectID) ([]*models.LogEntry, error) {
@@ -281,88 +576,279 @@ func (s *treeManagementService) DeleteLog(ctx context.Context, id primitive.Obje
 
 func (s *treeManagementService) StartConsumers() {
 	err := s.mqClient.Consume("payment.success", func(data []byte) error {
-		var event struct {
-			ReferenceID string `json:"reference_id"`
+		return s.handlePaymentSuccess(context.Background(), data)
+	})
+	if err != nil {
+		log.Printf("Failed to start consumers: %v", err)
+	}
+
+	err = s.mqClient.Consume("payment.expired", func(data []byte) error {
+		return s.handlePaymentExpired(context.Background(), data)
+	})
+
+	if err != nil {
+		log.Printf("Failed to start payment.expired consumer: %v", err)
+	}
+
+	err = s.mqClient.Consume("adoption.matched", func(data []byte) error {
+		return s.handleAdoptionMatched(context.Background(), data)
+	})
+	if err != nil {
+		log.Printf("Failed to start adoption.matched consumer: %v", err)
+	}
+}
+
+func parsePaymentEvent(data []byte) (primitive.ObjectID, error) {
+	var event struct {
+		ReferenceID string `json:"reference_id"`
+	}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return primitive.NilObjectID, err
+	}
+	return primitive.ObjectIDFromHex(event.ReferenceID)
+}
+
+// handlePaymentSuccess plants the tree of a paid adoption intent. The tree,
+// its first log, the intent status and the tree.adopted event are written in
+// one transaction; a redelivered event for a completed intent is ignored.
+// Gifted trees are planted without a sponsor and the recipient is sent an
+// e-card with the claim code.
+func (s *treeManagementService) handlePaymentSuccess(ctx context.Context, data []byte) error {
+	intentID, err := parsePaymentEvent(data)
+	if err != nil {
+		return err
+	}
+
+	var gift *models.Gift
+	err = s.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		gift = nil
+		intent, err := s.repo.GetAdoptionIntent(ctx, intentID)
+		if err != nil {
+			return err
 		}
-		if err := json.Unmarshal(data, &event); err != nil {
+		if intent.Status == "COMPLETED" {
+			return nil
+		}
+
+		tree := &models.Tree{
+			ID:           intent.ID,
+			SponsorID:    intent.SponsorID,
+			SpeciesID:    intent.SpeciesID,
+			PlotID:       intent.PlotID,
+			CustomName:   intent.CustomName,
+			LastCareDate: time.Now(),
+			AdoptedAt:    time.Now(),
+		}
+		note := fmt.Sprintf("Tree adopted by %s", intent.SponsorID)
+
+		g, err := s.repo.GetGiftByIntentID(ctx, intent.ID)
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
 			return err
 		}
-		
-		intentID, err := primitive.ObjectIDFromHex(event.ReferenceID)
-		if err != nil { return err }
+		if g != nil {
+			tree.SponsorID = ""
+			note = fmt.Sprintf("Tree gifted by %s", intent.SponsorID)
+			if err := s.repo.UpdateGiftStatus(ctx, g.ID, "SENT"); err != nil {
+				return err
+			}
+			g.Status = "SENT"
+			gift = g
+		}
 
-		intent, err := s.repo.GetAdoptionIntent(context.Background(), intentID)
-		if err != nil { return err }
+		createdTree, err := s.repo.CreateTree(ctx, tree)
+		if err != nil {
+			return err
+		}
 
-		tree := &models.Tree{
-			ID:                  intent.ID,
-			SponsorID:           intent.SponsorID,
-			SpeciesID:           intent.SpeciesID,
-			PlotID:              intent.PlotID,
-			CustomName:          intent.CustomName,
-			LastCareDate:        time.Now(),
-			AdoptedAt:           time.Now(),
-		}
-		createdTree, err := s.repo.CreateTree(context.Background(), tree)
-		if err != nil { return err }
-
-		_, _ = s.repo.CreateLog(context.Background(), &models.LogEntry{
+		_, err = s.repo.CreateLog(ctx, &models.LogEntry{
 			AdoptedTreeID:       createdTree.ID,
 			Activity:            "Tree Planted",
-			Note:                fmt.Sprintf("Tree adopted by %s", intent.SponsorID),
+			Note:                note,
 			RecordedAt:          time.Now(),
 			CurrentHeightMeters: 0.5,
 		})
+		if err != nil {
+			return err
+		}
 
-		if err := s.repo.UpdateAdoptionIntentStatus(context.Background(), intent.ID, "COMPLETED"); err != nil {
-			log.Printf("WARN: failed to update adoption intent %s status to COMPLETED: %v", intent.ID.Hex(), err)
+		if err := s.repo.UpdateAdoptionIntentStatus(ctx, intent.ID, "COMPLETED"); err != nil {
+			return err
 		}
 
-		return nil
+		return s.repo.EnqueueEvent(ctx, "tree.adopted", map[string]string{
+			"reference_id": intent.ID.Hex(),
+			"tree_id":      createdTree.ID.Hex(),
+			"sponsor_id":   intent.SponsorID,
+			"species_id":   intent.SpeciesID.Hex(),
+			"plot_id":      intent.PlotID.Hex(),
+		})
 	})
 	if err != nil {
-		log.Printf("Failed to start consumers: %v", err)
+		return err
 	}
 
-	err = s.mqClient.Consume("payment.expired", func(data []byte) error {
-		var event struct {
-			ReferenceID string `json:"reference_id"`
+	if gift != nil {
+		s.sendGiftCard(gift)
+	}
+	return nil
+}
+
+// handleAdoptionMatched plants the trees a matching campaign funded next to
+// an employee's adoption: the same species, in the employee's name and, space
+// permitting, in the same plot. Otherwise they go to the first plot with room
+// for all of them. Trees already planted for the match are counted, so a
+// redelivered event plants nothing twice.
+func (s *treeManagementService) handleAdoptionMatched(ctx context.Context, data []byte) error {
+	var event adoptionMatchedEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return err
+	}
+	intentID, err := primitive.ObjectIDFromHex(event.ReferenceID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		planted, err := s.repo.CountTreesByMatchID(ctx, event.MatchID)
+		if err != nil {
+			return err
 		}
-		if err := json.Unmarshal(data, &event); err != nil {
+		trees := event.Trees - int(planted)
+		if trees <= 0 {
+			return nil
+		}
+
+		intent, err := s.repo.GetAdoptionIntent(ctx, intentID)
+		if err != nil {
+			return err
+		}
+		species, err := s.repo.GetSpecies(ctx, intent.SpeciesID)
+		if err != nil {
 			return err
 		}
+		space := species.SpaceRequiredM2 * float64(trees)
 
-		intentID, err := primitive.ObjectIDFromHex(event.ReferenceID)
+		plot, err := s.repo.GetPlot(ctx, intent.PlotID)
 		if err != nil {
 			return err
 		}
+		if plot.AvailableSpaceM2 < space {
+			plots, _, err := s.repo.ListPlots(ctx, models.PlotFilter{MinAvailableSpaceM2: space}, models.ListOptions{
+				PageSize:   1,
+				SortBy:     "available_space_m2",
+				Descending: true,
+			})
+			if err != nil {
+				return err
+			}
+			if len(plots) == 0 {
+				return fmt.Errorf("no plot has room for the %d trees of match %s", trees, event.MatchID)
+			}
+			plot = plots[0]
+		}
+		plot.AvailableSpaceM2 -= space
+		if _, err := s.repo.UpdatePlot(ctx, plot); err != nil {
+			return err
+		}
+
+		for i := 0; i < trees; i++ {
+			tree, err := s.repo.CreateTree(ctx, &models.Tree{
+				SponsorID:    intent.SponsorID,
+				SpeciesID:    intent.SpeciesID,
+				PlotID:       plot.ID,
+				CustomName:   fmt.Sprintf("Matched by %s", event.Organization),
+				LastCareDate: time.Now(),
+				AdoptedAt:    time.Now(),
+				MatchID:      event.MatchID,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = s.repo.CreateLog(ctx, &models.LogEntry{
+				AdoptedTreeID:       tree.ID,
+				Activity:            "Tree Planted",
+				Note:                fmt.Sprintf("Tree matched by %s for %s", event.Organization, intent.SponsorID),
+				RecordedAt:          time.Now(),
+				CurrentHeightMeters: 0.5,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = s.repo.EnqueueEvent(ctx, "tree.adopted", map[string]string{
+				"reference_id": intent.ID.Hex(),
+				"tree_id":      tree.ID.Hex(),
+				"sponsor_id":   intent.SponsorID,
+				"species_id":   intent.SpeciesID.Hex(),
+				"plot_id":      plot.ID.Hex(),
+				"match_id":     event.MatchID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// handlePaymentExpired gives the reserved plot space back and marks the intent
+// EXPIRED in one transaction, announcing the release as adoption.expired.
+func (s *treeManagementService) handlePaymentExpired(ctx context.Context, data []byte) error {
+	intentID, err := parsePaymentEvent(data)
+	if err != nil {
+		return err
+	}
 
-		intent, err := s.repo.GetAdoptionIntent(context.Background(), intentID)
+	return s.repo.WithTransaction(ctx, func(ctx context.Context) error {
+		intent, err := s.repo.GetAdoptionIntent(ctx, intentID)
 		if err != nil {
 			return err
 		}
+		if intent.Status == "EXPIRED" {
+			return nil
+		}
 
 		if intent.Status == "PENDING" {
-			if _, err := s.repo.GetTree(context.Background(), intent.ID); err == nil {
+			if _, err := s.repo.GetTree(ctx, intent.ID); err == nil {
 				log.Printf("WARN: Payment expired event for intent %s but tree exists. Ignoring.", intentID.Hex())
 				return nil
 			}
 
-			species, err := s.repo.GetSpecies(context.Background(), intent.SpeciesID)
-			if err != nil { return err }
+			species, err := s.repo.GetSpecies(ctx, intent.SpeciesID)
+			if err != nil {
+				return err
+			}
 
-			plot, err := s.repo.GetPlot(context.Background(), intent.PlotID)
-			if err != nil { return err }
+			plot, err := s.repo.GetPlot(ctx, intent.PlotID)
+			if err != nil {
+				return err
+			}
 
 			plot.AvailableSpaceM2 += species.SpaceRequiredM2
-			if _, err := s.repo.UpdatePlot(context.Background(), plot); err != nil { return err }
+			if _, err := s.repo.UpdatePlot(ctx, plot); err != nil {
+				return err
+			}
+
+			if gift, err := s.repo.GetGiftByIntentID(ctx, intent.ID); err == nil {
+				if err := s.repo.UpdateGiftStatus(ctx, gift.ID, "EXPIRED"); err != nil {
+					return err
+				}
+			} else if !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+
+			err = s.repo.EnqueueEvent(ctx, "adoption.expired", map[string]string{
+				"reference_id": intent.ID.Hex(),
+				"sponsor_id":   intent.SponsorID,
+				"plot_id":      intent.PlotID.Hex(),
+			})
+			if err != nil {
+				return err
+			}
 		}
 
 		log.Printf("Payment expired for adoption intent %s. Marking as EXPIRED.", intentID.Hex())
-		return s.repo.UpdateAdoptionIntentStatus(context.Background(), intentID, "EXPIRED")
+		return s.repo.UpdateAdoptionIntentStatus(ctx, intentID, "EXPIRED")
 	})
-
-	if err != nil {
-		log.Printf("Failed to start payment.expired consumer: %v", err)
-	}
-}
\ No newline at end of file
+}
@@ -35,17 +35,35 @@ type mockTreeRepo struct {
 	speciesList    []*models.Species
 	plot           *models.Plot
 	plots          []*models.Plot
+	nearPoint      *models.GeoPoint
+	nearRadius     float64
+	withinArea     *models.GeoPolygon
 	logsByTree     map[primitive.ObjectID][]*models.LogEntry
 	listTreesResp  []*models.Tree
+	treeFilter     models.TreeFilter
+	pendingIntents []*models.AdoptionIntent
+	listOpts       models.ListOptions
 	updateTree     *models.Tree
 	intent         *models.AdoptionIntent
 	intentStatus   string
+	intentIDs      []primitive.ObjectID
 	deletedSpecies primitive.ObjectID
 	deletedPlot    primitive.ObjectID
 	deletedTree    primitive.ObjectID
 	deletedLog     primitive.ObjectID
 	createLogInput *models.LogEntry
 	updatedLog     *models.LogEntry
+	events         []mockOutboxEvent
+	gift           *models.Gift
+	matchedTrees   int64
+}
+
+func (m *mockTreeRepo) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+func (m *mockTreeRepo) EnqueueEvent(ctx context.Context, routingKey string, payload interface{}) error {
+	m.events = append(m.events, mockOutboxEvent{routingKey: routingKey, payload: payload})
+	return nil
 }
 
 func (m *mockTreeRepo) CreateSpecies(ctx context.Context, species *models.Species) (*models.Species, error) {
@@ -61,8 +79,9 @@ func (m *mockTreeRepo) GetSpecies(ctx context.Context, id primitive.ObjectID) (*
 	}
 	return nil, models.ErrNotFound
 }
-func (m *mockTreeRepo) ListSpecies(ctx context.Context) ([]*models.Species, error) {
-	return m.speciesList, nil
+func (m *mockTreeRepo) ListSpecies(ctx context.Context, filter models.SpeciesFilter, opts models.ListOptions) ([]*models.Species, models.PageInfo, error) {
+	m.listOpts = opts
+	return m.speciesList, models.PageInfo{TotalCount: int64(len(m.speciesList))}, nil
 }
 func (m *mockTreeRepo) UpdateSpecies(ctx context.Context, species *models.Species) (*models.Species, error) {
 	m.species = species
@@ -85,11 +104,37 @@ func (m *mockTreeRepo) GetPlot(ctx context.Context, id primitive.ObjectID) (*mod
 	}
 	return nil, models.ErrNotFound
 }
-func (m *mockTreeRepo) ListPlots(ctx context.Context) ([]*models.Plot, error) { return m.plots, nil }
+func (m *mockTreeRepo) ListPlots(ctx context.Context, filter models.PlotFilter, opts models.ListOptions) ([]*models.Plot, models.PageInfo, error) {
+	m.listOpts = opts
+	var plots []*models.Plot
+	for _, p := range m.plots {
+		if p.AvailableSpaceM2 >= filter.MinAvailableSpaceM2 {
+			plots = append(plots, p)
+		}
+	}
+	return plots, models.PageInfo{TotalCount: int64(len(plots))}, nil
+}
 func (m *mockTreeRepo) UpdatePlot(ctx context.Context, plot *models.Plot) (*models.Plot, error) {
 	m.plot = plot
 	return plot, nil
 }
+func (m *mockTreeRepo) FindPlotsNear(ctx context.Context, point *models.GeoPoint, radiusMeters float64, limit int) ([]*models.Plot, error) {
+	m.nearPoint, m.nearRadius = point, radiusMeters
+	return m.plots, nil
+}
+func (m *mockTreeRepo) FindPlotsWithin(ctx context.Context, area *models.GeoPolygon, limit int) ([]*models.Plot, error) {
+	m.withinArea = area
+	return m.plots, nil
+}
+func (m *mockTreeRepo) FindPlotsIntersecting(ctx context.Context, boundary *models.GeoPolygon, excludeID primitive.ObjectID) ([]*models.Plot, error) {
+	var plots []*models.Plot
+	for _, p := range m.plots {
+		if p.ID != excludeID {
+			plots = append(plots, p)
+		}
+	}
+	return plots, nil
+}
 func (m *mockTreeRepo) DeletePlot(ctx context.Context, id primitive.ObjectID) error {
 	m.deletedPlot = id
 	return nil
@@ -97,21 +142,43 @@ func (m *mockTreeRepo) DeletePlot(ctx context.Context, id primitive.ObjectID) er
 
 func (m *mockTreeRepo) CreateTree(ctx context.Context, tree *models.Tree) (*models.Tree, error) {
 	m.createdTree = tree
+	if tree.MatchID != "" {
+		m.matchedTrees++
+	}
 	if tree.ID.IsZero() {
 		tree.ID = primitive.NewObjectID()
 	}
 	return tree, nil
 }
 func (m *mockTreeRepo) GetTree(ctx context.Context, id primitive.ObjectID) (*models.Tree, error) {
+	if m.tree == nil {
+		return nil, models.ErrNotFound
+	}
 	return m.tree, nil
 }
-func (m *mockTreeRepo) ListTrees(ctx context.Context) ([]*models.Tree, error) {
-	return m.listTreesResp, nil
+func (m *mockTreeRepo) ListTrees(ctx context.Context, filter models.TreeFilter, opts models.ListOptions) ([]*models.Tree, models.PageInfo, error) {
+	m.treeFilter, m.listOpts = filter, opts
+	return m.listTreesResp, models.PageInfo{TotalCount: int64(len(m.listTreesResp))}, nil
+}
+func (m *mockTreeRepo) CountTreesByMatchID(ctx context.Context, matchID string) (int64, error) {
+	return m.matchedTrees, nil
 }
 func (m *mockTreeRepo) UpdateTree(ctx context.Context, tree *models.Tree) (*models.Tree, error) {
 	m.updateTree = tree
 	return tree, nil
 }
+func (m *mockTreeRepo) UpdateTreePlacement(ctx context.Context, id primitive.ObjectID, location *models.GeoPoint, position *models.GridPosition) error {
+	if m.tree == nil || m.tree.ID != id {
+		return models.ErrNotFound
+	}
+	if location != nil {
+		m.tree.Location = location
+	}
+	if position != nil {
+		m.tree.Position = position
+	}
+	return nil
+}
 func (m *mockTreeRepo) DeleteTree(ctx context.Context, id primitive.ObjectID) error {
 	m.deletedTree = id
 	return nil
@@ -157,6 +224,23 @@ func (m *mockTreeRepo) GetAdoptionIntent(ctx context.Context, id primitive.Objec
 	return nil, models.ErrNotFound
 }
 
+func (m *mockTreeRepo) ListAdoptionIntentsByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*models.AdoptionIntent, error) {
+	m.intentIDs = ids
+	if m.intent == nil {
+		return nil, nil
+	}
+	return []*models.AdoptionIntent{m.intent}, nil
+}
+
+func (m *mockTreeRepo) ListAdoptionIntentsBySponsor(ctx context.Context, sponsorID, status string) ([]*models.AdoptionIntent, error) {
+	var intents []*models.AdoptionIntent
+	for _, intent := range m.pendingIntents {
+		if intent.SponsorID == sponsorID && intent.Status == status {
+			intents = append(intents, intent)
+		}
+	}
+	return intents, nil
+}
 func (m *mockTreeRepo) UpdateAdoptionIntentStatus(ctx context.Context, id primitive.ObjectID, status string) error {
 	if m.intent != nil && m.intent.ID == id {
 		m.intent.Status = status
@@ -165,14 +249,49 @@ func (m *mockTreeRepo) UpdateAdoptionIntentStatus(ctx context.Context, id primit
 	return nil
 }
 
+func (m *mockTreeRepo) CreateGift(ctx context.Context, gift *models.Gift) (*models.Gift, error) {
+	if gift.ID.IsZero() {
+		gift.ID = primitive.NewObjectID()
+	}
+	m.gift = gift
+	return gift, nil
+}
+func (m *mockTreeRepo) GetGiftByIntentID(ctx context.Context, intentID primitive.ObjectID) (*models.Gift, error) {
+	if m.gift != nil && m.gift.IntentID == intentID {
+		return m.gift, nil
+	}
+	return nil, models.ErrNotFound
+}
+func (m *mockTreeRepo) GetGiftByClaimCode(ctx context.Context, code string) (*models.Gift, error) {
+	if m.gift != nil && m.gift.ClaimCode == code {
+		return m.gift, nil
+	}
+	return nil, models.ErrNotFound
+}
+func (m *mockTreeRepo) UpdateGiftStatus(ctx context.Context, id primitive.ObjectID, status string) error {
+	if m.gift != nil && m.gift.ID == id {
+		m.gift.Status = status
+	}
+	return nil
+}
+func (m *mockTreeRepo) MarkGiftClaimed(ctx context.Context, id primitive.ObjectID, recipientID string, claimedAt time.Time) error {
+	if m.gift == nil || m.gift.ID != id || m.gift.Status != "SENT" {
+		return models.ErrNotFound
+	}
+	m.gift.Status, m.gift.RecipientID, m.gift.ClaimedAt = "CLAIMED", recipientID, claimedAt
+	return nil
+}
+
 type mockFinanceClient struct {
 	txList      *pb.TransactionList
 	txErr       error
 	txResp      *pb.Transaction
 	txErrCreate error
+	txReq       *pb.TransactionRequest
 }
 
 func (m *mockFinanceClient) CreateTransaction(ctx context.Context, in *pb.TransactionRequest, opts ...grpc.CallOption) (*pb.Transaction, error) {
+	m.txReq = in
 	if m.txResp != nil {
 		return m.txResp, m.txErrCreate
 	}
@@ -181,22 +300,162 @@ func (m *mockFinanceClient) CreateTransaction(ctx context.Context, in *pb.Transa
 func (m *mockFinanceClient) TopUpWallet(ctx context.Context, in *pb.TopUpRequest, opts ...grpc.CallOption) (*pb.Transaction, error) {
 	return nil, errors.New("not implemented")
 }
+func (m *mockFinanceClient) TransferBalance(ctx context.Context, in *pb.TransferRequest, opts ...grpc.CallOption) (*pb.Transaction, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) GetRevenueReport(ctx context.Context, in *pb.RevenueReportRequest, opts ...grpc.CallOption) (*pb.RevenueReport, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) GetPendingInvoiceReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.PendingInvoiceReport, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) GetWalletLiabilityReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.WalletLiabilityReport, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.JobList, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) TriggerJob(ctx context.Context, in *pb.TriggerJobRequest, opts ...grpc.CallOption) (*pb.JobRun, error) {
+	return nil, errors.New("not implemented")
+}
 func (m *mockFinanceClient) HandleWalletWebhook(ctx context.Context, in *pb.WebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, errors.New("not implemented")
 }
-func (m *mockFinanceClient) GetBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.BalanceResponse, error) {
+func (m *mockFinanceClient) GetBalance(ctx context.Context, in *pb.BalanceRequest, opts ...grpc.CallOption) (*pb.BalanceResponse, error) {
 	return nil, errors.New("not implemented")
 }
 func (m *mockFinanceClient) GetTransactionHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.TransactionList, error) {
 	return m.txList, m.txErr
 }
+func (m *mockFinanceClient) CancelPayment(ctx context.Context, in *pb.CancelPaymentRequest, opts ...grpc.CallOption) (*pb.Transaction, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) CreateBalanceHold(ctx context.Context, in *pb.CreateBalanceHoldRequest, opts ...grpc.CallOption) (*pb.BalanceHold, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) CaptureBalanceHold(ctx context.Context, in *pb.BalanceHoldRequest, opts ...grpc.CallOption) (*pb.BalanceHold, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) ReleaseBalanceHold(ctx context.Context, in *pb.BalanceHoldRequest, opts ...grpc.CallOption) (*pb.BalanceHold, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) ListBalanceHolds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.BalanceHoldList, error) {
+	return nil, errors.New("not implemented")
+}
 func (m *mockFinanceClient) CheckPaymentExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	return nil, errors.New("not implemented")
 }
+func (m *mockFinanceClient) GetReceipt(ctx context.Context, in *pb.ReceiptRequest, opts ...grpc.CallOption) (*pb.Document, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) GetStatement(ctx context.Context, in *pb.StatementRequest, opts ...grpc.CallOption) (*pb.Document, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) IssueDonationCertificate(ctx context.Context, in *pb.CertificateRequest, opts ...grpc.CallOption) (*pb.Document, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) VerifyDonationCertificate(ctx context.Context, in *pb.VerifyCertificateRequest, opts ...grpc.CallOption) (*pb.CertificateVerification, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) GetExchangeRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.ExchangeRateList, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) ReconcilePayments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.ReconciliationReport, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) ListReconciliationReports(ctx context.Context, in *pb.ListReconciliationReportsRequest, opts ...grpc.CallOption) (*pb.ReconciliationReportList, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) CreateVoucher(ctx context.Context, in *pb.Voucher, opts ...grpc.CallOption) (*pb.Voucher, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockFinanceClient) ListVouchers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.VoucherList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ListTopUpReviews(ctx context.Context, in *pb.ListTopUpReviewsRequest, opts ...grpc.CallOption) (*pb.TopUpReviewList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ApproveTopUpReview(ctx context.Context, in *pb.DecideTopUpReviewRequest, opts ...grpc.CallOption) (*pb.TopUpReview, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) RejectTopUpReview(ctx context.Context, in *pb.DecideTopUpReviewRequest, opts ...grpc.CallOption) (*pb.TopUpReview, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) RequestBalanceAdjustment(ctx context.Context, in *pb.BalanceAdjustmentRequest, opts ...grpc.CallOption) (*pb.BalanceAdjustment, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ApproveBalanceAdjustment(ctx context.Context, in *pb.DecideBalanceAdjustmentRequest, opts ...grpc.CallOption) (*pb.BalanceAdjustment, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) RejectBalanceAdjustment(ctx context.Context, in *pb.DecideBalanceAdjustmentRequest, opts ...grpc.CallOption) (*pb.BalanceAdjustment, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ListBalanceAdjustments(ctx context.Context, in *pb.ListBalanceAdjustmentsRequest, opts ...grpc.CallOption) (*pb.BalanceAdjustmentList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ExportJournal(ctx context.Context, in *pb.JournalExportRequest, opts ...grpc.CallOption) (*pb.Document, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) CreatePartner(ctx context.Context, in *pb.Partner, opts ...grpc.CallOption) (*pb.Partner, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) UpdatePartner(ctx context.Context, in *pb.Partner, opts ...grpc.CallOption) (*pb.Partner, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ListPartners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.PartnerList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) CalculatePayouts(ctx context.Context, in *pb.CalculatePayoutsRequest, opts ...grpc.CallOption) (*pb.PayoutList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ListPayouts(ctx context.Context, in *pb.ListPayoutsRequest, opts ...grpc.CallOption) (*pb.PayoutList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) UpdatePayoutStatus(ctx context.Context, in *pb.PayoutStatusRequest, opts ...grpc.CallOption) (*pb.Payout, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) GetPayoutStatement(ctx context.Context, in *pb.PayoutStatementRequest, opts ...grpc.CallOption) (*pb.Document, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) CreateMatchingCampaign(ctx context.Context, in *pb.MatchingCampaign, opts ...grpc.CallOption) (*pb.MatchingCampaign, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ListMatchingCampaigns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.MatchingCampaignList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) UpdateMatchingCampaignStatus(ctx context.Context, in *pb.MatchingCampaignStatusRequest, opts ...grpc.CallOption) (*pb.MatchingCampaign, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) ListCampaignMatches(ctx context.Context, in *pb.ListCampaignMatchesRequest, opts ...grpc.CallOption) (*pb.CampaignMatchList, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockFinanceClient) GetPaymentMethodReport(ctx context.Context, in *pb.PaymentMethodReportRequest, opts ...grpc.CallOption) (*pb.PaymentMethodReport, error) {
+	return nil, errors.New("not implemented")
+}
 
 func TestTreeService_AdoptTree_InvalidIDs(t *testing.T) {
 	repo := &mockTreeRepo{}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
 	_, _, _, err := svc.AdoptTree(context.Background(), &pb.AdoptTreeRequest{
 		SpeciesId: "bad",
@@ -213,8 +472,9 @@ func TestTreeService_AdoptTree_Success(t *testing.T) {
 
 	repo := &mockTreeRepo{
 		species: &models.Species{
-			ID:    speciesID,
-			Price: 2500,
+			ID:       speciesID,
+			Price:    2500,
+			Currency: "USD",
 		},
 		plot: &models.Plot{
 			ID: plotID,
@@ -227,7 +487,7 @@ func TestTreeService_AdoptTree_Success(t *testing.T) {
 			InvoiceId:  "inv-1",
 		},
 	}
-	svc := NewTreeManagementService(repo, mockFin, nil)
+	svc := NewTreeManagementService(repo, mockFin, nil, nil, nil)
 
 	intent, paymentURL, invoiceID, err := svc.AdoptTree(context.Background(), &pb.AdoptTreeRequest{
 		SpeciesId:  speciesID.Hex(),
@@ -247,6 +507,18 @@ func TestTreeService_AdoptTree_Success(t *testing.T) {
 	if paymentURL != "https://pay.example.com/1" || invoiceID != "inv-1" {
 		t.Fatalf("payment details not forwarded correctly")
 	}
+	if mockFin.txReq.Amount != 2500 || mockFin.txReq.Currency != "USD" {
+		t.Fatalf("species price not forwarded with its currency: %+v", mockFin.txReq)
+	}
+}
+
+func TestTreeService_CreateSpecies_UnsupportedCurrency(t *testing.T) {
+	svc := NewTreeManagementService(&mockTreeRepo{}, &mockFinanceClient{}, nil, nil, nil)
+
+	_, err := svc.CreateSpecies(context.Background(), &pb.Species{CommonName: "Oak", Price: 100, Currency: "XYZ"})
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for unsupported currency, got %v", err)
+	}
 }
 
 func TestTreeService_GetTree_ComputesAggregates(t *testing.T) {
@@ -270,7 +542,7 @@ func TestTreeService_GetTree_ComputesAggregates(t *testing.T) {
 			},
 		},
 	}
-	svc := NewTreeManagementService(repo, financeClient, nil)
+	svc := NewTreeManagementService(repo, financeClient, nil, nil, nil)
 
 	tree, err := svc.GetTree(context.Background(), treeID)
 	if err != nil {
@@ -292,9 +564,9 @@ func TestTreeService_ListTrees_UsesLogsHeight(t *testing.T) {
 			},
 		},
 	}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
-	trees, err := svc.ListTrees(context.Background())
+	trees, _, err := svc.ListTrees(context.Background(), &pb.ListTreesRequest{})
 	if err != nil {
 		t.Fatalf("ListTrees() error = %v", err)
 	}
@@ -306,9 +578,127 @@ func TestTreeService_ListTrees_UsesLogsHeight(t *testing.T) {
 	}
 }
 
+func TestTreeService_ListTrees_Filters(t *testing.T) {
+	plotID := primitive.NewObjectID()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := &mockTreeRepo{}
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
+
+	_, _, err := svc.ListTrees(context.Background(), &pb.ListTreesRequest{
+		PageSize:    500,
+		SponsorId:   "user-1",
+		PlotId:      plotID.Hex(),
+		AdoptedFrom: timestamppb.New(from),
+		SortBy:      "Custom_Name",
+		Descending:  true,
+	})
+	if err != nil {
+		t.Fatalf("ListTrees() error = %v", err)
+	}
+	if f := repo.treeFilter; f.SponsorID != "user-1" || f.PlotID != plotID || !f.AdoptedFrom.Equal(from) || !f.AdoptedTo.IsZero() {
+		t.Fatalf("unexpected filter %+v", f)
+	}
+	if o := repo.listOpts; o.PageSize != maxPageSize || o.SortBy != "custom_name" || !o.Descending {
+		t.Fatalf("unexpected options %+v", o)
+	}
+
+	for name, req := range map[string]*pb.ListTreesRequest{
+		"unknown sort":       {SortBy: "height"},
+		"negative page size": {PageSize: -1},
+		"malformed plot ID":  {PlotId: "plot-1"},
+		"empty date range":   {AdoptedFrom: timestamppb.New(from), AdoptedTo: timestamppb.New(from)},
+	} {
+		if _, _, err := svc.ListTrees(context.Background(), req); !errors.Is(err, models.ErrInvalidInput) {
+			t.Fatalf("%s: expected ErrInvalidInput, got %v", name, err)
+		}
+	}
+}
+
+func TestTreeService_ListMyTrees(t *testing.T) {
+	planted := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	species := &models.Species{ID: primitive.NewObjectID(), CommonName: "Mahoni"}
+	plot := &models.Plot{ID: primitive.NewObjectID(), LocationName: "Bogor"}
+	tree := &models.Tree{ID: primitive.NewObjectID(), SponsorID: "user-1", SpeciesID: species.ID, PlotID: plot.ID, LastCareDate: planted}
+	repo := &mockTreeRepo{
+		species:       species,
+		plot:          plot,
+		listTreesResp: []*models.Tree{tree},
+		logsByTree: map[primitive.ObjectID][]*models.LogEntry{
+			tree.ID: {
+				{CurrentHeightMeters: 1.4, RecordedAt: planted.AddDate(0, 2, 0)},
+				{CurrentHeightMeters: 1.2, RecordedAt: planted.AddDate(0, 3, 0)}, // pruned
+				{CurrentHeightMeters: 0.8, RecordedAt: planted.AddDate(0, 1, 0)},
+			},
+		},
+		pendingIntents: []*models.AdoptionIntent{
+			{ID: primitive.NewObjectID(), SponsorID: "user-1", Status: "PENDING"},
+			{ID: primitive.NewObjectID(), SponsorID: "user-2", Status: "PENDING"},
+		},
+	}
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
+
+	portfolio, err := svc.ListMyTrees(context.Background(), "user-1", &pb.ListMyTreesRequest{})
+	if err != nil {
+		t.Fatalf("ListMyTrees() error = %v", err)
+	}
+	if repo.treeFilter.SponsorID != "user-1" {
+		t.Fatalf("trees should be filtered on the sponsor, got %+v", repo.treeFilter)
+	}
+	if len(portfolio.Trees) != 1 {
+		t.Fatalf("expected one tree, got %+v", portfolio.Trees)
+	}
+	got := portfolio.Trees[0]
+	if got.SpeciesName != "Mahoni" || got.PlotName != "Bogor" {
+		t.Fatalf("expected species and plot names, got %+v", got)
+	}
+	if got.Tree.CurrentHeightMeters != 1.2 || !got.Tree.LastCareDate.Equal(planted.AddDate(0, 3, 0)) {
+		t.Fatalf("expected the latest log's height and date, got %v, %v", got.Tree.CurrentHeightMeters, got.Tree.LastCareDate)
+	}
+	if len(portfolio.PendingIntents) != 1 || portfolio.PendingIntents[0].SponsorID != "user-1" {
+		t.Fatalf("expected the sponsor's pending intent only, got %+v", portfolio.PendingIntents)
+	}
+}
+
+func TestTreeService_ListSpecies_Defaults(t *testing.T) {
+	repo := &mockTreeRepo{}
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
+
+	if _, _, err := svc.ListSpecies(context.Background(), &pb.ListSpeciesRequest{}); err != nil {
+		t.Fatalf("ListSpecies() error = %v", err)
+	}
+	if o := repo.listOpts; o.PageSize != defaultPageSize || o.SortBy != "common_name" || o.Descending {
+		t.Fatalf("unexpected options %+v", o)
+	}
+	if _, _, err := svc.ListSpecies(context.Background(), &pb.ListSpeciesRequest{MinPrice: 200, MaxPrice: 100}); !errors.Is(err, models.ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for an inverted price range, got %v", err)
+	}
+}
+
+func TestTreeService_ListAdoptionIntents_SkipsMalformedIDs(t *testing.T) {
+	intent := &models.AdoptionIntent{ID: primitive.NewObjectID()}
+	repo := &mockTreeRepo{intent: intent}
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
+
+	intents, err := svc.ListAdoptionIntents(context.Background(), []string{intent.ID.Hex(), "not-an-id", ""})
+	if err != nil {
+		t.Fatalf("ListAdoptionIntents() error = %v", err)
+	}
+	if len(repo.intentIDs) != 1 || repo.intentIDs[0] != intent.ID {
+		t.Fatalf("expected only the valid ID looked up, got %v", repo.intentIDs)
+	}
+	if len(intents) != 1 {
+		t.Fatalf("expected 1 intent, got %d", len(intents))
+	}
+
+	repo.intentIDs = nil
+	if intents, err := svc.ListAdoptionIntents(context.Background(), []string{"deposit-ref"}); err != nil || intents != nil || repo.intentIDs != nil {
+		t.Fatalf("no valid IDs should skip the lookup")
+	}
+}
+
 func TestTreeService_UpdateTree_InvalidIDs(t *testing.T) {
 	repo := &mockTreeRepo{}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
 	_, err := svc.UpdateTree(context.Background(), primitive.NewObjectID(), &pb.Tree{
 		SpeciesId: "bad",
@@ -321,7 +711,7 @@ func TestTreeService_UpdateTree_InvalidIDs(t *testing.T) {
 
 func TestTreeService_CreateSpecies_CRUD(t *testing.T) {
 	repo := &mockTreeRepo{}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
 	created, err := svc.CreateSpecies(context.Background(), &pb.Species{
 		CommonName:      "Oak",
@@ -334,9 +724,12 @@ func TestTreeService_CreateSpecies_CRUD(t *testing.T) {
 	if created.ID.IsZero() || repo.species.CommonName != "Oak" {
 		t.Fatalf("species not created correctly")
 	}
+	if created.Currency != "IDR" {
+		t.Fatalf("species without currency should default to IDR, got %q", created.Currency)
+	}
 
 	repo.speciesList = []*models.Species{created}
-	list, err := svc.ListSpecies(context.Background())
+	list, _, err := svc.ListSpecies(context.Background(), &pb.ListSpeciesRequest{})
 	if err != nil || len(list) != 1 {
 		t.Fatalf("ListSpecies failed")
 	}
@@ -368,7 +761,7 @@ func TestTreeService_CreateSpecies_CRUD(t *testing.T) {
 
 func TestTreeService_Plot_CRUD(t *testing.T) {
 	repo := &mockTreeRepo{}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
 	p, err := svc.CreatePlot(context.Background(), &pb.Plot{
 		LocationName:     "Loc",
@@ -380,7 +773,7 @@ func TestTreeService_Plot_CRUD(t *testing.T) {
 	}
 	repo.plots = []*models.Plot{p}
 
-	plist, _ := svc.ListPlots(context.Background())
+	plist, _, _ := svc.ListPlots(context.Background(), &pb.ListPlotsRequest{})
 	if len(plist) != 1 || plist[0].LocationName != "Loc" {
 		t.Fatalf("ListPlots failed")
 	}
@@ -409,7 +802,7 @@ func TestTreeService_Plot_CRUD(t *testing.T) {
 
 func TestTreeService_UpdateTree_Success(t *testing.T) {
 	repo := &mockTreeRepo{}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
 	id := primitive.NewObjectID()
 	speciesID := primitive.NewObjectID()
@@ -435,7 +828,7 @@ func TestTreeService_UpdateTree_Success(t *testing.T) {
 
 func TestTreeService_DeleteTree(t *testing.T) {
 	repo := &mockTreeRepo{}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 	id := primitive.NewObjectID()
 	if err := svc.DeleteTree(context.Background(), id); err != nil {
 		t.Fatalf("DeleteTree error: %v", err)
@@ -447,7 +840,7 @@ func TestTreeService_DeleteTree(t *testing.T) {
 
 func TestTreeService_CreateLog_SuccessAndInvalid(t *testing.T) {
 	repo := &mockTreeRepo{}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
 	// invalid id
 	if _, err := svc.CreateLog(context.Background(), &pb.CreateLogRequest{AdoptedTreeId: "bad"}, "admin"); err != models.ErrInvalidInput {
@@ -478,7 +871,7 @@ func TestTreeService_GetLogs_UpdateLog_DeleteLog(t *testing.T) {
 			},
 		},
 	}
-	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil)
+	svc := NewTreeManagementService(repo, &mockFinanceClient{}, nil, nil, nil)
 
 	logs, err := svc.GetLogsByTreeID(context.Background(), treeID)
 	if err != nil || len(logs) != 1 {
@@ -510,3 +903,107 @@ func TestTreeService_GetLogs_UpdateLog_DeleteLog(t *testing.T) {
 		t.Fatalf("DeleteLog id mismatch")
 	}
 }
+
+func TestTreeService_HandlePaymentSuccess(t *testing.T) {
+	intent := &models.AdoptionIntent{
+		ID:        primitive.NewObjectID(),
+		SponsorID: "sponsor-1",
+		SpeciesID: primitive.NewObjectID(),
+		PlotID:    primitive.NewObjectID(),
+		Status:    "PENDING",
+	}
+	repo := &mockTreeRepo{intent: intent}
+	svc := NewTreeManagementService(repo, nil, nil, nil, nil).(*treeManagementService)
+
+	payload := []byte(`{"reference_id":"` + intent.ID.Hex() + `"}`)
+	if err := svc.handlePaymentSuccess(context.Background(), payload); err != nil {
+		t.Fatalf("handlePaymentSuccess() error = %v", err)
+	}
+
+	if repo.createdTree == nil || repo.createdTree.ID != intent.ID || repo.createdTree.SponsorID != "sponsor-1" {
+		t.Fatalf("expected tree planted for the intent, got %+v", repo.createdTree)
+	}
+	if intent.Status != "COMPLETED" {
+		t.Fatalf("expected intent COMPLETED, got %s", intent.Status)
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "tree.adopted" {
+		t.Fatalf("expected tree.adopted in the outbox, got %+v", repo.events)
+	}
+	if got := repo.events[0].payload.(map[string]string)["tree_id"]; got != intent.ID.Hex() {
+		t.Fatalf("unexpected tree_id %s", got)
+	}
+
+	// A redelivered event must not plant a second tree.
+	repo.createdTree = nil
+	if err := svc.handlePaymentSuccess(context.Background(), payload); err != nil {
+		t.Fatalf("redelivery error = %v", err)
+	}
+	if repo.createdTree != nil || len(repo.events) != 1 {
+		t.Fatalf("redelivered event should be ignored")
+	}
+}
+
+func TestTreeService_HandleAdoptionMatched(t *testing.T) {
+	species := &models.Species{ID: primitive.NewObjectID(), SpaceRequiredM2: 4}
+	full := &models.Plot{ID: primitive.NewObjectID(), AvailableSpaceM2: 4}
+	roomy := &models.Plot{ID: primitive.NewObjectID(), AvailableSpaceM2: 20}
+	intent := &models.AdoptionIntent{
+		ID:        primitive.NewObjectID(),
+		SponsorID: "employee-1",
+		SpeciesID: species.ID,
+		PlotID:    full.ID,
+		Status:    "COMPLETED",
+	}
+	repo := &mockTreeRepo{intent: intent, species: species, plot: full, plots: []*models.Plot{full, roomy}}
+	svc := NewTreeManagementService(repo, nil, nil, nil, nil).(*treeManagementService)
+
+	payload := []byte(`{"reference_id":"` + intent.ID.Hex() + `","match_id":"match-1","organization":"Acme","trees":2}`)
+	for i := 0; i < 2; i++ {
+		if err := svc.handleAdoptionMatched(context.Background(), payload); err != nil {
+			t.Fatalf("handleAdoptionMatched() error = %v", err)
+		}
+	}
+
+	if repo.matchedTrees != 2 {
+		t.Fatalf("expected two matched trees planted once, got %d", repo.matchedTrees)
+	}
+	if tree := repo.createdTree; tree.SponsorID != "employee-1" || tree.PlotID != roomy.ID || tree.SpeciesID != species.ID {
+		t.Fatalf("matched trees should go to the employee in a plot with room, got %+v", tree)
+	}
+	if full.AvailableSpaceM2 != 4 || roomy.AvailableSpaceM2 != 12 {
+		t.Fatalf("space should be taken from the roomy plot, got %v and %v", full.AvailableSpaceM2, roomy.AvailableSpaceM2)
+	}
+	if len(repo.events) != 2 || repo.events[0].payload.(map[string]string)["match_id"] != "match-1" {
+		t.Fatalf("expected a tree.adopted event per matched tree, got %+v", repo.events)
+	}
+}
+
+func TestTreeService_HandlePaymentExpired_ReleasesSpace(t *testing.T) {
+	species := &models.Species{ID: primitive.NewObjectID(), SpaceRequiredM2: 4}
+	plot := &models.Plot{ID: primitive.NewObjectID(), AvailableSpaceM2: 10}
+	intent := &models.AdoptionIntent{
+		ID:        primitive.NewObjectID(),
+		SpeciesID: species.ID,
+		PlotID:    plot.ID,
+		Status:    "PENDING",
+	}
+	repo := &mockTreeRepo{intent: intent, species: species, plot: plot}
+	svc := NewTreeManagementService(repo, nil, nil, nil, nil).(*treeManagementService)
+
+	payload := []byte(`{"reference_id":"` + intent.ID.Hex() + `"}`)
+	for i := 0; i < 2; i++ {
+		if err := svc.handlePaymentExpired(context.Background(), payload); err != nil {
+			t.Fatalf("handlePaymentExpired() error = %v", err)
+		}
+	}
+
+	if plot.AvailableSpaceM2 != 14 {
+		t.Fatalf("space should be released exactly once, got %v", plot.AvailableSpaceM2)
+	}
+	if intent.Status != "EXPIRED" {
+		t.Fatalf("expected intent EXPIRED, got %s", intent.Status)
+	}
+	if len(repo.events) != 1 || repo.events[0].routingKey != "adoption.expired" {
+		t.Fatalf("expected one adoption.expired event, got %+v", repo.events)
+	}
+}
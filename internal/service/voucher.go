@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/internal/repository"
+	"reforest/pkg/money"
+)
+
+const (
+	voucherPercentage = "PERCENTAGE"
+	voucherFixed      = "FIXED"
+)
+
+var voucherCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]{2,31}$`)
+
+func normalizeVoucherCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (s *financeService) CreateVoucher(ctx context.Context, v *models.Voucher) (*models.Voucher, error) {
+	v.Code = normalizeVoucherCode(v.Code)
+	if !voucherCodePattern.MatchString(v.Code) {
+		return nil, fmt.Errorf("%w: voucher code must be 3-32 letters, digits, '-' or '_'", models.ErrInvalidInput)
+	}
+
+	switch v.DiscountType {
+	case voucherPercentage:
+		if v.Value < 1 || v.Value > 100 {
+			return nil, fmt.Errorf("%w: percentage must be between 1 and 100", models.ErrInvalidInput)
+		}
+		v.Currency = ""
+	case voucherFixed:
+		if v.Value <= 0 {
+			return nil, fmt.Errorf("%w: fixed discount must be positive", models.ErrInvalidInput)
+		}
+		currency, err := money.Normalize(v.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+		}
+		v.Currency = currency
+	default:
+		return nil, fmt.Errorf("%w: discount type must be PERCENTAGE or FIXED", models.ErrInvalidInput)
+	}
+
+	switch v.TransactionType {
+	case "", "ADOPT":
+	case "DEPOSIT":
+		if v.SpeciesID != "" || v.PlotID != "" {
+			return nil, fmt.Errorf("%w: species and plot restrictions only apply to adoptions", models.ErrInvalidInput)
+		}
+	default:
+		return nil, fmt.Errorf("%w: transaction type must be ADOPT or DEPOSIT", models.ErrInvalidInput)
+	}
+
+	if v.MaxRedemptions < 0 || v.MaxPerUser < 0 {
+		return nil, fmt.Errorf("%w: usage limits cannot be negative", models.ErrInvalidInput)
+	}
+	if v.ValidFrom != nil && v.ValidUntil != nil && !v.ValidUntil.After(*v.ValidFrom) {
+		return nil, fmt.Errorf("%w: voucher must end after it starts", models.ErrInvalidInput)
+	}
+
+	v.RedemptionCount = 0
+	if err := s.repo.CreateVoucher(ctx, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (s *financeService) ListVouchers(ctx context.Context) ([]models.Voucher, error) {
+	return s.repo.ListVouchers(ctx)
+}
+
+// applyVoucher checks a voucher against a priced checkout and takes the
+// discount off tx.Amount. The use is only claimed when the transaction is
+// saved, see saveTransaction.
+func (s *financeService) applyVoucher(ctx context.Context, tx *models.Transaction, code, speciesID, plotID string) (*models.Voucher, error) {
+	v, err := s.repo.GetVoucherByCode(ctx, normalizeVoucherCode(code))
+	if errors.Is(err, models.ErrNotFound) {
+		return nil, fmt.Errorf("%w: unknown voucher code %s", models.ErrVoucherUnavailable, code)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch {
+	case v.ValidFrom != nil && now.Before(*v.ValidFrom):
+		return nil, fmt.Errorf("%w: voucher %s is not valid yet", models.ErrVoucherUnavailable, v.Code)
+	case v.ValidUntil != nil && !now.Before(*v.ValidUntil):
+		return nil, fmt.Errorf("%w: voucher %s has expired", models.ErrVoucherUnavailable, v.Code)
+	case v.TransactionType != "" && v.TransactionType != tx.Type:
+		return nil, fmt.Errorf("%w: voucher %s cannot be used for %s", models.ErrVoucherUnavailable, v.Code, tx.Type)
+	case v.SpeciesID != "" && v.SpeciesID != speciesID:
+		return nil, fmt.Errorf("%w: voucher %s is not valid for this species", models.ErrVoucherUnavailable, v.Code)
+	case v.PlotID != "" && v.PlotID != plotID:
+		return nil, fmt.Errorf("%w: voucher %s is not valid for this plot", models.ErrVoucherUnavailable, v.Code)
+	case v.MaxRedemptions > 0 && v.RedemptionCount >= v.MaxRedemptions:
+		return nil, fmt.Errorf("%w: voucher %s has been fully redeemed", models.ErrVoucherUnavailable, v.Code)
+	}
+
+	var discount int64
+	switch v.DiscountType {
+	case voucherPercentage:
+		discount = tx.Amount * v.Value / 100
+	case voucherFixed:
+		if v.Currency != tx.Currency {
+			return nil, fmt.Errorf("%w: voucher %s is in %s, checkout is in %s", models.ErrVoucherUnavailable, v.Code, v.Currency, tx.Currency)
+		}
+		discount = v.Value
+		if discount > tx.Amount {
+			discount = tx.Amount
+		}
+	}
+	if tx.Type == "DEPOSIT" && discount >= tx.Amount {
+		return nil, fmt.Errorf("%w: voucher %s cannot cover a whole top up", models.ErrVoucherUnavailable, v.Code)
+	}
+
+	tx.Amount -= discount
+	tx.DiscountAmount = discount
+	tx.VoucherCode = v.Code
+	return v, nil
+}
+
+// saveTransaction stores tx and, when a voucher was applied to it, the
+// redemption. Callers run it inside WithTransaction so a used-up voucher
+// leaves no transaction behind.
+func saveTransaction(ctx context.Context, repo repository.FinanceRepository, tx *models.Transaction, voucher *models.Voucher) error {
+	if err := repo.CreateTransaction(ctx, tx); err != nil {
+		return err
+	}
+	if voucher == nil {
+		return nil
+	}
+	return repo.RedeemVoucher(ctx, &models.VoucherRedemption{
+		VoucherID:      voucher.ID,
+		UserID:         tx.UserID,
+		TransactionID:  tx.ID,
+		DiscountAmount: tx.DiscountAmount,
+		Currency:       tx.Currency,
+		Status:         "APPLIED",
+	}, voucher.MaxPerUser)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"reforest/internal/models"
+	"reforest/pkg/pb"
+
+	"github.com/google/uuid"
+)
+
+func TestFinanceService_CreateVoucher(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		voucher models.Voucher
+		wantErr bool
+	}{
+		{"percentage", models.Voucher{Code: " earth-day ", DiscountType: "PERCENTAGE", Value: 20}, false},
+		{"fixed", models.Voucher{Code: "HEMAT10K", DiscountType: "FIXED", Value: 10000, TransactionType: "ADOPT", SpeciesID: "abc"}, false},
+		{"short code", models.Voucher{Code: "AB", DiscountType: "PERCENTAGE", Value: 20}, true},
+		{"percentage over 100", models.Voucher{Code: "FREE", DiscountType: "PERCENTAGE", Value: 101}, true},
+		{"unknown type", models.Voucher{Code: "FREE", DiscountType: "BOGO", Value: 1}, true},
+		{"unknown currency", models.Voucher{Code: "FREE", DiscountType: "FIXED", Value: 1, Currency: "XYZ"}, true},
+		{"plot restriction on deposit", models.Voucher{Code: "FREE", DiscountType: "FIXED", Value: 1, TransactionType: "DEPOSIT", PlotID: "abc"}, true},
+		{"ends before it starts", models.Voucher{Code: "FREE", DiscountType: "FIXED", Value: 1, ValidFrom: &later, ValidUntil: &now}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockFinanceRepo{}
+			svc := NewFinanceService(repo, "key", nil, nil)
+
+			v := tt.voucher
+			_, err := svc.CreateVoucher(context.Background(), &v)
+			if tt.wantErr {
+				if !errors.Is(err, models.ErrInvalidInput) {
+					t.Fatalf("expected invalid input, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateVoucher() error = %v", err)
+			}
+			if repo.createdVoucher == nil || repo.createdVoucher.Code != strings.ToUpper(strings.TrimSpace(tt.voucher.Code)) {
+				t.Fatalf("expected normalized code stored, got %+v", repo.createdVoucher)
+			}
+			if v.DiscountType == "FIXED" && v.Currency != "IDR" {
+				t.Fatalf("fixed voucher should default to IDR, got %q", v.Currency)
+			}
+		})
+	}
+}
+
+func TestFinanceService_CreateTransaction_AdoptWithVoucherFromWallet(t *testing.T) {
+	voucher := &models.Voucher{ID: uuid.New(), Code: "EARTHDAY", DiscountType: "PERCENTAGE", Value: 20, MaxPerUser: 1}
+	repo := &mockFinanceRepo{getBalanceValue: 100000, voucher: voucher}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	tx, err := svc.CreateTransaction(context.Background(), &pb.TransactionRequest{
+		UserId:      uuid.New().String(),
+		Amount:      50000,
+		Type:        pb.TransactionType_ADOPT,
+		ReferenceId: "ref-1",
+		VoucherCode: "earthday",
+	})
+	if err != nil {
+		t.Fatalf("CreateTransaction() error = %v", err)
+	}
+
+	if tx.Amount != 40000 || tx.DiscountAmount != 10000 || tx.VoucherCode != "EARTHDAY" {
+		t.Fatalf("expected 20%% off, got amount %d discount %d code %s", tx.Amount, tx.DiscountAmount, tx.VoucherCode)
+	}
+	if repo.debitAmount != 40000 {
+		t.Fatalf("wallet should be charged the discounted price, got %d", repo.debitAmount)
+	}
+	r := repo.redemption
+	if r == nil || r.VoucherID != voucher.ID || r.TransactionID != tx.ID || r.DiscountAmount != 10000 || r.Status != "APPLIED" {
+		t.Fatalf("expected redemption recorded against the transaction, got %+v", r)
+	}
+}
+
+func TestFinanceService_TopUpWallet_WithVoucher(t *testing.T) {
+	voucher := &models.Voucher{ID: uuid.New(), Code: "BONUS5K", DiscountType: "FIXED", Value: 5000, Currency: "IDR", TransactionType: "DEPOSIT"}
+	repo := &mockFinanceRepo{voucher: voucher}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	var invoiced float64
+	origTransport := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		var body xenditInvoiceRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode invoice request: %v", err)
+		}
+		invoiced = body.Amount
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`{"invoice_url":"https://pay.xendit.co/abc","status":"PENDING"}`)),
+			Header:     make(http.Header),
+		}, nil
+	})
+	defer func() { http.DefaultTransport = origTransport }()
+
+	tx, err := svc.TopUpWallet(context.Background(), uuid.New(), 50000, "", 3600, "BONUS5K", models.PaymentMethod{})
+	if err != nil {
+		t.Fatalf("TopUpWallet() error = %v", err)
+	}
+	if invoiced != 45000 || tx.Payment.Amount != 45000 {
+		t.Fatalf("expected invoice for 45000, got %v", invoiced)
+	}
+	if repo.redemption == nil || repo.redemption.TransactionID != tx.ID {
+		t.Fatalf("expected redemption recorded")
+	}
+
+	// Settling the invoice credits the full top up.
+	repo.balanceDelta = 0
+	if err := svc.(*financeService).markPaid(context.Background(), tx); err != nil {
+		t.Fatalf("markPaid() error = %v", err)
+	}
+	if repo.balanceDelta != 50000 {
+		t.Fatalf("expected 50000 credited, got %d", repo.balanceDelta)
+	}
+}
+
+func TestFinanceService_ApplyVoucher_Rejected(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		voucher models.Voucher
+		txType  string
+		amount  int64
+	}{
+		{"expired", models.Voucher{DiscountType: "PERCENTAGE", Value: 10, ValidUntil: &past}, "ADOPT", 50000},
+		{"not started", models.Voucher{DiscountType: "PERCENTAGE", Value: 10, ValidFrom: &future}, "ADOPT", 50000},
+		{"wrong type", models.Voucher{DiscountType: "PERCENTAGE", Value: 10, TransactionType: "DEPOSIT"}, "ADOPT", 50000},
+		{"other species", models.Voucher{DiscountType: "PERCENTAGE", Value: 10, SpeciesID: "mahogany"}, "ADOPT", 50000},
+		{"other plot", models.Voucher{DiscountType: "PERCENTAGE", Value: 10, PlotID: "borneo-1"}, "ADOPT", 50000},
+		{"used up", models.Voucher{DiscountType: "PERCENTAGE", Value: 10, MaxRedemptions: 5, RedemptionCount: 5}, "ADOPT", 50000},
+		{"other currency", models.Voucher{DiscountType: "FIXED", Value: 500, Currency: "USD"}, "ADOPT", 50000},
+		{"whole top up", models.Voucher{DiscountType: "FIXED", Value: 50000, Currency: "IDR"}, "DEPOSIT", 50000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.voucher
+			v.Code = "PROMO"
+			svc := NewFinanceService(&mockFinanceRepo{voucher: &v}, "key", nil, nil).(*financeService)
+			tx := &models.Transaction{Amount: tt.amount, Currency: "IDR", Type: tt.txType}
+
+			if _, err := svc.applyVoucher(context.Background(), tx, "PROMO", "meranti", "sumatra-2"); !errors.Is(err, models.ErrVoucherUnavailable) {
+				t.Fatalf("expected voucher unavailable, got %v", err)
+			}
+			if tx.Amount != tt.amount || tx.VoucherCode != "" {
+				t.Fatalf("rejected voucher must not change the transaction")
+			}
+		})
+	}
+
+	svc := NewFinanceService(&mockFinanceRepo{}, "key", nil, nil).(*financeService)
+	if _, err := svc.applyVoucher(context.Background(), &models.Transaction{Amount: 1}, "NOPE", "", ""); !errors.Is(err, models.ErrVoucherUnavailable) {
+		t.Fatalf("unknown code should be unavailable, got %v", err)
+	}
+}
+
+func TestFinanceService_ExpireTransaction_ReleasesVoucher(t *testing.T) {
+	tx := models.Transaction{ID: uuid.New(), Type: "DEPOSIT", VoucherCode: "BONUS5K", Payment: models.Payment{Status: "PENDING"}}
+	repo := &mockFinanceRepo{pendingBefore: []models.Transaction{tx}}
+	svc := NewFinanceService(repo, "key", nil, nil)
+
+	if err := svc.CheckPaymentExpiry(context.Background()); err != nil {
+		t.Fatalf("CheckPaymentExpiry() error = %v", err)
+	}
+	if repo.releasedTxID != tx.ID {
+		t.Fatalf("expected voucher use released for %s", tx.ID)
+	}
+}
@@ -9,8 +9,15 @@ import (
 	"gorm.io/gorm"
 )
 
+// GormConfig is the configuration every connection is opened with. Driver
+// errors are translated, so repositories can check for gorm.ErrDuplicatedKey
+// rather than Postgres error codes.
+func GormConfig() *gorm.Config {
+	return &gorm.Config{TranslateError: true}
+}
+
 func NewConnection(dsn string) *gorm.DB {
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), GormConfig())
 	if err != nil {
 		log.Fatalf("failed to connect database: %v", err)
 	}
@@ -0,0 +1,217 @@
+// Package money holds the currency catalogue, minor-unit arithmetic and the
+// exchange-rate table used for display and checkout conversion.
+//
+// Amounts are always int64 minor units of their currency. IDR and VND are
+// settled in whole units by Xendit and local banks, so their minor unit is the
+// currency unit itself.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const DefaultCurrency = "IDR"
+
+var (
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrNoExchangeRate      = errors.New("no exchange rate configured")
+)
+
+// exponents lists the currencies Xendit invoices can be issued in, with the
+// number of decimal places of their minor unit.
+var exponents = map[string]int{
+	"IDR": 0,
+	"VND": 0,
+	"USD": 2,
+	"SGD": 2,
+	"MYR": 2,
+	"PHP": 2,
+	"THB": 2,
+}
+
+// Normalize upper-cases and validates a currency code. An empty code means
+// DefaultCurrency.
+func Normalize(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+	if _, ok := exponents[code]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedCurrency, code)
+	}
+	return code, nil
+}
+
+// Exponent returns the number of minor-unit decimal places of a currency.
+func Exponent(code string) int {
+	return exponents[code]
+}
+
+// ToMajor converts minor units into the decimal amount payment providers
+// expect, e.g. 1050 USD cents -> 10.50.
+func ToMajor(amount int64, code string) float64 {
+	f, _ := new(big.Rat).SetFrac(big.NewInt(amount), pow10(Exponent(code))).Float64()
+	return f
+}
+
+// ToMinor converts a decimal amount reported by a payment provider back into
+// minor units, rounding half away from zero, e.g. 10.125 USD -> 1013.
+func ToMinor(amount float64, code string) int64 {
+	r := new(big.Rat)
+	if r.SetFloat64(amount) == nil {
+		return 0
+	}
+	return roundHalfAway(r.Mul(r, new(big.Rat).SetInt(pow10(Exponent(code)))))
+}
+
+// Decimal renders an amount as a plain decimal for machine-readable exports,
+// e.g. "-10.50" for -1050 USD cents or "150000" for IDR.
+func Decimal(amount int64, code string) string {
+	return new(big.Rat).SetFrac(big.NewInt(amount), pow10(Exponent(code))).FloatString(Exponent(code))
+}
+
+// Format renders an amount using Indonesian separators, e.g. "IDR 1.234.567"
+// or "USD 1.234,50".
+func Format(amount int64, code string) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := strconv.FormatInt(amount, 10)
+	exp := Exponent(code)
+	fraction := ""
+	if exp > 0 {
+		if len(digits) <= exp {
+			digits = strings.Repeat("0", exp-len(digits)+1) + digits
+		}
+		fraction = "," + digits[len(digits)-exp:]
+		digits = digits[:len(digits)-exp]
+	}
+
+	var parts []string
+	for len(digits) > 3 {
+		parts = append([]string{digits[len(digits)-3:]}, parts...)
+		digits = digits[:len(digits)-3]
+	}
+	parts = append([]string{digits}, parts...)
+	return code + " " + sign + strings.Join(parts, ".") + fraction
+}
+
+// Rate is the price of one major unit of Currency in the base currency.
+type Rate struct {
+	Currency string
+	Value    string
+}
+
+// Rates is a fixed exchange-rate table quoted against a base currency.
+type Rates struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// ParseRates reads a table such as "USD=16250,SGD=12100.5", where every value
+// is the price of one major unit in the base currency.
+func ParseRates(base, spec string) (*Rates, error) {
+	base, err := Normalize(base)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Rates{base: base, rates: map[string]*big.Rat{base: big.NewRat(1, 1)}}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		code, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid exchange rate entry %q", entry)
+		}
+		code, err := Normalize(code)
+		if err != nil {
+			return nil, err
+		}
+		rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid exchange rate for %s: %q", code, value)
+		}
+		r.rates[code] = rate
+	}
+	return r, nil
+}
+
+func (r *Rates) Base() string {
+	return r.base
+}
+
+// List returns the table sorted by currency code, excluding the base.
+func (r *Rates) List() []Rate {
+	list := make([]Rate, 0, len(r.rates))
+	for code, rate := range r.rates {
+		if code == r.base {
+			continue
+		}
+		list = append(list, Rate{Currency: code, Value: trimRat(rate)})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Currency < list[j].Currency })
+	return list
+}
+
+// Convert converts minor units of from into minor units of to, rounding half
+// away from zero. It also returns the applied major-unit rate as a decimal
+// string so it can be recorded alongside the converted amount.
+func (r *Rates) Convert(amount int64, from, to string) (int64, string, error) {
+	if from == to {
+		return amount, "1", nil
+	}
+	if r == nil {
+		return 0, "", fmt.Errorf("%w: %s to %s", ErrNoExchangeRate, from, to)
+	}
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return 0, "", fmt.Errorf("%w: %s", ErrNoExchangeRate, from)
+	}
+	toRate, ok := r.rates[to]
+	if !ok {
+		return 0, "", fmt.Errorf("%w: %s", ErrNoExchangeRate, to)
+	}
+
+	rate := new(big.Rat).Quo(fromRate, toRate)
+
+	// minor(from) / 10^exp(from) * rate * 10^exp(to)
+	v := new(big.Rat).SetInt64(amount)
+	v.Mul(v, rate)
+	v.Mul(v, new(big.Rat).SetFrac(pow10(Exponent(to)), pow10(Exponent(from))))
+
+	return roundHalfAway(v), trimRat(rate), nil
+}
+
+func roundHalfAway(v *big.Rat) int64 {
+	num := new(big.Int).Abs(v.Num())
+	den := v.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Mul(rem, big.NewInt(2)).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if v.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
+
+func trimRat(r *big.Rat) string {
+	s := r.FloatString(8)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
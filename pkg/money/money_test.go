@@ -0,0 +1,129 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	if got, err := Normalize(""); err != nil || got != "IDR" {
+		t.Fatalf("Normalize(\"\") = %q, %v; want IDR", got, err)
+	}
+	if got, err := Normalize(" usd "); err != nil || got != "USD" {
+		t.Fatalf("Normalize(usd) = %q, %v; want USD", got, err)
+	}
+	if _, err := Normalize("XYZ"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Fatalf("expected ErrUnsupportedCurrency, got %v", err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		amount int64
+		code   string
+		want   string
+	}{
+		{0, "IDR", "IDR 0"},
+		{1234567, "IDR", "IDR 1.234.567"},
+		{-250000, "IDR", "IDR -250.000"},
+		{5, "USD", "USD 0,05"},
+		{123450, "USD", "USD 1.234,50"},
+	}
+	for _, c := range cases {
+		if got := Format(c.amount, c.code); got != c.want {
+			t.Fatalf("Format(%d, %s) = %q, want %q", c.amount, c.code, got, c.want)
+		}
+	}
+}
+
+func TestToMajor(t *testing.T) {
+	if got := ToMajor(1050, "USD"); got != 10.5 {
+		t.Fatalf("ToMajor(1050, USD) = %v, want 10.5", got)
+	}
+	if got := ToMajor(150000, "IDR"); got != 150000 {
+		t.Fatalf("ToMajor(150000, IDR) = %v, want 150000", got)
+	}
+}
+
+func TestToMinor(t *testing.T) {
+	if got := ToMinor(10.125, "USD"); got != 1013 {
+		t.Fatalf("ToMinor(10.125, USD) = %v, want 1013", got)
+	}
+	if got := ToMinor(4995, "IDR"); got != 4995 {
+		t.Fatalf("ToMinor(4995, IDR) = %v, want 4995", got)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	if got := Decimal(-1050, "USD"); got != "-10.50" {
+		t.Fatalf("Decimal(-1050, USD) = %q, want -10.50", got)
+	}
+	if got := Decimal(150000, "IDR"); got != "150000" {
+		t.Fatalf("Decimal(150000, IDR) = %q, want 150000", got)
+	}
+}
+
+func TestRates_Convert(t *testing.T) {
+	rates, err := ParseRates("IDR", "USD=16000, SGD=12000")
+	if err != nil {
+		t.Fatalf("ParseRates() error = %v", err)
+	}
+
+	// 10.00 USD -> IDR
+	got, rate, err := rates.Convert(1000, "USD", "IDR")
+	if err != nil || got != 160000 || rate != "16000" {
+		t.Fatalf("Convert(USD->IDR) = %d, %s, %v", got, rate, err)
+	}
+
+	// 100.000 IDR -> USD cents, 6.25
+	got, _, err = rates.Convert(100000, "IDR", "USD")
+	if err != nil || got != 625 {
+		t.Fatalf("Convert(IDR->USD) = %d, %v; want 625", got, err)
+	}
+
+	// cross rate through the base: 1 SGD = 0.75 USD
+	got, rate, err = rates.Convert(100, "SGD", "USD")
+	if err != nil || got != 75 || rate != "0.75" {
+		t.Fatalf("Convert(SGD->USD) = %d, %s, %v", got, rate, err)
+	}
+
+	// rounding half away from zero: 1 IDR = 0.00625 USD -> 0.01 USD
+	got, _, _ = rates.Convert(1, "IDR", "USD")
+	if got != 0 {
+		t.Fatalf("Convert(1 IDR->USD) = %d, want 0", got)
+	}
+	got, _, _ = rates.Convert(80, "IDR", "USD")
+	if got != 1 {
+		t.Fatalf("Convert(80 IDR->USD) = %d, want 1", got)
+	}
+
+	if _, _, err := rates.Convert(100, "PHP", "IDR"); !errors.Is(err, ErrNoExchangeRate) {
+		t.Fatalf("expected ErrNoExchangeRate, got %v", err)
+	}
+}
+
+func TestRates_ConvertNilTable(t *testing.T) {
+	var rates *Rates
+	if got, _, err := rates.Convert(500, "IDR", "IDR"); err != nil || got != 500 {
+		t.Fatalf("same-currency conversion should not need a table, got %d, %v", got, err)
+	}
+	if _, _, err := rates.Convert(500, "USD", "IDR"); !errors.Is(err, ErrNoExchangeRate) {
+		t.Fatalf("expected ErrNoExchangeRate, got %v", err)
+	}
+}
+
+func TestParseRates_Invalid(t *testing.T) {
+	for _, spec := range []string{"USD", "USD=abc", "USD=-1", "XYZ=10"} {
+		if _, err := ParseRates("IDR", spec); err == nil {
+			t.Fatalf("ParseRates(%q) should fail", spec)
+		}
+	}
+}
+
+func TestRates_List(t *testing.T) {
+	rates, _ := ParseRates("IDR", "USD=16250,SGD=12100.5")
+	list := rates.List()
+	if len(list) != 2 || list[0].Currency != "SGD" || list[0].Value != "12100.5" || list[1].Value != "16250" {
+		t.Fatalf("unexpected rate list %+v", list)
+	}
+}
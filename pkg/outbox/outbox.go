@@ -0,0 +1,123 @@
+// Package outbox relays events that a service stored in its own database in
+// the same transaction as the state change they describe, so a broker outage
+// delays events instead of losing them.
+//
+// Delivery is at least once: a relay that crashes between publishing and
+// marking a message sent will publish it again once its claim runs out, so
+// consumers must be idempotent. Relays of several replicas of a service can
+// run side by side, as each message is claimed by one of them.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+)
+
+const (
+	defaultBatchSize = 100
+	maxBackoff       = 5 * time.Minute
+)
+
+// ClaimTimeout is how long a message returned by Store.Pending is hidden
+// from other relays. It comfortably outlasts publishing a batch.
+const ClaimTimeout = time.Minute
+
+// Message is a stored event waiting to be published.
+type Message struct {
+	ID         string
+	RoutingKey string
+	Payload    []byte
+	Attempts   int
+}
+
+// Store is the outbox table or collection of a service.
+type Store interface {
+	// Pending claims unsent messages that are due at now, oldest first, by
+	// making them due again only after ClaimTimeout.
+	Pending(ctx context.Context, now time.Time, limit int) ([]Message, error)
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+	// MarkFailed records a failed attempt and when to try again.
+	MarkFailed(ctx context.Context, id string, lastErr string, retryAt time.Time) error
+}
+
+// Publisher is satisfied by *mq.Client.
+type Publisher interface {
+	Publish(ctx context.Context, routingKey string, payload interface{}) error
+}
+
+type Relay struct {
+	store     Store
+	publisher Publisher
+	batchSize int
+	now       func() time.Time
+}
+
+func NewRelay(store Store, publisher Publisher) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		batchSize: defaultBatchSize,
+		now:       time.Now,
+	}
+}
+
+// Backoff is the delay before retrying a message that already failed
+// attempts times: 1s, 2s, 4s, ... capped at five minutes.
+func Backoff(attempts int) time.Duration {
+	if attempts >= 9 {
+		return maxBackoff
+	}
+	d := time.Second << attempts
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
+
+// Flush publishes one batch of due messages and returns how many were sent.
+// A failed publish is rescheduled and does not stop the batch.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	msgs, err := r.store.Pending(ctx, r.now(), r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, msg := range msgs {
+		if err := r.publisher.Publish(ctx, msg.RoutingKey, json.RawMessage(msg.Payload)); err != nil {
+			log.Printf("WARN: failed to publish outbox message %s (%s), attempt %d: %v", msg.ID, msg.RoutingKey, msg.Attempts+1, err)
+			if err := r.store.MarkFailed(ctx, msg.ID, err.Error(), r.now().Add(Backoff(msg.Attempts))); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if err := r.store.MarkSent(ctx, msg.ID, r.now()); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// Run flushes the outbox every interval until ctx is cancelled. A full batch
+// is followed immediately by another flush to drain a backlog.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		sent, err := r.Flush(ctx)
+		if err != nil {
+			log.Printf("Error relaying outbox: %v", err)
+		}
+		if err == nil && sent == r.batchSize && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+type memoryStore struct {
+	pending []Message
+	sent    map[string]time.Time
+	failed  map[string]time.Time
+	lastErr map[string]string
+}
+
+func newMemoryStore(msgs ...Message) *memoryStore {
+	return &memoryStore{
+		pending: msgs,
+		sent:    map[string]time.Time{},
+		failed:  map[string]time.Time{},
+		lastErr: map[string]string{},
+	}
+}
+
+func (s *memoryStore) Pending(ctx context.Context, now time.Time, limit int) ([]Message, error) {
+	if len(s.pending) > limit {
+		return s.pending[:limit], nil
+	}
+	return s.pending, nil
+}
+
+func (s *memoryStore) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	s.sent[id] = sentAt
+	return nil
+}
+
+func (s *memoryStore) MarkFailed(ctx context.Context, id string, lastErr string, retryAt time.Time) error {
+	s.failed[id], s.lastErr[id] = retryAt, lastErr
+	return nil
+}
+
+type recordingPublisher struct {
+	published map[string]string
+	failKey   string
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, routingKey string, payload interface{}) error {
+	if routingKey == p.failKey {
+		return errors.New("channel closed")
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	p.published[routingKey] = string(body)
+	return nil
+}
+
+func TestRelay_Flush(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	store := newMemoryStore(
+		Message{ID: "1", RoutingKey: "payment.success", Payload: []byte(`{"reference_id":"abc"}`)},
+		Message{ID: "2", RoutingKey: "payment.expired", Payload: []byte(`{"reference_id":"def"}`), Attempts: 3},
+	)
+	pub := &recordingPublisher{published: map[string]string{}, failKey: "payment.expired"}
+	relay := NewRelay(store, pub)
+	relay.now = func() time.Time { return now }
+
+	sent, err := relay.Flush(context.Background())
+	if err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if sent != 1 {
+		t.Fatalf("expected 1 message sent, got %d", sent)
+	}
+	if got := pub.published["payment.success"]; got != `{"reference_id":"abc"}` {
+		t.Fatalf("payload should be published unchanged, got %s", got)
+	}
+	if !store.sent["1"].Equal(now) {
+		t.Fatalf("message 1 should be marked sent")
+	}
+	if _, ok := store.sent["2"]; ok {
+		t.Fatalf("failed message must not be marked sent")
+	}
+	if want := now.Add(8 * time.Second); !store.failed["2"].Equal(want) {
+		t.Fatalf("expected retry at %v, got %v", want, store.failed["2"])
+	}
+	if store.lastErr["2"] != "channel closed" {
+		t.Fatalf("expected last error recorded, got %q", store.lastErr["2"])
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		0:  time.Second,
+		1:  2 * time.Second,
+		5:  32 * time.Second,
+		8:  256 * time.Second,
+		9:  5 * time.Minute,
+		70: 5 * time.Minute,
+	}
+	for attempts, want := range tests {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
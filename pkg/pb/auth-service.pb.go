@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,6 +27,7 @@ type User struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	RoleType      string                 `protobuf:"bytes,3,opt,name=role_type,json=roleType,proto3" json:"role_type,omitempty"`
+	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +83,13 @@ func (x *User) GetRoleType() string {
 	return ""
 }
 
+func (x *User) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
 type Profile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -88,6 +97,7 @@ type Profile struct {
 	DateOfBirth   string                 `protobuf:"bytes,3,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
 	Age           int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
 	Balance       int32                  `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,6 +167,13 @@ func (x *Profile) GetBalance() int32 {
 	return 0
 }
 
+func (x *Profile) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -164,6 +181,7 @@ type RegisterRequest struct {
 	RoleType      string                 `protobuf:"bytes,3,opt,name=role_type,json=roleType,proto3" json:"role_type,omitempty"`
 	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
 	DateOfBirth   string                 `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,6 +251,13 @@ func (x *RegisterRequest) GetDateOfBirth() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -393,23 +418,26 @@ var File_proto_auth_service_proto protoreflect.FileDescriptor
 
 const file_proto_auth_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/auth-service.proto\x12\x04auth\"I\n" +
+	"\x18proto/auth-service.proto\x12\x04auth\x1a\x1bgoogle/protobuf/empty.proto\"f\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
-	"\trole_type\x18\x03 \x01(\tR\broleType\"\x86\x01\n" +
+	"\trole_type\x18\x03 \x01(\tR\broleType\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\"\xa2\x01\n" +
 	"\aProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\"\n" +
 	"\rdate_of_birth\x18\x03 \x01(\tR\vdateOfBirth\x12\x10\n" +
 	"\x03age\x18\x04 \x01(\x05R\x03age\x12\x18\n" +
-	"\abalance\x18\x05 \x01(\x05R\abalance\"\xa1\x01\n" +
+	"\abalance\x18\x05 \x01(\x05R\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"\xbd\x01\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
 	"\trole_type\x18\x03 \x01(\tR\broleType\x12\x1b\n" +
 	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\"\n" +
-	"\rdate_of_birth\x18\x05 \x01(\tR\vdateOfBirth\"E\n" +
+	"\rdate_of_birth\x18\x05 \x01(\tR\vdateOfBirth\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"E\n" +
 	"\x10RegisterResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"@\n" +
@@ -418,10 +446,12 @@ const file_proto_auth_service_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"8\n" +
 	"\fAuthResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role2y\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role2\xaf\x01\n" +
 	"\vAuthService\x12/\n" +
 	"\x05Login\x12\x12.auth.LoginRequest\x1a\x12.auth.AuthResponse\x129\n" +
-	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponseB\x11Z\x0freforest/pkg/pbb\x06proto3"
+	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponse\x124\n" +
+	"\x0eGetCurrentUser\x12\x16.google.protobuf.Empty\x1a\n" +
+	".auth.UserB\x11Z\x0freforest/pkg/pbb\x06proto3"
 
 var (
 	file_proto_auth_service_proto_rawDescOnce sync.Once
@@ -443,14 +473,17 @@ var file_proto_auth_service_proto_goTypes = []any{
 	(*RegisterResponse)(nil), // 3: auth.RegisterResponse
 	(*LoginRequest)(nil),     // 4: auth.LoginRequest
 	(*AuthResponse)(nil),     // 5: auth.AuthResponse
+	(*emptypb.Empty)(nil),    // 6: google.protobuf.Empty
 }
 var file_proto_auth_service_proto_depIdxs = []int32{
 	4, // 0: auth.AuthService.Login:input_type -> auth.LoginRequest
 	2, // 1: auth.AuthService.Register:input_type -> auth.RegisterRequest
-	5, // 2: auth.AuthService.Login:output_type -> auth.AuthResponse
-	3, // 3: auth.AuthService.Register:output_type -> auth.RegisterResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	6, // 2: auth.AuthService.GetCurrentUser:input_type -> google.protobuf.Empty
+	5, // 3: auth.AuthService.Login:output_type -> auth.AuthResponse
+	3, // 4: auth.AuthService.Register:output_type -> auth.RegisterResponse
+	0, // 5: auth.AuthService.GetCurrentUser:output_type -> auth.User
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
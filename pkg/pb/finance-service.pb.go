@@ -26,9 +26,11 @@ const (
 type TransactionType int32
 
 const (
-	TransactionType_DEPOSIT TransactionType = 0
-	TransactionType_ADOPT   TransactionType = 1
-	TransactionType_CARE    TransactionType = 2
+	TransactionType_DEPOSIT    TransactionType = 0
+	TransactionType_ADOPT      TransactionType = 1
+	TransactionType_CARE       TransactionType = 2
+	TransactionType_TRANSFER   TransactionType = 3
+	TransactionType_ADJUSTMENT TransactionType = 4
 )
 
 // Enum value maps for TransactionType.
@@ -37,11 +39,15 @@ var (
 		0: "DEPOSIT",
 		1: "ADOPT",
 		2: "CARE",
+		3: "TRANSFER",
+		4: "ADJUSTMENT",
 	}
 	TransactionType_value = map[string]int32{
-		"DEPOSIT": 0,
-		"ADOPT":   1,
-		"CARE":    2,
+		"DEPOSIT":    0,
+		"ADOPT":      1,
+		"CARE":       2,
+		"TRANSFER":   3,
+		"ADJUSTMENT": 4,
 	}
 )
 
@@ -72,14 +78,171 @@ func (TransactionType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_finance_service_proto_rawDescGZIP(), []int{0}
 }
 
+type DocumentFormat int32
+
+const (
+	DocumentFormat_PDF DocumentFormat = 0
+	DocumentFormat_CSV DocumentFormat = 1
+	DocumentFormat_IIF DocumentFormat = 2 // Intuit Interchange Format, accounting journal only
+)
+
+// Enum value maps for DocumentFormat.
+var (
+	DocumentFormat_name = map[int32]string{
+		0: "PDF",
+		1: "CSV",
+		2: "IIF",
+	}
+	DocumentFormat_value = map[string]int32{
+		"PDF": 0,
+		"CSV": 1,
+		"IIF": 2,
+	}
+)
+
+func (x DocumentFormat) Enum() *DocumentFormat {
+	p := new(DocumentFormat)
+	*p = x
+	return p
+}
+
+func (x DocumentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_finance_service_proto_enumTypes[1].Descriptor()
+}
+
+func (DocumentFormat) Type() protoreflect.EnumType {
+	return &file_proto_finance_service_proto_enumTypes[1]
+}
+
+func (x DocumentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentFormat.Descriptor instead.
+func (DocumentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{1}
+}
+
+type StatementPeriod int32
+
+const (
+	StatementPeriod_MONTHLY StatementPeriod = 0
+	StatementPeriod_ANNUAL  StatementPeriod = 1
+)
+
+// Enum value maps for StatementPeriod.
+var (
+	StatementPeriod_name = map[int32]string{
+		0: "MONTHLY",
+		1: "ANNUAL",
+	}
+	StatementPeriod_value = map[string]int32{
+		"MONTHLY": 0,
+		"ANNUAL":  1,
+	}
+)
+
+func (x StatementPeriod) Enum() *StatementPeriod {
+	p := new(StatementPeriod)
+	*p = x
+	return p
+}
+
+func (x StatementPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_finance_service_proto_enumTypes[2].Descriptor()
+}
+
+func (StatementPeriod) Type() protoreflect.EnumType {
+	return &file_proto_finance_service_proto_enumTypes[2]
+}
+
+func (x StatementPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementPeriod.Descriptor instead.
+func (StatementPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{2}
+}
+
+type RevenueGrouping int32
+
+const (
+	RevenueGrouping_DAY     RevenueGrouping = 0
+	RevenueGrouping_MONTH   RevenueGrouping = 1
+	RevenueGrouping_TYPE    RevenueGrouping = 2
+	RevenueGrouping_SPECIES RevenueGrouping = 3
+	RevenueGrouping_PLOT    RevenueGrouping = 4
+)
+
+// Enum value maps for RevenueGrouping.
+var (
+	RevenueGrouping_name = map[int32]string{
+		0: "DAY",
+		1: "MONTH",
+		2: "TYPE",
+		3: "SPECIES",
+		4: "PLOT",
+	}
+	RevenueGrouping_value = map[string]int32{
+		"DAY":     0,
+		"MONTH":   1,
+		"TYPE":    2,
+		"SPECIES": 3,
+		"PLOT":    4,
+	}
+)
+
+func (x RevenueGrouping) Enum() *RevenueGrouping {
+	p := new(RevenueGrouping)
+	*p = x
+	return p
+}
+
+func (x RevenueGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevenueGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_finance_service_proto_enumTypes[3].Descriptor()
+}
+
+func (RevenueGrouping) Type() protoreflect.EnumType {
+	return &file_proto_finance_service_proto_enumTypes[3]
+}
+
+func (x RevenueGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevenueGrouping.Descriptor instead.
+func (RevenueGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{3}
+}
+
 type TransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type          TransactionType        `protobuf:"varint,3,opt,name=type,proto3,enum=finance.TransactionType" json:"type,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount      int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type        TransactionType        `protobuf:"varint,3,opt,name=type,proto3,enum=finance.TransactionType" json:"type,omitempty"`
+	ReferenceId string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Currency    string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	VoucherCode string                 `protobuf:"bytes,6,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	// Checked against voucher restrictions.
+	SpeciesId      string `protobuf:"bytes,7,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
+	PlotId         string `protobuf:"bytes,8,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	PaymentMethod  string `protobuf:"bytes,9,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`     // VIRTUAL_ACCOUNT, QRIS, EWALLET or CARD; empty for any
+	PaymentChannel string `protobuf:"bytes,10,opt,name=payment_channel,json=paymentChannel,proto3" json:"payment_channel,omitempty"` // bank or e-wallet, e.g. BCA or OVO
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TransactionRequest) Reset() {
@@ -140,10 +303,56 @@ func (x *TransactionRequest) GetReferenceId() string {
 	return ""
 }
 
+func (x *TransactionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetSpeciesId() string {
+	if x != nil {
+		return x.SpeciesId
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetPlotId() string {
+	if x != nil {
+		return x.PlotId
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetPaymentChannel() string {
+	if x != nil {
+		return x.PaymentChannel
+	}
+	return ""
+}
+
 type TopUpRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Amount          int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	DurationSeconds int32                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	VoucherCode     string                 `protobuf:"bytes,4,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	PaymentMethod   string                 `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`    // VIRTUAL_ACCOUNT, QRIS, EWALLET or CARD; empty for any
+	PaymentChannel  string                 `protobuf:"bytes,6,opt,name=payment_channel,json=paymentChannel,proto3" json:"payment_channel,omitempty"` // bank or e-wallet, e.g. BCA or OVO
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -192,19 +401,58 @@ func (x *TopUpRequest) GetDurationSeconds() int32 {
 	return 0
 }
 
+func (x *TopUpRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetPaymentChannel() string {
+	if x != nil {
+		return x.PaymentChannel
+	}
+	return ""
+}
+
 type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	PaymentUrl    string                 `protobuf:"bytes,6,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,8,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	InvoiceId     string                 `protobuf:"bytes,9,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount           int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type             string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	PaymentUrl       string                 `protobuf:"bytes,6,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ReferenceId      string                 `protobuf:"bytes,8,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	InvoiceId        string                 `protobuf:"bytes,9,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Currency         string                 `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	OriginalAmount   int64                  `protobuf:"varint,11,opt,name=original_amount,json=originalAmount,proto3" json:"original_amount,omitempty"`
+	OriginalCurrency string                 `protobuf:"bytes,12,opt,name=original_currency,json=originalCurrency,proto3" json:"original_currency,omitempty"`
+	ExchangeRate     string                 `protobuf:"bytes,13,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	VoucherCode      string                 `protobuf:"bytes,14,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	DiscountAmount   int64                  `protobuf:"varint,15,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Direction        string                 `protobuf:"bytes,16,opt,name=direction,proto3" json:"direction,omitempty"` // IN or OUT, set on transfers and adjustments
+	CounterpartyId   string                 `protobuf:"bytes,17,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	PaymentAmount    int64                  `protobuf:"varint,18,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"` // invoiced part of amount, the rest is held from the wallet
+	PaymentMethod    string                 `protobuf:"bytes,19,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`  // preferred at checkout, the one used once paid
+	PaymentChannel   string                 `protobuf:"bytes,20,opt,name=payment_channel,json=paymentChannel,proto3" json:"payment_channel,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Transaction) Reset() {
@@ -300,27 +548,105 @@ func (x *Transaction) GetInvoiceId() string {
 	return ""
 }
 
-type TransactionList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *TransactionList) Reset() {
-	*x = TransactionList{}
+func (x *Transaction) GetOriginalAmount() int64 {
+	if x != nil {
+		return x.OriginalAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetOriginalCurrency() string {
+	if x != nil {
+		return x.OriginalCurrency
+	}
+	return ""
+}
+
+func (x *Transaction) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Transaction) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *Transaction) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Transaction) GetCounterpartyId() string {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return ""
+}
+
+func (x *Transaction) GetPaymentAmount() int64 {
+	if x != nil {
+		return x.PaymentAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *Transaction) GetPaymentChannel() string {
+	if x != nil {
+		return x.PaymentChannel
+	}
+	return ""
+}
+
+type TransferRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RecipientEmail string                 `protobuf:"bytes,1,opt,name=recipient_email,json=recipientEmail,proto3" json:"recipient_email,omitempty"`
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
 	mi := &file_proto_finance_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionList) String() string {
+func (x *TransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionList) ProtoMessage() {}
+func (*TransferRequest) ProtoMessage() {}
 
-func (x *TransactionList) ProtoReflect() protoreflect.Message {
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_finance_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,39 +658,46 @@ func (x *TransactionList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionList.ProtoReflect.Descriptor instead.
-func (*TransactionList) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
 	return file_proto_finance_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TransactionList) GetTransactions() []*Transaction {
+func (x *TransferRequest) GetRecipientEmail() string {
 	if x != nil {
-		return x.Transactions
+		return x.RecipientEmail
 	}
-	return nil
+	return ""
 }
 
-type BalanceResponse struct {
+func (x *TransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TransactionList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Balance       int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BalanceResponse) Reset() {
-	*x = BalanceResponse{}
+func (x *TransactionList) Reset() {
+	*x = TransactionList{}
 	mi := &file_proto_finance_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BalanceResponse) String() string {
+func (x *TransactionList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceResponse) ProtoMessage() {}
+func (*TransactionList) ProtoMessage() {}
 
-func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
+func (x *TransactionList) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_finance_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,40 +709,39 @@ func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
-func (*BalanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionList.ProtoReflect.Descriptor instead.
+func (*TransactionList) Descriptor() ([]byte, []int) {
 	return file_proto_finance_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BalanceResponse) GetBalance() int64 {
+func (x *TransactionList) GetTransactions() []*Transaction {
 	if x != nil {
-		return x.Balance
+		return x.Transactions
 	}
-	return 0
+	return nil
 }
 
-type WebhookRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BalanceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DisplayCurrency string                 `protobuf:"bytes,1,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *WebhookRequest) Reset() {
-	*x = WebhookRequest{}
+func (x *BalanceRequest) Reset() {
+	*x = BalanceRequest{}
 	mi := &file_proto_finance_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebhookRequest) String() string {
+func (x *BalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhookRequest) ProtoMessage() {}
+func (*BalanceRequest) ProtoMessage() {}
 
-func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_finance_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,19 +753,4152 @@ func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
-func (*WebhookRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_finance_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *WebhookRequest) GetEvent() string {
+func (x *BalanceRequest) GetDisplayCurrency() string {
 	if x != nil {
-		return x.Event
+		return x.DisplayCurrency
 	}
 	return ""
 }
 
-func (x *WebhookRequest) GetData() []byte {
+type BalanceResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Balance                 int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"` // total, including held funds
+	Currency                string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	DisplayBalance          int64                  `protobuf:"varint,3,opt,name=display_balance,json=displayBalance,proto3" json:"display_balance,omitempty"`
+	DisplayCurrency         string                 `protobuf:"bytes,4,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	AvailableBalance        int64                  `protobuf:"varint,5,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"` // balance less held funds
+	DisplayAvailableBalance int64                  `protobuf:"varint,6,opt,name=display_available_balance,json=displayAvailableBalance,proto3" json:"display_available_balance,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *BalanceResponse) Reset() {
+	*x = BalanceResponse{}
+	mi := &file_proto_finance_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceResponse) ProtoMessage() {}
+
+func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BalanceResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *BalanceResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BalanceResponse) GetDisplayBalance() int64 {
+	if x != nil {
+		return x.DisplayBalance
+	}
+	return 0
+}
+
+func (x *BalanceResponse) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *BalanceResponse) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+func (x *BalanceResponse) GetDisplayAvailableBalance() int64 {
+	if x != nil {
+		return x.DisplayAvailableBalance
+	}
+	return 0
+}
+
+type BalanceHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                    // HELD, CAPTURED, RELEASED, EXPIRED
+	TransactionId string                 `protobuf:"bytes,9,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // payment the hold went towards, or the one capturing it recorded
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHold) Reset() {
+	*x = BalanceHold{}
+	mi := &file_proto_finance_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHold) ProtoMessage() {}
+
+func (x *BalanceHold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHold.ProtoReflect.Descriptor instead.
+func (*BalanceHold) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BalanceHold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceHold) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *BalanceHold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *BalanceHold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateBalanceHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type          TransactionType        `protobuf:"varint,2,opt,name=type,proto3,enum=finance.TransactionType" json:"type,omitempty"` // ADOPT or CARE, recorded when the hold is captured
+	ReferenceId   string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // defaults to 24 hours, at most 7 days
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBalanceHoldRequest) Reset() {
+	*x = CreateBalanceHoldRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBalanceHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBalanceHoldRequest) ProtoMessage() {}
+
+func (x *CreateBalanceHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBalanceHoldRequest.ProtoReflect.Descriptor instead.
+func (*CreateBalanceHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateBalanceHoldRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateBalanceHoldRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_DEPOSIT
+}
+
+func (x *CreateBalanceHoldRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CreateBalanceHoldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBalanceHoldRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type BalanceHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHoldRequest) Reset() {
+	*x = BalanceHoldRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHoldRequest) ProtoMessage() {}
+
+func (x *BalanceHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHoldRequest.ProtoReflect.Descriptor instead.
+func (*BalanceHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BalanceHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type BalanceHoldList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holds         []*BalanceHold         `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceHoldList) Reset() {
+	*x = BalanceHoldList{}
+	mi := &file_proto_finance_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceHoldList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceHoldList) ProtoMessage() {}
+
+func (x *BalanceHoldList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceHoldList.ProtoReflect.Descriptor instead.
+func (*BalanceHoldList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BalanceHoldList) GetHolds() []*BalanceHold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+// TopUpReview is a top-up the fraud rules held back for an admin to decide
+// on. Its payment stays in REVIEW, without an invoice, until then.
+type TopUpReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rules         []string               `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"` // VELOCITY, DAILY_VOLUME, DUPLICATE_AMOUNT
+	Reasons       string                 `protobuf:"bytes,7,opt,name=reasons,proto3" json:"reasons,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // PENDING, APPROVED, REJECTED
+	ReviewerId    string                 `protobuf:"bytes,9,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Note          string                 `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpReview) Reset() {
+	*x = TopUpReview{}
+	mi := &file_proto_finance_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpReview) ProtoMessage() {}
+
+func (x *TopUpReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpReview.ProtoReflect.Descriptor instead.
+func (*TopUpReview) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TopUpReview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TopUpReview) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *TopUpReview) GetReasons() string {
+	if x != nil {
+		return x.Reasons
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TopUpReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TopUpReview) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+type ListTopUpReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // empty lists every review
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTopUpReviewsRequest) Reset() {
+	*x = ListTopUpReviewsRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTopUpReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTopUpReviewsRequest) ProtoMessage() {}
+
+func (x *ListTopUpReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTopUpReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListTopUpReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTopUpReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type TopUpReviewList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*TopUpReview         `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpReviewList) Reset() {
+	*x = TopUpReviewList{}
+	mi := &file_proto_finance_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpReviewList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpReviewList) ProtoMessage() {}
+
+func (x *TopUpReviewList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpReviewList.ProtoReflect.Descriptor instead.
+func (*TopUpReviewList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TopUpReviewList) GetReviews() []*TopUpReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type DecideTopUpReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideTopUpReviewRequest) Reset() {
+	*x = DecideTopUpReviewRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideTopUpReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideTopUpReviewRequest) ProtoMessage() {}
+
+func (x *DecideTopUpReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideTopUpReviewRequest.ProtoReflect.Descriptor instead.
+func (*DecideTopUpReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DecideTopUpReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *DecideTopUpReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// BalanceAdjustment is a manual wallet correction. It is posted as an
+// ADJUSTMENT transaction only once an admin other than the requester
+// approves it.
+type BalanceAdjustment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount          int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // signed: negative amounts debit the wallet
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	TicketReference string                 `protobuf:"bytes,6,opt,name=ticket_reference,json=ticketReference,proto3" json:"ticket_reference,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // PENDING, APPROVED, REJECTED
+	RequestedBy     string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	DecidedBy       string                 `protobuf:"bytes,9,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecisionNote    string                 `protobuf:"bytes,10,opt,name=decision_note,json=decisionNote,proto3" json:"decision_note,omitempty"`
+	TransactionId   string                 `protobuf:"bytes,11,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // the ADJUSTMENT transaction, once approved
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BalanceAdjustment) Reset() {
+	*x = BalanceAdjustment{}
+	mi := &file_proto_finance_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceAdjustment) ProtoMessage() {}
+
+func (x *BalanceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceAdjustment.ProtoReflect.Descriptor instead.
+func (*BalanceAdjustment) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BalanceAdjustment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceAdjustment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetTicketReference() string {
+	if x != nil {
+		return x.TicketReference
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetDecisionNote() string {
+	if x != nil {
+		return x.DecisionNote
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BalanceAdjustment) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+type BalanceAdjustmentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount          int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	TicketReference string                 `protobuf:"bytes,4,opt,name=ticket_reference,json=ticketReference,proto3" json:"ticket_reference,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BalanceAdjustmentRequest) Reset() {
+	*x = BalanceAdjustmentRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceAdjustmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceAdjustmentRequest) ProtoMessage() {}
+
+func (x *BalanceAdjustmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceAdjustmentRequest.ProtoReflect.Descriptor instead.
+func (*BalanceAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BalanceAdjustmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustmentRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceAdjustmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BalanceAdjustmentRequest) GetTicketReference() string {
+	if x != nil {
+		return x.TicketReference
+	}
+	return ""
+}
+
+type DecideBalanceAdjustmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdjustmentId  string                 `protobuf:"bytes,1,opt,name=adjustment_id,json=adjustmentId,proto3" json:"adjustment_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideBalanceAdjustmentRequest) Reset() {
+	*x = DecideBalanceAdjustmentRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideBalanceAdjustmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideBalanceAdjustmentRequest) ProtoMessage() {}
+
+func (x *DecideBalanceAdjustmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideBalanceAdjustmentRequest.ProtoReflect.Descriptor instead.
+func (*DecideBalanceAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DecideBalanceAdjustmentRequest) GetAdjustmentId() string {
+	if x != nil {
+		return x.AdjustmentId
+	}
+	return ""
+}
+
+func (x *DecideBalanceAdjustmentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListBalanceAdjustmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // empty lists every adjustment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBalanceAdjustmentsRequest) Reset() {
+	*x = ListBalanceAdjustmentsRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBalanceAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceAdjustmentsRequest) ProtoMessage() {}
+
+func (x *ListBalanceAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListBalanceAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListBalanceAdjustmentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type BalanceAdjustmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adjustments   []*BalanceAdjustment   `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceAdjustmentList) Reset() {
+	*x = BalanceAdjustmentList{}
+	mi := &file_proto_finance_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceAdjustmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceAdjustmentList) ProtoMessage() {}
+
+func (x *BalanceAdjustmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceAdjustmentList.ProtoReflect.Descriptor instead.
+func (*BalanceAdjustmentList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BalanceAdjustmentList) GetAdjustments() []*BalanceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+// Partner is a landowner hosting some of our plots. Shares are in basis
+// points of the settled ADOPT and CARE revenue of a plot, 2500 = 25%.
+type Partner struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email             string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	BankName          string                 `protobuf:"bytes,4,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	BankAccountNumber string                 `protobuf:"bytes,5,opt,name=bank_account_number,json=bankAccountNumber,proto3" json:"bank_account_number,omitempty"`
+	BankAccountName   string                 `protobuf:"bytes,6,opt,name=bank_account_name,json=bankAccountName,proto3" json:"bank_account_name,omitempty"`
+	ShareBps          int32                  `protobuf:"varint,7,opt,name=share_bps,json=shareBps,proto3" json:"share_bps,omitempty"`
+	Plots             []*PartnerPlot         `protobuf:"bytes,8,rep,name=plots,proto3" json:"plots,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Partner) Reset() {
+	*x = Partner{}
+	mi := &file_proto_finance_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Partner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Partner) ProtoMessage() {}
+
+func (x *Partner) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Partner.ProtoReflect.Descriptor instead.
+func (*Partner) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Partner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Partner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Partner) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Partner) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *Partner) GetBankAccountNumber() string {
+	if x != nil {
+		return x.BankAccountNumber
+	}
+	return ""
+}
+
+func (x *Partner) GetBankAccountName() string {
+	if x != nil {
+		return x.BankAccountName
+	}
+	return ""
+}
+
+func (x *Partner) GetShareBps() int32 {
+	if x != nil {
+		return x.ShareBps
+	}
+	return 0
+}
+
+func (x *Partner) GetPlots() []*PartnerPlot {
+	if x != nil {
+		return x.Plots
+	}
+	return nil
+}
+
+func (x *Partner) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PartnerPlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlotId        string                 `protobuf:"bytes,1,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	ShareBps      int32                  `protobuf:"varint,2,opt,name=share_bps,json=shareBps,proto3" json:"share_bps,omitempty"` // overrides the partner's share when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartnerPlot) Reset() {
+	*x = PartnerPlot{}
+	mi := &file_proto_finance_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartnerPlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartnerPlot) ProtoMessage() {}
+
+func (x *PartnerPlot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartnerPlot.ProtoReflect.Descriptor instead.
+func (*PartnerPlot) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PartnerPlot) GetPlotId() string {
+	if x != nil {
+		return x.PlotId
+	}
+	return ""
+}
+
+func (x *PartnerPlot) GetShareBps() int32 {
+	if x != nil {
+		return x.ShareBps
+	}
+	return 0
+}
+
+type PartnerList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Partners      []*Partner             `protobuf:"bytes,1,rep,name=partners,proto3" json:"partners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartnerList) Reset() {
+	*x = PartnerList{}
+	mi := &file_proto_finance_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartnerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartnerList) ProtoMessage() {}
+
+func (x *PartnerList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartnerList.ProtoReflect.Descriptor instead.
+func (*PartnerList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PartnerList) GetPartners() []*Partner {
+	if x != nil {
+		return x.Partners
+	}
+	return nil
+}
+
+type CalculatePayoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"` // 1-12; must have ended
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatePayoutsRequest) Reset() {
+	*x = CalculatePayoutsRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePayoutsRequest) ProtoMessage() {}
+
+func (x *CalculatePayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePayoutsRequest.ProtoReflect.Descriptor instead.
+func (*CalculatePayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CalculatePayoutsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CalculatePayoutsRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+type Payout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PartnerId     string                 `protobuf:"bytes,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	PartnerName   string                 `protobuf:"bytes,3,opt,name=partner_name,json=partnerName,proto3" json:"partner_name,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	RevenueAmount int64                  `protobuf:"varint,7,opt,name=revenue_amount,json=revenueAmount,proto3" json:"revenue_amount,omitempty"`
+	Amount        int64                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`       // owed to the partner
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`        // PENDING, PAID, CANCELLED
+	Reference     string                 `protobuf:"bytes,10,opt,name=reference,proto3" json:"reference,omitempty"` // bank transfer reference once paid
+	Note          string                 `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	Lines         []*PayoutLine          `protobuf:"bytes,12,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payout) Reset() {
+	*x = Payout{}
+	mi := &file_proto_finance_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payout) ProtoMessage() {}
+
+func (x *Payout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payout.ProtoReflect.Descriptor instead.
+func (*Payout) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Payout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payout) GetPartnerId() string {
+	if x != nil {
+		return x.PartnerId
+	}
+	return ""
+}
+
+func (x *Payout) GetPartnerName() string {
+	if x != nil {
+		return x.PartnerName
+	}
+	return ""
+}
+
+func (x *Payout) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Payout) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Payout) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payout) GetRevenueAmount() int64 {
+	if x != nil {
+		return x.RevenueAmount
+	}
+	return 0
+}
+
+func (x *Payout) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payout) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payout) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Payout) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Payout) GetLines() []*PayoutLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Payout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Payout) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+type PayoutLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlotId           string                 `protobuf:"bytes,1,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	PlotName         string                 `protobuf:"bytes,2,opt,name=plot_name,json=plotName,proto3" json:"plot_name,omitempty"`
+	TransactionCount int64                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	RevenueAmount    int64                  `protobuf:"varint,4,opt,name=revenue_amount,json=revenueAmount,proto3" json:"revenue_amount,omitempty"`
+	ShareBps         int32                  `protobuf:"varint,5,opt,name=share_bps,json=shareBps,proto3" json:"share_bps,omitempty"`
+	Amount           int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PayoutLine) Reset() {
+	*x = PayoutLine{}
+	mi := &file_proto_finance_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutLine) ProtoMessage() {}
+
+func (x *PayoutLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutLine.ProtoReflect.Descriptor instead.
+func (*PayoutLine) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PayoutLine) GetPlotId() string {
+	if x != nil {
+		return x.PlotId
+	}
+	return ""
+}
+
+func (x *PayoutLine) GetPlotName() string {
+	if x != nil {
+		return x.PlotName
+	}
+	return ""
+}
+
+func (x *PayoutLine) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *PayoutLine) GetRevenueAmount() int64 {
+	if x != nil {
+		return x.RevenueAmount
+	}
+	return 0
+}
+
+func (x *PayoutLine) GetShareBps() int32 {
+	if x != nil {
+		return x.ShareBps
+	}
+	return 0
+}
+
+func (x *PayoutLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PayoutList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payouts       []*Payout              `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutList) Reset() {
+	*x = PayoutList{}
+	mi := &file_proto_finance_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutList) ProtoMessage() {}
+
+func (x *PayoutList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutList.ProtoReflect.Descriptor instead.
+func (*PayoutList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PayoutList) GetPayouts() []*Payout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+type ListPayoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PartnerId     string                 `protobuf:"bytes,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"` // empty lists every partner
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutsRequest) Reset() {
+	*x = ListPayoutsRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsRequest) ProtoMessage() {}
+
+func (x *ListPayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListPayoutsRequest) GetPartnerId() string {
+	if x != nil {
+		return x.PartnerId
+	}
+	return ""
+}
+
+func (x *ListPayoutsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type PayoutStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`       // PAID or CANCELLED
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"` // required when PAID
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutStatusRequest) Reset() {
+	*x = PayoutStatusRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutStatusRequest) ProtoMessage() {}
+
+func (x *PayoutStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutStatusRequest.ProtoReflect.Descriptor instead.
+func (*PayoutStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PayoutStatusRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+func (x *PayoutStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayoutStatusRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PayoutStatusRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type PayoutStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	Format        DocumentFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=finance.DocumentFormat" json:"format,omitempty"` // PDF or CSV
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutStatementRequest) Reset() {
+	*x = PayoutStatementRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutStatementRequest) ProtoMessage() {}
+
+func (x *PayoutStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutStatementRequest.ProtoReflect.Descriptor instead.
+func (*PayoutStatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PayoutStatementRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+func (x *PayoutStatementRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_PDF
+}
+
+// MatchingCampaign funds match_ratio extra trees for every tree adopted by
+// an employee of organization, recognised by their email domain, until the
+// budget runs out.
+type MatchingCampaign struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Organization        string                 `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	EmailDomains        []string               `protobuf:"bytes,4,rep,name=email_domains,json=emailDomains,proto3" json:"email_domains,omitempty"`
+	MatchRatio          int32                  `protobuf:"varint,5,opt,name=match_ratio,json=matchRatio,proto3" json:"match_ratio,omitempty"`                                // trees funded per tree adopted; defaults to 1
+	MaxTreesPerEmployee int32                  `protobuf:"varint,6,opt,name=max_trees_per_employee,json=maxTreesPerEmployee,proto3" json:"max_trees_per_employee,omitempty"` // 0 for no cap
+	BudgetAmount        int64                  `protobuf:"varint,7,opt,name=budget_amount,json=budgetAmount,proto3" json:"budget_amount,omitempty"`
+	SpentAmount         int64                  `protobuf:"varint,8,opt,name=spent_amount,json=spentAmount,proto3" json:"spent_amount,omitempty"`
+	Currency            string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status              string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // ACTIVE, PAUSED, ENDED
+	StartsAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt              *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MatchingCampaign) Reset() {
+	*x = MatchingCampaign{}
+	mi := &file_proto_finance_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchingCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingCampaign) ProtoMessage() {}
+
+func (x *MatchingCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingCampaign.ProtoReflect.Descriptor instead.
+func (*MatchingCampaign) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MatchingCampaign) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MatchingCampaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MatchingCampaign) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *MatchingCampaign) GetEmailDomains() []string {
+	if x != nil {
+		return x.EmailDomains
+	}
+	return nil
+}
+
+func (x *MatchingCampaign) GetMatchRatio() int32 {
+	if x != nil {
+		return x.MatchRatio
+	}
+	return 0
+}
+
+func (x *MatchingCampaign) GetMaxTreesPerEmployee() int32 {
+	if x != nil {
+		return x.MaxTreesPerEmployee
+	}
+	return 0
+}
+
+func (x *MatchingCampaign) GetBudgetAmount() int64 {
+	if x != nil {
+		return x.BudgetAmount
+	}
+	return 0
+}
+
+func (x *MatchingCampaign) GetSpentAmount() int64 {
+	if x != nil {
+		return x.SpentAmount
+	}
+	return 0
+}
+
+func (x *MatchingCampaign) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *MatchingCampaign) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MatchingCampaign) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *MatchingCampaign) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *MatchingCampaign) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MatchingCampaignList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaigns     []*MatchingCampaign    `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchingCampaignList) Reset() {
+	*x = MatchingCampaignList{}
+	mi := &file_proto_finance_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchingCampaignList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingCampaignList) ProtoMessage() {}
+
+func (x *MatchingCampaignList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingCampaignList.ProtoReflect.Descriptor instead.
+func (*MatchingCampaignList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MatchingCampaignList) GetCampaigns() []*MatchingCampaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+type MatchingCampaignStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // ACTIVE, PAUSED or ENDED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchingCampaignStatusRequest) Reset() {
+	*x = MatchingCampaignStatusRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchingCampaignStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchingCampaignStatusRequest) ProtoMessage() {}
+
+func (x *MatchingCampaignStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchingCampaignStatusRequest.ProtoReflect.Descriptor instead.
+func (*MatchingCampaignStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MatchingCampaignStatusRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *MatchingCampaignStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CampaignMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // the employee's adoption intent
+	Trees         int32                  `protobuf:"varint,6,opt,name=trees,proto3" json:"trees,omitempty"`
+	Amount        int64                  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignMatch) Reset() {
+	*x = CampaignMatch{}
+	mi := &file_proto_finance_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignMatch) ProtoMessage() {}
+
+func (x *CampaignMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignMatch.ProtoReflect.Descriptor instead.
+func (*CampaignMatch) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CampaignMatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CampaignMatch) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CampaignMatch) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CampaignMatch) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CampaignMatch) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CampaignMatch) GetTrees() int32 {
+	if x != nil {
+		return x.Trees
+	}
+	return 0
+}
+
+func (x *CampaignMatch) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CampaignMatch) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CampaignMatch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListCampaignMatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignMatchesRequest) Reset() {
+	*x = ListCampaignMatchesRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignMatchesRequest) ProtoMessage() {}
+
+func (x *ListCampaignMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListCampaignMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListCampaignMatchesRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+type CampaignMatchList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*CampaignMatch       `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignMatchList) Reset() {
+	*x = CampaignMatchList{}
+	mi := &file_proto_finance_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignMatchList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignMatchList) ProtoMessage() {}
+
+func (x *CampaignMatchList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignMatchList.ProtoReflect.Descriptor instead.
+func (*CampaignMatchList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CampaignMatchList) GetMatches() []*CampaignMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type ExchangeRate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Currency          string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rate              string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	MinorUnitExponent int32                  `protobuf:"varint,3,opt,name=minor_unit_exponent,json=minorUnitExponent,proto3" json:"minor_unit_exponent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_proto_finance_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExchangeRate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetMinorUnitExponent() int32 {
+	if x != nil {
+		return x.MinorUnitExponent
+	}
+	return 0
+}
+
+type ExchangeRateList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateList) Reset() {
+	*x = ExchangeRateList{}
+	mi := &file_proto_finance_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateList) ProtoMessage() {}
+
+func (x *ExchangeRateList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateList.ProtoReflect.Descriptor instead.
+func (*ExchangeRateList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExchangeRateList) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRateList) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type CancelPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPaymentRequest) Reset() {
+	*x = CancelPaymentRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPaymentRequest) ProtoMessage() {}
+
+func (x *CancelPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CancelPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type ReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Format        DocumentFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=finance.DocumentFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptRequest) Reset() {
+	*x = ReceiptRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptRequest) ProtoMessage() {}
+
+func (x *ReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReceiptRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ReceiptRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_PDF
+}
+
+type StatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        StatementPeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=finance.StatementPeriod" json:"period,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Format        DocumentFormat         `protobuf:"varint,4,opt,name=format,proto3,enum=finance.DocumentFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatementRequest) Reset() {
+	*x = StatementRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementRequest) ProtoMessage() {}
+
+func (x *StatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementRequest.ProtoReflect.Descriptor instead.
+func (*StatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StatementRequest) GetPeriod() StatementPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return StatementPeriod_MONTHLY
+}
+
+func (x *StatementRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *StatementRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *StatementRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_PDF
+}
+
+type Document struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_proto_finance_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Document) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Document) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Document) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// CertificateRequest always covers the caller's own donations. The
+// organisation fields are printed on the certificate, not aggregated over.
+type CertificateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Year             int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	TaxId            string                 `protobuf:"bytes,3,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CertificateRequest) Reset() {
+	*x = CertificateRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRequest) ProtoMessage() {}
+
+func (x *CertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRequest.ProtoReflect.Descriptor instead.
+func (*CertificateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CertificateRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CertificateRequest) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *CertificateRequest) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+type VerifyCertificateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VerificationCode string                 `protobuf:"bytes,1,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VerifyCertificateRequest) Reset() {
+	*x = VerifyCertificateRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCertificateRequest) ProtoMessage() {}
+
+func (x *VerifyCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCertificateRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VerifyCertificateRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+type CertificateVerification struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Valid             bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	CertificateNumber string                 `protobuf:"bytes,2,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	RecipientName     string                 `protobuf:"bytes,3,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	OrganizationName  string                 `protobuf:"bytes,4,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	Year              int32                  `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	TotalAmount       int64                  `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	IssuedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Currency          string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CertificateVerification) Reset() {
+	*x = CertificateVerification{}
+	mi := &file_proto_finance_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateVerification) ProtoMessage() {}
+
+func (x *CertificateVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateVerification.ProtoReflect.Descriptor instead.
+func (*CertificateVerification) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CertificateVerification) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *CertificateVerification) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *CertificateVerification) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *CertificateVerification) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *CertificateVerification) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CertificateVerification) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *CertificateVerification) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *CertificateVerification) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ReconciliationDiscrepancy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId  string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Kind           string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	LocalStatus    string                 `protobuf:"bytes,7,opt,name=local_status,json=localStatus,proto3" json:"local_status,omitempty"`
+	ProviderStatus string                 `protobuf:"bytes,8,opt,name=provider_status,json=providerStatus,proto3" json:"provider_status,omitempty"`
+	Action         string                 `protobuf:"bytes,9,opt,name=action,proto3" json:"action,omitempty"`
+	Detail         string                 `protobuf:"bytes,10,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReconciliationDiscrepancy) Reset() {
+	*x = ReconciliationDiscrepancy{}
+	mi := &file_proto_finance_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationDiscrepancy) ProtoMessage() {}
+
+func (x *ReconciliationDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationDiscrepancy.ProtoReflect.Descriptor instead.
+func (*ReconciliationDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReconciliationDiscrepancy) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReconciliationDiscrepancy) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetLocalStatus() string {
+	if x != nil {
+		return x.LocalStatus
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetProviderStatus() string {
+	if x != nil {
+		return x.ProviderStatus
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ReconciliationDiscrepancy) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ReconciliationReport struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Id            string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartedAt     *timestamppb.Timestamp       `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp       `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Checked       int32                        `protobuf:"varint,4,opt,name=checked,proto3" json:"checked,omitempty"`
+	Fixed         int32                        `protobuf:"varint,5,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Discrepancies []*ReconciliationDiscrepancy `protobuf:"bytes,6,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationReport) Reset() {
+	*x = ReconciliationReport{}
+	mi := &file_proto_finance_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationReport) ProtoMessage() {}
+
+func (x *ReconciliationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationReport) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReconciliationReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReconciliationReport) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationReport) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationReport) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetFixed() int32 {
+	if x != nil {
+		return x.Fixed
+	}
+	return 0
+}
+
+func (x *ReconciliationReport) GetDiscrepancies() []*ReconciliationDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type ListReconciliationReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationReportsRequest) Reset() {
+	*x = ListReconciliationReportsRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationReportsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListReconciliationReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReconciliationReportList struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Reports       []*ReconciliationReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationReportList) Reset() {
+	*x = ReconciliationReportList{}
+	mi := &file_proto_finance_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationReportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationReportList) ProtoMessage() {}
+
+func (x *ReconciliationReportList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationReportList.ProtoReflect.Descriptor instead.
+func (*ReconciliationReportList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReconciliationReportList) GetReports() []*ReconciliationReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type Voucher struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DiscountType    string                 `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"` // PERCENTAGE or FIXED
+	Value           int64                  `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	Currency        string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransactionType string                 `protobuf:"bytes,7,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"` // ADOPT, DEPOSIT or empty for both
+	SpeciesId       string                 `protobuf:"bytes,8,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
+	PlotId          string                 `protobuf:"bytes,9,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	MaxRedemptions  int32                  `protobuf:"varint,10,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxPerUser      int32                  `protobuf:"varint,11,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	RedemptionCount int32                  `protobuf:"varint,12,opt,name=redemption_count,json=redemptionCount,proto3" json:"redemption_count,omitempty"`
+	ValidFrom       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Voucher) Reset() {
+	*x = Voucher{}
+	mi := &file_proto_finance_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Voucher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Voucher) ProtoMessage() {}
+
+func (x *Voucher) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Voucher.ProtoReflect.Descriptor instead.
+func (*Voucher) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Voucher) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Voucher) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Voucher) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Voucher) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *Voucher) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Voucher) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Voucher) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *Voucher) GetSpeciesId() string {
+	if x != nil {
+		return x.SpeciesId
+	}
+	return ""
+}
+
+func (x *Voucher) GetPlotId() string {
+	if x != nil {
+		return x.PlotId
+	}
+	return ""
+}
+
+func (x *Voucher) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *Voucher) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *Voucher) GetRedemptionCount() int32 {
+	if x != nil {
+		return x.RedemptionCount
+	}
+	return 0
+}
+
+func (x *Voucher) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Voucher) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *Voucher) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type VoucherList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vouchers      []*Voucher             `protobuf:"bytes,1,rep,name=vouchers,proto3" json:"vouchers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoucherList) Reset() {
+	*x = VoucherList{}
+	mi := &file_proto_finance_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoucherList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoucherList) ProtoMessage() {}
+
+func (x *VoucherList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoucherList.ProtoReflect.Descriptor instead.
+func (*VoucherList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VoucherList) GetVouchers() []*Voucher {
+	if x != nil {
+		return x.Vouchers
+	}
+	return nil
+}
+
+type RevenueReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       RevenueGrouping        `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=finance.RevenueGrouping" json:"group_by,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // defaults to 30 days before to
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueReportRequest) Reset() {
+	*x = RevenueReportRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueReportRequest) ProtoMessage() {}
+
+func (x *RevenueReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueReportRequest.ProtoReflect.Descriptor instead.
+func (*RevenueReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RevenueReportRequest) GetGroupBy() RevenueGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return RevenueGrouping_DAY
+}
+
+func (x *RevenueReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RevenueReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type RevenueRow struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Key              string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // date, month, type, species ID or plot ID
+	Label            string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	TransactionCount int64                  `protobuf:"varint,4,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Amount           int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	DiscountAmount   int64                  `protobuf:"varint,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RevenueRow) Reset() {
+	*x = RevenueRow{}
+	mi := &file_proto_finance_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueRow) ProtoMessage() {}
+
+func (x *RevenueRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueRow.ProtoReflect.Descriptor instead.
+func (*RevenueRow) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevenueRow) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RevenueRow) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RevenueRow) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RevenueRow) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *RevenueRow) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RevenueRow) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+type RevenueReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       RevenueGrouping        `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=finance.RevenueGrouping" json:"group_by,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Rows          []*RevenueRow          `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueReport) Reset() {
+	*x = RevenueReport{}
+	mi := &file_proto_finance_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueReport) ProtoMessage() {}
+
+func (x *RevenueReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueReport.ProtoReflect.Descriptor instead.
+func (*RevenueReport) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RevenueReport) GetGroupBy() RevenueGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return RevenueGrouping_DAY
+}
+
+func (x *RevenueReport) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RevenueReport) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RevenueReport) GetRows() []*RevenueRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type JournalExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`                                  // defaults to 30 days before to
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`                                      // defaults to now
+	Format        DocumentFormat         `protobuf:"varint,3,opt,name=format,proto3,enum=finance.DocumentFormat" json:"format,omitempty"` // CSV or IIF
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalExportRequest) Reset() {
+	*x = JournalExportRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalExportRequest) ProtoMessage() {}
+
+func (x *JournalExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalExportRequest.ProtoReflect.Descriptor instead.
+func (*JournalExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *JournalExportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *JournalExportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *JournalExportRequest) GetFormat() DocumentFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DocumentFormat_PDF
+}
+
+type CurrencyTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyTotal) Reset() {
+	*x = CurrencyTotal{}
+	mi := &file_proto_finance_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyTotal) ProtoMessage() {}
+
+func (x *CurrencyTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyTotal.ProtoReflect.Descriptor instead.
+func (*CurrencyTotal) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CurrencyTotal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyTotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CurrencyTotal) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PendingInvoiceReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Transaction         `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	Totals        []*CurrencyTotal       `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingInvoiceReport) Reset() {
+	*x = PendingInvoiceReport{}
+	mi := &file_proto_finance_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingInvoiceReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingInvoiceReport) ProtoMessage() {}
+
+func (x *PendingInvoiceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingInvoiceReport.ProtoReflect.Descriptor instead.
+func (*PendingInvoiceReport) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PendingInvoiceReport) GetInvoices() []*Transaction {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *PendingInvoiceReport) GetTotals() []*CurrencyTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type PaymentMethodReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // defaults to 30 days before to
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethodReportRequest) Reset() {
+	*x = PaymentMethodReportRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethodReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethodReportRequest) ProtoMessage() {}
+
+func (x *PaymentMethodReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethodReportRequest.ProtoReflect.Descriptor instead.
+func (*PaymentMethodReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PaymentMethodReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PaymentMethodReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type PaymentMethodRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"` // empty for invoices paid before methods were recorded
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Count         int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeAmount     int64                  `protobuf:"varint,6,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"` // kept by the payment provider
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethodRow) Reset() {
+	*x = PaymentMethodRow{}
+	mi := &file_proto_finance_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethodRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethodRow) ProtoMessage() {}
+
+func (x *PaymentMethodRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethodRow.ProtoReflect.Descriptor instead.
+func (*PaymentMethodRow) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PaymentMethodRow) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentMethodRow) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PaymentMethodRow) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentMethodRow) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PaymentMethodRow) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentMethodRow) GetFeeAmount() int64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+type PaymentMethodReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Rows          []*PaymentMethodRow    `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethodReport) Reset() {
+	*x = PaymentMethodReport{}
+	mi := &file_proto_finance_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethodReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethodReport) ProtoMessage() {}
+
+func (x *PaymentMethodReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethodReport.ProtoReflect.Descriptor instead.
+func (*PaymentMethodReport) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PaymentMethodReport) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PaymentMethodReport) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PaymentMethodReport) GetRows() []*PaymentMethodRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type WalletLiabilityReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Totals        []*CurrencyTotal       `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletLiabilityReport) Reset() {
+	*x = WalletLiabilityReport{}
+	mi := &file_proto_finance_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletLiabilityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletLiabilityReport) ProtoMessage() {}
+
+func (x *WalletLiabilityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletLiabilityReport.ProtoReflect.Descriptor instead.
+func (*WalletLiabilityReport) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WalletLiabilityReport) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *WalletLiabilityReport) GetTotals() []*CurrencyTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type JobRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Job           string                 `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	Trigger       string                 `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"` // SCHEDULE or MANUAL
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`   // RUNNING, SUCCEEDED or FAILED
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_proto_finance_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *JobRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobRun) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *JobRun) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *JobRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobRun) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *JobRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *JobRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Job struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Schedule       string                 `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Enabled        bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"` // false when the job only runs when triggered
+	NextRunAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	RecentRuns     []*JobRun              `protobuf:"bytes,7,rep,name=recent_runs,json=recentRuns,proto3" json:"recent_runs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_proto_finance_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Job) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Job) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Job) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *Job) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Job) GetRecentRuns() []*JobRun {
+	if x != nil {
+		return x.RecentRuns
+	}
+	return nil
+}
+
+type JobList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobList) Reset() {
+	*x = JobList{}
+	mi := &file_proto_finance_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobList) ProtoMessage() {}
+
+func (x *JobList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobList.ProtoReflect.Descriptor instead.
+func (*JobList) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *JobList) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type TriggerJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerJobRequest) Reset() {
+	*x = TriggerJobRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerJobRequest) ProtoMessage() {}
+
+func (x *TriggerJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerJobRequest.ProtoReflect.Descriptor instead.
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TriggerJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type WebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookRequest) Reset() {
+	*x = WebhookRequest{}
+	mi := &file_proto_finance_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookRequest) ProtoMessage() {}
+
+func (x *WebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_finance_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookRequest.ProtoReflect.Descriptor instead.
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_finance_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *WebhookRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookRequest) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
@@ -444,15 +4909,27 @@ var File_proto_finance_service_proto protoreflect.FileDescriptor
 
 const file_proto_finance_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/finance-service.proto\x12\afinance\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x96\x01\n" +
+	"\x1bproto/finance-service.proto\x12\afinance\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x02\n" +
 	"\x12TransactionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12,\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x18.finance.TransactionTypeR\x04type\x12!\n" +
-	"\freference_id\x18\x04 \x01(\tR\vreferenceId\"Q\n" +
+	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12!\n" +
+	"\fvoucher_code\x18\x06 \x01(\tR\vvoucherCode\x12\x1d\n" +
+	"\n" +
+	"species_id\x18\a \x01(\tR\tspeciesId\x12\x17\n" +
+	"\aplot_id\x18\b \x01(\tR\x06plotId\x12%\n" +
+	"\x0epayment_method\x18\t \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fpayment_channel\x18\n" +
+	" \x01(\tR\x0epaymentChannel\"\xe0\x01\n" +
 	"\fTopUpRequest\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12)\n" +
-	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds\"\x98\x02\n" +
+	"\x10duration_seconds\x18\x02 \x01(\x05R\x0fdurationSeconds\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\fvoucher_code\x18\x04 \x01(\tR\vvoucherCode\x12%\n" +
+	"\x0epayment_method\x18\x05 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fpayment_channel\x18\x06 \x01(\tR\x0epaymentChannel\"\xb9\x05\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
@@ -465,26 +4942,445 @@ const file_proto_finance_service_proto_rawDesc = "" +
 	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12!\n" +
 	"\freference_id\x18\b \x01(\tR\vreferenceId\x12\x1d\n" +
 	"\n" +
-	"invoice_id\x18\t \x01(\tR\tinvoiceId\"K\n" +
+	"invoice_id\x18\t \x01(\tR\tinvoiceId\x12\x1a\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tR\bcurrency\x12'\n" +
+	"\x0foriginal_amount\x18\v \x01(\x03R\x0eoriginalAmount\x12+\n" +
+	"\x11original_currency\x18\f \x01(\tR\x10originalCurrency\x12#\n" +
+	"\rexchange_rate\x18\r \x01(\tR\fexchangeRate\x12!\n" +
+	"\fvoucher_code\x18\x0e \x01(\tR\vvoucherCode\x12'\n" +
+	"\x0fdiscount_amount\x18\x0f \x01(\x03R\x0ediscountAmount\x12\x1c\n" +
+	"\tdirection\x18\x10 \x01(\tR\tdirection\x12'\n" +
+	"\x0fcounterparty_id\x18\x11 \x01(\tR\x0ecounterpartyId\x12%\n" +
+	"\x0epayment_amount\x18\x12 \x01(\x03R\rpaymentAmount\x12%\n" +
+	"\x0epayment_method\x18\x13 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fpayment_channel\x18\x14 \x01(\tR\x0epaymentChannel\"R\n" +
+	"\x0fTransferRequest\x12'\n" +
+	"\x0frecipient_email\x18\x01 \x01(\tR\x0erecipientEmail\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"K\n" +
 	"\x0fTransactionList\x128\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x14.finance.TransactionR\ftransactions\"+\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x14.finance.TransactionR\ftransactions\";\n" +
+	"\x0eBalanceRequest\x12)\n" +
+	"\x10display_currency\x18\x01 \x01(\tR\x0fdisplayCurrency\"\x84\x02\n" +
 	"\x0fBalanceResponse\x12\x18\n" +
-	"\abalance\x18\x01 \x01(\x03R\abalance\":\n" +
+	"\abalance\x18\x01 \x01(\x03R\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fdisplay_balance\x18\x03 \x01(\x03R\x0edisplayBalance\x12)\n" +
+	"\x10display_currency\x18\x04 \x01(\tR\x0fdisplayCurrency\x12+\n" +
+	"\x11available_balance\x18\x05 \x01(\x03R\x10availableBalance\x12:\n" +
+	"\x19display_available_balance\x18\x06 \x01(\x03R\x17displayAvailableBalance\"\xf8\x02\n" +
+	"\vBalanceHold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12!\n" +
+	"\freference_id\x18\x06 \x01(\tR\vreferenceId\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12%\n" +
+	"\x0etransaction_id\x18\t \x01(\tR\rtransactionId\x129\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc6\x01\n" +
+	"\x18CreateBalanceHoldRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.finance.TransactionTypeR\x04type\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\x05R\n" +
+	"ttlSeconds\"-\n" +
+	"\x12BalanceHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\"=\n" +
+	"\x0fBalanceHoldList\x12*\n" +
+	"\x05holds\x18\x01 \x03(\v2\x14.finance.BalanceHoldR\x05holds\"\x86\x03\n" +
+	"\vTopUpReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05rules\x18\x06 \x03(\tR\x05rules\x12\x18\n" +
+	"\areasons\x18\a \x01(\tR\areasons\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewer_id\x18\t \x01(\tR\n" +
+	"reviewerId\x12\x12\n" +
+	"\x04note\x18\n" +
+	" \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreviewed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\"1\n" +
+	"\x17ListTopUpReviewsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"A\n" +
+	"\x0fTopUpReviewList\x12.\n" +
+	"\areviews\x18\x01 \x03(\v2\x14.finance.TopUpReviewR\areviews\"K\n" +
+	"\x18DecideTopUpReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"\xcf\x03\n" +
+	"\x11BalanceAdjustment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12)\n" +
+	"\x10ticket_reference\x18\x06 \x01(\tR\x0fticketReference\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\t \x01(\tR\tdecidedBy\x12#\n" +
+	"\rdecision_note\x18\n" +
+	" \x01(\tR\fdecisionNote\x12%\n" +
+	"\x0etransaction_id\x18\v \x01(\tR\rtransactionId\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"decided_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"\x8e\x01\n" +
+	"\x18BalanceAdjustmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12)\n" +
+	"\x10ticket_reference\x18\x04 \x01(\tR\x0fticketReference\"Y\n" +
+	"\x1eDecideBalanceAdjustmentRequest\x12#\n" +
+	"\radjustment_id\x18\x01 \x01(\tR\fadjustmentId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"7\n" +
+	"\x1dListBalanceAdjustmentsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"U\n" +
+	"\x15BalanceAdjustmentList\x12<\n" +
+	"\vadjustments\x18\x01 \x03(\v2\x1a.finance.BalanceAdjustmentR\vadjustments\"\xc0\x02\n" +
+	"\aPartner\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1b\n" +
+	"\tbank_name\x18\x04 \x01(\tR\bbankName\x12.\n" +
+	"\x13bank_account_number\x18\x05 \x01(\tR\x11bankAccountNumber\x12*\n" +
+	"\x11bank_account_name\x18\x06 \x01(\tR\x0fbankAccountName\x12\x1b\n" +
+	"\tshare_bps\x18\a \x01(\x05R\bshareBps\x12*\n" +
+	"\x05plots\x18\b \x03(\v2\x14.finance.PartnerPlotR\x05plots\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"C\n" +
+	"\vPartnerPlot\x12\x17\n" +
+	"\aplot_id\x18\x01 \x01(\tR\x06plotId\x12\x1b\n" +
+	"\tshare_bps\x18\x02 \x01(\x05R\bshareBps\";\n" +
+	"\vPartnerList\x12,\n" +
+	"\bpartners\x18\x01 \x03(\v2\x10.finance.PartnerR\bpartners\"C\n" +
+	"\x17CalculatePayoutsRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\"\x94\x04\n" +
+	"\x06Payout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x02 \x01(\tR\tpartnerId\x12!\n" +
+	"\fpartner_name\x18\x03 \x01(\tR\vpartnerName\x12=\n" +
+	"\fperiod_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12%\n" +
+	"\x0erevenue_amount\x18\a \x01(\x03R\rrevenueAmount\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\treference\x18\n" +
+	" \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04note\x12)\n" +
+	"\x05lines\x18\f \x03(\v2\x13.finance.PayoutLineR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\apaid_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\"\xcb\x01\n" +
+	"\n" +
+	"PayoutLine\x12\x17\n" +
+	"\aplot_id\x18\x01 \x01(\tR\x06plotId\x12\x1b\n" +
+	"\tplot_name\x18\x02 \x01(\tR\bplotName\x12+\n" +
+	"\x11transaction_count\x18\x03 \x01(\x03R\x10transactionCount\x12%\n" +
+	"\x0erevenue_amount\x18\x04 \x01(\x03R\rrevenueAmount\x12\x1b\n" +
+	"\tshare_bps\x18\x05 \x01(\x05R\bshareBps\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\"7\n" +
+	"\n" +
+	"PayoutList\x12)\n" +
+	"\apayouts\x18\x01 \x03(\v2\x0f.finance.PayoutR\apayouts\"K\n" +
+	"\x12ListPayoutsRequest\x12\x1d\n" +
+	"\n" +
+	"partner_id\x18\x01 \x01(\tR\tpartnerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"|\n" +
+	"\x13PayoutStatusRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"f\n" +
+	"\x16PayoutStatementRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\x12/\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.finance.DocumentFormatR\x06format\"\xfa\x03\n" +
+	"\x10MatchingCampaign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\forganization\x18\x03 \x01(\tR\forganization\x12#\n" +
+	"\remail_domains\x18\x04 \x03(\tR\femailDomains\x12\x1f\n" +
+	"\vmatch_ratio\x18\x05 \x01(\x05R\n" +
+	"matchRatio\x123\n" +
+	"\x16max_trees_per_employee\x18\x06 \x01(\x05R\x13maxTreesPerEmployee\x12#\n" +
+	"\rbudget_amount\x18\a \x01(\x03R\fbudgetAmount\x12!\n" +
+	"\fspent_amount\x18\b \x01(\x03R\vspentAmount\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x127\n" +
+	"\tstarts_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"O\n" +
+	"\x14MatchingCampaignList\x127\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x19.finance.MatchingCampaignR\tcampaigns\"X\n" +
+	"\x1dMatchingCampaignStatusRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xa8\x02\n" +
+	"\rCampaignMatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcampaign_id\x18\x02 \x01(\tR\n" +
+	"campaignId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\x12\x14\n" +
+	"\x05trees\x18\x06 \x01(\x05R\x05trees\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"=\n" +
+	"\x1aListCampaignMatchesRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\"E\n" +
+	"\x11CampaignMatchList\x120\n" +
+	"\amatches\x18\x01 \x03(\v2\x16.finance.CampaignMatchR\amatches\"n\n" +
+	"\fExchangeRate\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04rate\x18\x02 \x01(\tR\x04rate\x12.\n" +
+	"\x13minor_unit_exponent\x18\x03 \x01(\x05R\x11minorUnitExponent\"d\n" +
+	"\x10ExchangeRateList\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12+\n" +
+	"\x05rates\x18\x02 \x03(\v2\x15.finance.ExchangeRateR\x05rates\"=\n" +
+	"\x14CancelPaymentRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"h\n" +
+	"\x0eReceiptRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12/\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.finance.DocumentFormatR\x06format\"\x9f\x01\n" +
+	"\x10StatementRequest\x120\n" +
+	"\x06period\x18\x01 \x01(\x0e2\x18.finance.StatementPeriodR\x06period\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\x05R\x05month\x12/\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x17.finance.DocumentFormatR\x06format\"d\n" +
+	"\bDocument\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"l\n" +
+	"\x12CertificateRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12+\n" +
+	"\x11organization_name\x18\x02 \x01(\tR\x10organizationName\x12\x15\n" +
+	"\x06tax_id\x18\x03 \x01(\tR\x05taxId\"G\n" +
+	"\x18VerifyCertificateRequest\x12+\n" +
+	"\x11verification_code\x18\x01 \x01(\tR\x10verificationCode\"\xbe\x02\n" +
+	"\x17CertificateVerification\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12-\n" +
+	"\x12certificate_number\x18\x02 \x01(\tR\x11certificateNumber\x12%\n" +
+	"\x0erecipient_name\x18\x03 \x01(\tR\rrecipientName\x12+\n" +
+	"\x11organization_name\x18\x04 \x01(\tR\x10organizationName\x12\x12\n" +
+	"\x04year\x18\x05 \x01(\x05R\x04year\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x03R\vtotalAmount\x127\n" +
+	"\tissued_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"\xb3\x02\n" +
+	"\x19ReconciliationDiscrepancy\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12!\n" +
+	"\flocal_status\x18\a \x01(\tR\vlocalStatus\x12'\n" +
+	"\x0fprovider_status\x18\b \x01(\tR\x0eproviderStatus\x12\x16\n" +
+	"\x06action\x18\t \x01(\tR\x06action\x12\x16\n" +
+	"\x06detail\x18\n" +
+	" \x01(\tR\x06detail\"\x98\x02\n" +
+	"\x14ReconciliationReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x18\n" +
+	"\achecked\x18\x04 \x01(\x05R\achecked\x12\x14\n" +
+	"\x05fixed\x18\x05 \x01(\x05R\x05fixed\x12H\n" +
+	"\rdiscrepancies\x18\x06 \x03(\v2\".finance.ReconciliationDiscrepancyR\rdiscrepancies\"8\n" +
+	" ListReconciliationReportsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"S\n" +
+	"\x18ReconciliationReportList\x127\n" +
+	"\areports\x18\x01 \x03(\v2\x1d.finance.ReconciliationReportR\areports\"\xb2\x04\n" +
+	"\aVoucher\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdiscount_type\x18\x04 \x01(\tR\fdiscountType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x03R\x05value\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12)\n" +
+	"\x10transaction_type\x18\a \x01(\tR\x0ftransactionType\x12\x1d\n" +
+	"\n" +
+	"species_id\x18\b \x01(\tR\tspeciesId\x12\x17\n" +
+	"\aplot_id\x18\t \x01(\tR\x06plotId\x12'\n" +
+	"\x0fmax_redemptions\x18\n" +
+	" \x01(\x05R\x0emaxRedemptions\x12 \n" +
+	"\fmax_per_user\x18\v \x01(\x05R\n" +
+	"maxPerUser\x12)\n" +
+	"\x10redemption_count\x18\f \x01(\x05R\x0fredemptionCount\x129\n" +
+	"\n" +
+	"valid_from\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\";\n" +
+	"\vVoucherList\x12,\n" +
+	"\bvouchers\x18\x01 \x03(\v2\x10.finance.VoucherR\bvouchers\"\xa7\x01\n" +
+	"\x14RevenueReportRequest\x123\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x18.finance.RevenueGroupingR\agroupBy\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xbe\x01\n" +
+	"\n" +
+	"RevenueRow\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12+\n" +
+	"\x11transaction_count\x18\x04 \x01(\x03R\x10transactionCount\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12'\n" +
+	"\x0fdiscount_amount\x18\x06 \x01(\x03R\x0ediscountAmount\"\xc9\x01\n" +
+	"\rRevenueReport\x123\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x18.finance.RevenueGroupingR\agroupBy\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12'\n" +
+	"\x04rows\x18\x04 \x03(\v2\x13.finance.RevenueRowR\x04rows\"\xa3\x01\n" +
+	"\x14JournalExportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12/\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x17.finance.DocumentFormatR\x06format\"Y\n" +
+	"\rCurrencyTotal\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"x\n" +
+	"\x14PendingInvoiceReport\x120\n" +
+	"\binvoices\x18\x01 \x03(\v2\x14.finance.TransactionR\binvoices\x12.\n" +
+	"\x06totals\x18\x02 \x03(\v2\x16.finance.CurrencyTotalR\x06totals\"x\n" +
+	"\x1aPaymentMethodReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xad\x01\n" +
+	"\x10PaymentMethodRow\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\x06 \x01(\x03R\tfeeAmount\"\xa0\x01\n" +
+	"\x13PaymentMethodReport\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12-\n" +
+	"\x04rows\x18\x03 \x03(\v2\x19.finance.PaymentMethodRowR\x04rows\"x\n" +
+	"\x15WalletLiabilityReport\x12/\n" +
+	"\x05as_of\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12.\n" +
+	"\x06totals\x18\x02 \x03(\v2\x16.finance.CurrencyTotalR\x06totals\"\xca\x02\n" +
+	"\x06JobRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03job\x18\x02 \x01(\tR\x03job\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12=\n" +
+	"\fscheduled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\x88\x02\n" +
+	"\x03Job\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12:\n" +
+	"\vnext_run_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12'\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x03R\x0etimeoutSeconds\x120\n" +
+	"\vrecent_runs\x18\a \x03(\v2\x0f.finance.JobRunR\n" +
+	"recentRuns\"+\n" +
+	"\aJobList\x12 \n" +
+	"\x04jobs\x18\x01 \x03(\v2\f.finance.JobR\x04jobs\"'\n" +
+	"\x11TriggerJobRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\":\n" +
 	"\x0eWebhookRequest\x12\x14\n" +
 	"\x05event\x18\x01 \x01(\tR\x05event\x12\x12\n" +
-	"\x04data\x18\x02 \x01(\fR\x04data*3\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data*Q\n" +
 	"\x0fTransactionType\x12\v\n" +
 	"\aDEPOSIT\x10\x00\x12\t\n" +
 	"\x05ADOPT\x10\x01\x12\b\n" +
-	"\x04CARE\x10\x022\xad\x03\n" +
+	"\x04CARE\x10\x02\x12\f\n" +
+	"\bTRANSFER\x10\x03\x12\x0e\n" +
+	"\n" +
+	"ADJUSTMENT\x10\x04*+\n" +
+	"\x0eDocumentFormat\x12\a\n" +
+	"\x03PDF\x10\x00\x12\a\n" +
+	"\x03CSV\x10\x01\x12\a\n" +
+	"\x03IIF\x10\x02**\n" +
+	"\x0fStatementPeriod\x12\v\n" +
+	"\aMONTHLY\x10\x00\x12\n" +
+	"\n" +
+	"\x06ANNUAL\x10\x01*F\n" +
+	"\x0fRevenueGrouping\x12\a\n" +
+	"\x03DAY\x10\x00\x12\t\n" +
+	"\x05MONTH\x10\x01\x12\b\n" +
+	"\x04TYPE\x10\x02\x12\v\n" +
+	"\aSPECIES\x10\x03\x12\b\n" +
+	"\x04PLOT\x10\x042\xff\x1a\n" +
 	"\x0eFinanceService\x12F\n" +
 	"\x11CreateTransaction\x12\x1b.finance.TransactionRequest\x1a\x14.finance.Transaction\x12:\n" +
-	"\vTopUpWallet\x12\x15.finance.TopUpRequest\x1a\x14.finance.Transaction\x12F\n" +
-	"\x13HandleWalletWebhook\x12\x17.finance.WebhookRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\vTopUpWallet\x12\x15.finance.TopUpRequest\x1a\x14.finance.Transaction\x12A\n" +
+	"\x0fTransferBalance\x12\x18.finance.TransferRequest\x1a\x14.finance.Transaction\x12F\n" +
+	"\x13HandleWalletWebhook\x12\x17.finance.WebhookRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
 	"\n" +
-	"GetBalance\x12\x16.google.protobuf.Empty\x1a\x18.finance.BalanceResponse\x12I\n" +
+	"GetBalance\x12\x17.finance.BalanceRequest\x1a\x18.finance.BalanceResponse\x12I\n" +
 	"\x15GetTransactionHistory\x12\x16.google.protobuf.Empty\x1a\x18.finance.TransactionList\x12D\n" +
-	"\x12CheckPaymentExpiry\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.EmptyB\x11Z\x0freforest/pkg/pbb\x06proto3"
+	"\rCancelPayment\x12\x1d.finance.CancelPaymentRequest\x1a\x14.finance.Transaction\x12L\n" +
+	"\x11CreateBalanceHold\x12!.finance.CreateBalanceHoldRequest\x1a\x14.finance.BalanceHold\x12G\n" +
+	"\x12CaptureBalanceHold\x12\x1b.finance.BalanceHoldRequest\x1a\x14.finance.BalanceHold\x12G\n" +
+	"\x12ReleaseBalanceHold\x12\x1b.finance.BalanceHoldRequest\x1a\x14.finance.BalanceHold\x12D\n" +
+	"\x10ListBalanceHolds\x12\x16.google.protobuf.Empty\x1a\x18.finance.BalanceHoldList\x12D\n" +
+	"\x12CheckPaymentExpiry\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\n" +
+	"GetReceipt\x12\x17.finance.ReceiptRequest\x1a\x11.finance.Document\x12<\n" +
+	"\fGetStatement\x12\x19.finance.StatementRequest\x1a\x11.finance.Document\x12J\n" +
+	"\x18IssueDonationCertificate\x12\x1b.finance.CertificateRequest\x1a\x11.finance.Document\x12`\n" +
+	"\x19VerifyDonationCertificate\x12!.finance.VerifyCertificateRequest\x1a .finance.CertificateVerification\x12E\n" +
+	"\x10GetExchangeRates\x12\x16.google.protobuf.Empty\x1a\x19.finance.ExchangeRateList\x12J\n" +
+	"\x11ReconcilePayments\x12\x16.google.protobuf.Empty\x1a\x1d.finance.ReconciliationReport\x12i\n" +
+	"\x19ListReconciliationReports\x12).finance.ListReconciliationReportsRequest\x1a!.finance.ReconciliationReportList\x123\n" +
+	"\rCreateVoucher\x12\x10.finance.Voucher\x1a\x10.finance.Voucher\x12<\n" +
+	"\fListVouchers\x12\x16.google.protobuf.Empty\x1a\x14.finance.VoucherList\x12N\n" +
+	"\x10ListTopUpReviews\x12 .finance.ListTopUpReviewsRequest\x1a\x18.finance.TopUpReviewList\x12M\n" +
+	"\x12ApproveTopUpReview\x12!.finance.DecideTopUpReviewRequest\x1a\x14.finance.TopUpReview\x12L\n" +
+	"\x11RejectTopUpReview\x12!.finance.DecideTopUpReviewRequest\x1a\x14.finance.TopUpReview\x12Y\n" +
+	"\x18RequestBalanceAdjustment\x12!.finance.BalanceAdjustmentRequest\x1a\x1a.finance.BalanceAdjustment\x12_\n" +
+	"\x18ApproveBalanceAdjustment\x12'.finance.DecideBalanceAdjustmentRequest\x1a\x1a.finance.BalanceAdjustment\x12^\n" +
+	"\x17RejectBalanceAdjustment\x12'.finance.DecideBalanceAdjustmentRequest\x1a\x1a.finance.BalanceAdjustment\x12`\n" +
+	"\x16ListBalanceAdjustments\x12&.finance.ListBalanceAdjustmentsRequest\x1a\x1e.finance.BalanceAdjustmentList\x123\n" +
+	"\rCreatePartner\x12\x10.finance.Partner\x1a\x10.finance.Partner\x123\n" +
+	"\rUpdatePartner\x12\x10.finance.Partner\x1a\x10.finance.Partner\x12<\n" +
+	"\fListPartners\x12\x16.google.protobuf.Empty\x1a\x14.finance.PartnerList\x12I\n" +
+	"\x10CalculatePayouts\x12 .finance.CalculatePayoutsRequest\x1a\x13.finance.PayoutList\x12?\n" +
+	"\vListPayouts\x12\x1b.finance.ListPayoutsRequest\x1a\x13.finance.PayoutList\x12C\n" +
+	"\x12UpdatePayoutStatus\x12\x1c.finance.PayoutStatusRequest\x1a\x0f.finance.Payout\x12H\n" +
+	"\x12GetPayoutStatement\x12\x1f.finance.PayoutStatementRequest\x1a\x11.finance.Document\x12N\n" +
+	"\x16CreateMatchingCampaign\x12\x19.finance.MatchingCampaign\x1a\x19.finance.MatchingCampaign\x12N\n" +
+	"\x15ListMatchingCampaigns\x12\x16.google.protobuf.Empty\x1a\x1d.finance.MatchingCampaignList\x12a\n" +
+	"\x1cUpdateMatchingCampaignStatus\x12&.finance.MatchingCampaignStatusRequest\x1a\x19.finance.MatchingCampaign\x12V\n" +
+	"\x13ListCampaignMatches\x12#.finance.ListCampaignMatchesRequest\x1a\x1a.finance.CampaignMatchList\x12I\n" +
+	"\x10GetRevenueReport\x12\x1d.finance.RevenueReportRequest\x1a\x16.finance.RevenueReport\x12P\n" +
+	"\x17GetPendingInvoiceReport\x12\x16.google.protobuf.Empty\x1a\x1d.finance.PendingInvoiceReport\x12R\n" +
+	"\x18GetWalletLiabilityReport\x12\x16.google.protobuf.Empty\x1a\x1e.finance.WalletLiabilityReport\x12[\n" +
+	"\x16GetPaymentMethodReport\x12#.finance.PaymentMethodReportRequest\x1a\x1c.finance.PaymentMethodReport\x12A\n" +
+	"\rExportJournal\x12\x1d.finance.JournalExportRequest\x1a\x11.finance.Document\x124\n" +
+	"\bListJobs\x12\x16.google.protobuf.Empty\x1a\x10.finance.JobList\x129\n" +
+	"\n" +
+	"TriggerJob\x12\x1a.finance.TriggerJobRequest\x1a\x0f.finance.JobRunB\x11Z\x0freforest/pkg/pbb\x06proto3"
 
 var (
 	file_proto_finance_service_proto_rawDescOnce sync.Once
@@ -498,40 +5394,247 @@ func file_proto_finance_service_proto_rawDescGZIP() []byte {
 	return file_proto_finance_service_proto_rawDescData
 }
 
-var file_proto_finance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_finance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_finance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_finance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_proto_finance_service_proto_goTypes = []any{
-	(TransactionType)(0),          // 0: finance.TransactionType
-	(*TransactionRequest)(nil),    // 1: finance.TransactionRequest
-	(*TopUpRequest)(nil),          // 2: finance.TopUpRequest
-	(*Transaction)(nil),           // 3: finance.Transaction
-	(*TransactionList)(nil),       // 4: finance.TransactionList
-	(*BalanceResponse)(nil),       // 5: finance.BalanceResponse
-	(*WebhookRequest)(nil),        // 6: finance.WebhookRequest
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(TransactionType)(0),                     // 0: finance.TransactionType
+	(DocumentFormat)(0),                      // 1: finance.DocumentFormat
+	(StatementPeriod)(0),                     // 2: finance.StatementPeriod
+	(RevenueGrouping)(0),                     // 3: finance.RevenueGrouping
+	(*TransactionRequest)(nil),               // 4: finance.TransactionRequest
+	(*TopUpRequest)(nil),                     // 5: finance.TopUpRequest
+	(*Transaction)(nil),                      // 6: finance.Transaction
+	(*TransferRequest)(nil),                  // 7: finance.TransferRequest
+	(*TransactionList)(nil),                  // 8: finance.TransactionList
+	(*BalanceRequest)(nil),                   // 9: finance.BalanceRequest
+	(*BalanceResponse)(nil),                  // 10: finance.BalanceResponse
+	(*BalanceHold)(nil),                      // 11: finance.BalanceHold
+	(*CreateBalanceHoldRequest)(nil),         // 12: finance.CreateBalanceHoldRequest
+	(*BalanceHoldRequest)(nil),               // 13: finance.BalanceHoldRequest
+	(*BalanceHoldList)(nil),                  // 14: finance.BalanceHoldList
+	(*TopUpReview)(nil),                      // 15: finance.TopUpReview
+	(*ListTopUpReviewsRequest)(nil),          // 16: finance.ListTopUpReviewsRequest
+	(*TopUpReviewList)(nil),                  // 17: finance.TopUpReviewList
+	(*DecideTopUpReviewRequest)(nil),         // 18: finance.DecideTopUpReviewRequest
+	(*BalanceAdjustment)(nil),                // 19: finance.BalanceAdjustment
+	(*BalanceAdjustmentRequest)(nil),         // 20: finance.BalanceAdjustmentRequest
+	(*DecideBalanceAdjustmentRequest)(nil),   // 21: finance.DecideBalanceAdjustmentRequest
+	(*ListBalanceAdjustmentsRequest)(nil),    // 22: finance.ListBalanceAdjustmentsRequest
+	(*BalanceAdjustmentList)(nil),            // 23: finance.BalanceAdjustmentList
+	(*Partner)(nil),                          // 24: finance.Partner
+	(*PartnerPlot)(nil),                      // 25: finance.PartnerPlot
+	(*PartnerList)(nil),                      // 26: finance.PartnerList
+	(*CalculatePayoutsRequest)(nil),          // 27: finance.CalculatePayoutsRequest
+	(*Payout)(nil),                           // 28: finance.Payout
+	(*PayoutLine)(nil),                       // 29: finance.PayoutLine
+	(*PayoutList)(nil),                       // 30: finance.PayoutList
+	(*ListPayoutsRequest)(nil),               // 31: finance.ListPayoutsRequest
+	(*PayoutStatusRequest)(nil),              // 32: finance.PayoutStatusRequest
+	(*PayoutStatementRequest)(nil),           // 33: finance.PayoutStatementRequest
+	(*MatchingCampaign)(nil),                 // 34: finance.MatchingCampaign
+	(*MatchingCampaignList)(nil),             // 35: finance.MatchingCampaignList
+	(*MatchingCampaignStatusRequest)(nil),    // 36: finance.MatchingCampaignStatusRequest
+	(*CampaignMatch)(nil),                    // 37: finance.CampaignMatch
+	(*ListCampaignMatchesRequest)(nil),       // 38: finance.ListCampaignMatchesRequest
+	(*CampaignMatchList)(nil),                // 39: finance.CampaignMatchList
+	(*ExchangeRate)(nil),                     // 40: finance.ExchangeRate
+	(*ExchangeRateList)(nil),                 // 41: finance.ExchangeRateList
+	(*CancelPaymentRequest)(nil),             // 42: finance.CancelPaymentRequest
+	(*ReceiptRequest)(nil),                   // 43: finance.ReceiptRequest
+	(*StatementRequest)(nil),                 // 44: finance.StatementRequest
+	(*Document)(nil),                         // 45: finance.Document
+	(*CertificateRequest)(nil),               // 46: finance.CertificateRequest
+	(*VerifyCertificateRequest)(nil),         // 47: finance.VerifyCertificateRequest
+	(*CertificateVerification)(nil),          // 48: finance.CertificateVerification
+	(*ReconciliationDiscrepancy)(nil),        // 49: finance.ReconciliationDiscrepancy
+	(*ReconciliationReport)(nil),             // 50: finance.ReconciliationReport
+	(*ListReconciliationReportsRequest)(nil), // 51: finance.ListReconciliationReportsRequest
+	(*ReconciliationReportList)(nil),         // 52: finance.ReconciliationReportList
+	(*Voucher)(nil),                          // 53: finance.Voucher
+	(*VoucherList)(nil),                      // 54: finance.VoucherList
+	(*RevenueReportRequest)(nil),             // 55: finance.RevenueReportRequest
+	(*RevenueRow)(nil),                       // 56: finance.RevenueRow
+	(*RevenueReport)(nil),                    // 57: finance.RevenueReport
+	(*JournalExportRequest)(nil),             // 58: finance.JournalExportRequest
+	(*CurrencyTotal)(nil),                    // 59: finance.CurrencyTotal
+	(*PendingInvoiceReport)(nil),             // 60: finance.PendingInvoiceReport
+	(*PaymentMethodReportRequest)(nil),       // 61: finance.PaymentMethodReportRequest
+	(*PaymentMethodRow)(nil),                 // 62: finance.PaymentMethodRow
+	(*PaymentMethodReport)(nil),              // 63: finance.PaymentMethodReport
+	(*WalletLiabilityReport)(nil),            // 64: finance.WalletLiabilityReport
+	(*JobRun)(nil),                           // 65: finance.JobRun
+	(*Job)(nil),                              // 66: finance.Job
+	(*JobList)(nil),                          // 67: finance.JobList
+	(*TriggerJobRequest)(nil),                // 68: finance.TriggerJobRequest
+	(*WebhookRequest)(nil),                   // 69: finance.WebhookRequest
+	(*timestamppb.Timestamp)(nil),            // 70: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 71: google.protobuf.Empty
 }
 var file_proto_finance_service_proto_depIdxs = []int32{
-	0, // 0: finance.TransactionRequest.type:type_name -> finance.TransactionType
-	7, // 1: finance.Transaction.expires_at:type_name -> google.protobuf.Timestamp
-	3, // 2: finance.TransactionList.transactions:type_name -> finance.Transaction
-	1, // 3: finance.FinanceService.CreateTransaction:input_type -> finance.TransactionRequest
-	2, // 4: finance.FinanceService.TopUpWallet:input_type -> finance.TopUpRequest
-	6, // 5: finance.FinanceService.HandleWalletWebhook:input_type -> finance.WebhookRequest
-	8, // 6: finance.FinanceService.GetBalance:input_type -> google.protobuf.Empty
-	8, // 7: finance.FinanceService.GetTransactionHistory:input_type -> google.protobuf.Empty
-	8, // 8: finance.FinanceService.CheckPaymentExpiry:input_type -> google.protobuf.Empty
-	3, // 9: finance.FinanceService.CreateTransaction:output_type -> finance.Transaction
-	3, // 10: finance.FinanceService.TopUpWallet:output_type -> finance.Transaction
-	8, // 11: finance.FinanceService.HandleWalletWebhook:output_type -> google.protobuf.Empty
-	5, // 12: finance.FinanceService.GetBalance:output_type -> finance.BalanceResponse
-	4, // 13: finance.FinanceService.GetTransactionHistory:output_type -> finance.TransactionList
-	8, // 14: finance.FinanceService.CheckPaymentExpiry:output_type -> google.protobuf.Empty
-	9, // [9:15] is the sub-list for method output_type
-	3, // [3:9] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,   // 0: finance.TransactionRequest.type:type_name -> finance.TransactionType
+	70,  // 1: finance.Transaction.expires_at:type_name -> google.protobuf.Timestamp
+	6,   // 2: finance.TransactionList.transactions:type_name -> finance.Transaction
+	70,  // 3: finance.BalanceHold.expires_at:type_name -> google.protobuf.Timestamp
+	70,  // 4: finance.BalanceHold.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 5: finance.CreateBalanceHoldRequest.type:type_name -> finance.TransactionType
+	11,  // 6: finance.BalanceHoldList.holds:type_name -> finance.BalanceHold
+	70,  // 7: finance.TopUpReview.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 8: finance.TopUpReview.reviewed_at:type_name -> google.protobuf.Timestamp
+	15,  // 9: finance.TopUpReviewList.reviews:type_name -> finance.TopUpReview
+	70,  // 10: finance.BalanceAdjustment.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 11: finance.BalanceAdjustment.decided_at:type_name -> google.protobuf.Timestamp
+	19,  // 12: finance.BalanceAdjustmentList.adjustments:type_name -> finance.BalanceAdjustment
+	25,  // 13: finance.Partner.plots:type_name -> finance.PartnerPlot
+	70,  // 14: finance.Partner.created_at:type_name -> google.protobuf.Timestamp
+	24,  // 15: finance.PartnerList.partners:type_name -> finance.Partner
+	70,  // 16: finance.Payout.period_start:type_name -> google.protobuf.Timestamp
+	70,  // 17: finance.Payout.period_end:type_name -> google.protobuf.Timestamp
+	29,  // 18: finance.Payout.lines:type_name -> finance.PayoutLine
+	70,  // 19: finance.Payout.created_at:type_name -> google.protobuf.Timestamp
+	70,  // 20: finance.Payout.paid_at:type_name -> google.protobuf.Timestamp
+	28,  // 21: finance.PayoutList.payouts:type_name -> finance.Payout
+	1,   // 22: finance.PayoutStatementRequest.format:type_name -> finance.DocumentFormat
+	70,  // 23: finance.MatchingCampaign.starts_at:type_name -> google.protobuf.Timestamp
+	70,  // 24: finance.MatchingCampaign.ends_at:type_name -> google.protobuf.Timestamp
+	70,  // 25: finance.MatchingCampaign.created_at:type_name -> google.protobuf.Timestamp
+	34,  // 26: finance.MatchingCampaignList.campaigns:type_name -> finance.MatchingCampaign
+	70,  // 27: finance.CampaignMatch.created_at:type_name -> google.protobuf.Timestamp
+	37,  // 28: finance.CampaignMatchList.matches:type_name -> finance.CampaignMatch
+	40,  // 29: finance.ExchangeRateList.rates:type_name -> finance.ExchangeRate
+	1,   // 30: finance.ReceiptRequest.format:type_name -> finance.DocumentFormat
+	2,   // 31: finance.StatementRequest.period:type_name -> finance.StatementPeriod
+	1,   // 32: finance.StatementRequest.format:type_name -> finance.DocumentFormat
+	70,  // 33: finance.CertificateVerification.issued_at:type_name -> google.protobuf.Timestamp
+	70,  // 34: finance.ReconciliationReport.started_at:type_name -> google.protobuf.Timestamp
+	70,  // 35: finance.ReconciliationReport.finished_at:type_name -> google.protobuf.Timestamp
+	49,  // 36: finance.ReconciliationReport.discrepancies:type_name -> finance.ReconciliationDiscrepancy
+	50,  // 37: finance.ReconciliationReportList.reports:type_name -> finance.ReconciliationReport
+	70,  // 38: finance.Voucher.valid_from:type_name -> google.protobuf.Timestamp
+	70,  // 39: finance.Voucher.valid_until:type_name -> google.protobuf.Timestamp
+	70,  // 40: finance.Voucher.created_at:type_name -> google.protobuf.Timestamp
+	53,  // 41: finance.VoucherList.vouchers:type_name -> finance.Voucher
+	3,   // 42: finance.RevenueReportRequest.group_by:type_name -> finance.RevenueGrouping
+	70,  // 43: finance.RevenueReportRequest.from:type_name -> google.protobuf.Timestamp
+	70,  // 44: finance.RevenueReportRequest.to:type_name -> google.protobuf.Timestamp
+	3,   // 45: finance.RevenueReport.group_by:type_name -> finance.RevenueGrouping
+	70,  // 46: finance.RevenueReport.from:type_name -> google.protobuf.Timestamp
+	70,  // 47: finance.RevenueReport.to:type_name -> google.protobuf.Timestamp
+	56,  // 48: finance.RevenueReport.rows:type_name -> finance.RevenueRow
+	70,  // 49: finance.JournalExportRequest.from:type_name -> google.protobuf.Timestamp
+	70,  // 50: finance.JournalExportRequest.to:type_name -> google.protobuf.Timestamp
+	1,   // 51: finance.JournalExportRequest.format:type_name -> finance.DocumentFormat
+	6,   // 52: finance.PendingInvoiceReport.invoices:type_name -> finance.Transaction
+	59,  // 53: finance.PendingInvoiceReport.totals:type_name -> finance.CurrencyTotal
+	70,  // 54: finance.PaymentMethodReportRequest.from:type_name -> google.protobuf.Timestamp
+	70,  // 55: finance.PaymentMethodReportRequest.to:type_name -> google.protobuf.Timestamp
+	70,  // 56: finance.PaymentMethodReport.from:type_name -> google.protobuf.Timestamp
+	70,  // 57: finance.PaymentMethodReport.to:type_name -> google.protobuf.Timestamp
+	62,  // 58: finance.PaymentMethodReport.rows:type_name -> finance.PaymentMethodRow
+	70,  // 59: finance.WalletLiabilityReport.as_of:type_name -> google.protobuf.Timestamp
+	59,  // 60: finance.WalletLiabilityReport.totals:type_name -> finance.CurrencyTotal
+	70,  // 61: finance.JobRun.scheduled_at:type_name -> google.protobuf.Timestamp
+	70,  // 62: finance.JobRun.started_at:type_name -> google.protobuf.Timestamp
+	70,  // 63: finance.JobRun.finished_at:type_name -> google.protobuf.Timestamp
+	70,  // 64: finance.Job.next_run_at:type_name -> google.protobuf.Timestamp
+	65,  // 65: finance.Job.recent_runs:type_name -> finance.JobRun
+	66,  // 66: finance.JobList.jobs:type_name -> finance.Job
+	4,   // 67: finance.FinanceService.CreateTransaction:input_type -> finance.TransactionRequest
+	5,   // 68: finance.FinanceService.TopUpWallet:input_type -> finance.TopUpRequest
+	7,   // 69: finance.FinanceService.TransferBalance:input_type -> finance.TransferRequest
+	69,  // 70: finance.FinanceService.HandleWalletWebhook:input_type -> finance.WebhookRequest
+	9,   // 71: finance.FinanceService.GetBalance:input_type -> finance.BalanceRequest
+	71,  // 72: finance.FinanceService.GetTransactionHistory:input_type -> google.protobuf.Empty
+	42,  // 73: finance.FinanceService.CancelPayment:input_type -> finance.CancelPaymentRequest
+	12,  // 74: finance.FinanceService.CreateBalanceHold:input_type -> finance.CreateBalanceHoldRequest
+	13,  // 75: finance.FinanceService.CaptureBalanceHold:input_type -> finance.BalanceHoldRequest
+	13,  // 76: finance.FinanceService.ReleaseBalanceHold:input_type -> finance.BalanceHoldRequest
+	71,  // 77: finance.FinanceService.ListBalanceHolds:input_type -> google.protobuf.Empty
+	71,  // 78: finance.FinanceService.CheckPaymentExpiry:input_type -> google.protobuf.Empty
+	43,  // 79: finance.FinanceService.GetReceipt:input_type -> finance.ReceiptRequest
+	44,  // 80: finance.FinanceService.GetStatement:input_type -> finance.StatementRequest
+	46,  // 81: finance.FinanceService.IssueDonationCertificate:input_type -> finance.CertificateRequest
+	47,  // 82: finance.FinanceService.VerifyDonationCertificate:input_type -> finance.VerifyCertificateRequest
+	71,  // 83: finance.FinanceService.GetExchangeRates:input_type -> google.protobuf.Empty
+	71,  // 84: finance.FinanceService.ReconcilePayments:input_type -> google.protobuf.Empty
+	51,  // 85: finance.FinanceService.ListReconciliationReports:input_type -> finance.ListReconciliationReportsRequest
+	53,  // 86: finance.FinanceService.CreateVoucher:input_type -> finance.Voucher
+	71,  // 87: finance.FinanceService.ListVouchers:input_type -> google.protobuf.Empty
+	16,  // 88: finance.FinanceService.ListTopUpReviews:input_type -> finance.ListTopUpReviewsRequest
+	18,  // 89: finance.FinanceService.ApproveTopUpReview:input_type -> finance.DecideTopUpReviewRequest
+	18,  // 90: finance.FinanceService.RejectTopUpReview:input_type -> finance.DecideTopUpReviewRequest
+	20,  // 91: finance.FinanceService.RequestBalanceAdjustment:input_type -> finance.BalanceAdjustmentRequest
+	21,  // 92: finance.FinanceService.ApproveBalanceAdjustment:input_type -> finance.DecideBalanceAdjustmentRequest
+	21,  // 93: finance.FinanceService.RejectBalanceAdjustment:input_type -> finance.DecideBalanceAdjustmentRequest
+	22,  // 94: finance.FinanceService.ListBalanceAdjustments:input_type -> finance.ListBalanceAdjustmentsRequest
+	24,  // 95: finance.FinanceService.CreatePartner:input_type -> finance.Partner
+	24,  // 96: finance.FinanceService.UpdatePartner:input_type -> finance.Partner
+	71,  // 97: finance.FinanceService.ListPartners:input_type -> google.protobuf.Empty
+	27,  // 98: finance.FinanceService.CalculatePayouts:input_type -> finance.CalculatePayoutsRequest
+	31,  // 99: finance.FinanceService.ListPayouts:input_type -> finance.ListPayoutsRequest
+	32,  // 100: finance.FinanceService.UpdatePayoutStatus:input_type -> finance.PayoutStatusRequest
+	33,  // 101: finance.FinanceService.GetPayoutStatement:input_type -> finance.PayoutStatementRequest
+	34,  // 102: finance.FinanceService.CreateMatchingCampaign:input_type -> finance.MatchingCampaign
+	71,  // 103: finance.FinanceService.ListMatchingCampaigns:input_type -> google.protobuf.Empty
+	36,  // 104: finance.FinanceService.UpdateMatchingCampaignStatus:input_type -> finance.MatchingCampaignStatusRequest
+	38,  // 105: finance.FinanceService.ListCampaignMatches:input_type -> finance.ListCampaignMatchesRequest
+	55,  // 106: finance.FinanceService.GetRevenueReport:input_type -> finance.RevenueReportRequest
+	71,  // 107: finance.FinanceService.GetPendingInvoiceReport:input_type -> google.protobuf.Empty
+	71,  // 108: finance.FinanceService.GetWalletLiabilityReport:input_type -> google.protobuf.Empty
+	61,  // 109: finance.FinanceService.GetPaymentMethodReport:input_type -> finance.PaymentMethodReportRequest
+	58,  // 110: finance.FinanceService.ExportJournal:input_type -> finance.JournalExportRequest
+	71,  // 111: finance.FinanceService.ListJobs:input_type -> google.protobuf.Empty
+	68,  // 112: finance.FinanceService.TriggerJob:input_type -> finance.TriggerJobRequest
+	6,   // 113: finance.FinanceService.CreateTransaction:output_type -> finance.Transaction
+	6,   // 114: finance.FinanceService.TopUpWallet:output_type -> finance.Transaction
+	6,   // 115: finance.FinanceService.TransferBalance:output_type -> finance.Transaction
+	71,  // 116: finance.FinanceService.HandleWalletWebhook:output_type -> google.protobuf.Empty
+	10,  // 117: finance.FinanceService.GetBalance:output_type -> finance.BalanceResponse
+	8,   // 118: finance.FinanceService.GetTransactionHistory:output_type -> finance.TransactionList
+	6,   // 119: finance.FinanceService.CancelPayment:output_type -> finance.Transaction
+	11,  // 120: finance.FinanceService.CreateBalanceHold:output_type -> finance.BalanceHold
+	11,  // 121: finance.FinanceService.CaptureBalanceHold:output_type -> finance.BalanceHold
+	11,  // 122: finance.FinanceService.ReleaseBalanceHold:output_type -> finance.BalanceHold
+	14,  // 123: finance.FinanceService.ListBalanceHolds:output_type -> finance.BalanceHoldList
+	71,  // 124: finance.FinanceService.CheckPaymentExpiry:output_type -> google.protobuf.Empty
+	45,  // 125: finance.FinanceService.GetReceipt:output_type -> finance.Document
+	45,  // 126: finance.FinanceService.GetStatement:output_type -> finance.Document
+	45,  // 127: finance.FinanceService.IssueDonationCertificate:output_type -> finance.Document
+	48,  // 128: finance.FinanceService.VerifyDonationCertificate:output_type -> finance.CertificateVerification
+	41,  // 129: finance.FinanceService.GetExchangeRates:output_type -> finance.ExchangeRateList
+	50,  // 130: finance.FinanceService.ReconcilePayments:output_type -> finance.ReconciliationReport
+	52,  // 131: finance.FinanceService.ListReconciliationReports:output_type -> finance.ReconciliationReportList
+	53,  // 132: finance.FinanceService.CreateVoucher:output_type -> finance.Voucher
+	54,  // 133: finance.FinanceService.ListVouchers:output_type -> finance.VoucherList
+	17,  // 134: finance.FinanceService.ListTopUpReviews:output_type -> finance.TopUpReviewList
+	15,  // 135: finance.FinanceService.ApproveTopUpReview:output_type -> finance.TopUpReview
+	15,  // 136: finance.FinanceService.RejectTopUpReview:output_type -> finance.TopUpReview
+	19,  // 137: finance.FinanceService.RequestBalanceAdjustment:output_type -> finance.BalanceAdjustment
+	19,  // 138: finance.FinanceService.ApproveBalanceAdjustment:output_type -> finance.BalanceAdjustment
+	19,  // 139: finance.FinanceService.RejectBalanceAdjustment:output_type -> finance.BalanceAdjustment
+	23,  // 140: finance.FinanceService.ListBalanceAdjustments:output_type -> finance.BalanceAdjustmentList
+	24,  // 141: finance.FinanceService.CreatePartner:output_type -> finance.Partner
+	24,  // 142: finance.FinanceService.UpdatePartner:output_type -> finance.Partner
+	26,  // 143: finance.FinanceService.ListPartners:output_type -> finance.PartnerList
+	30,  // 144: finance.FinanceService.CalculatePayouts:output_type -> finance.PayoutList
+	30,  // 145: finance.FinanceService.ListPayouts:output_type -> finance.PayoutList
+	28,  // 146: finance.FinanceService.UpdatePayoutStatus:output_type -> finance.Payout
+	45,  // 147: finance.FinanceService.GetPayoutStatement:output_type -> finance.Document
+	34,  // 148: finance.FinanceService.CreateMatchingCampaign:output_type -> finance.MatchingCampaign
+	35,  // 149: finance.FinanceService.ListMatchingCampaigns:output_type -> finance.MatchingCampaignList
+	34,  // 150: finance.FinanceService.UpdateMatchingCampaignStatus:output_type -> finance.MatchingCampaign
+	39,  // 151: finance.FinanceService.ListCampaignMatches:output_type -> finance.CampaignMatchList
+	57,  // 152: finance.FinanceService.GetRevenueReport:output_type -> finance.RevenueReport
+	60,  // 153: finance.FinanceService.GetPendingInvoiceReport:output_type -> finance.PendingInvoiceReport
+	64,  // 154: finance.FinanceService.GetWalletLiabilityReport:output_type -> finance.WalletLiabilityReport
+	63,  // 155: finance.FinanceService.GetPaymentMethodReport:output_type -> finance.PaymentMethodReport
+	45,  // 156: finance.FinanceService.ExportJournal:output_type -> finance.Document
+	67,  // 157: finance.FinanceService.ListJobs:output_type -> finance.JobList
+	65,  // 158: finance.FinanceService.TriggerJob:output_type -> finance.JobRun
+	113, // [113:159] is the sub-list for method output_type
+	67,  // [67:113] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_proto_finance_service_proto_init() }
@@ -544,8 +5647,8 @@ func file_proto_finance_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_finance_service_proto_rawDesc), len(file_proto_finance_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      4,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
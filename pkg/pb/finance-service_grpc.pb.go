@@ -20,12 +20,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FinanceService_CreateTransaction_FullMethodName     = "/finance.FinanceService/CreateTransaction"
-	FinanceService_TopUpWallet_FullMethodName           = "/finance.FinanceService/TopUpWallet"
-	FinanceService_HandleWalletWebhook_FullMethodName   = "/finance.FinanceService/HandleWalletWebhook"
-	FinanceService_GetBalance_FullMethodName            = "/finance.FinanceService/GetBalance"
-	FinanceService_GetTransactionHistory_FullMethodName = "/finance.FinanceService/GetTransactionHistory"
-	FinanceService_CheckPaymentExpiry_FullMethodName    = "/finance.FinanceService/CheckPaymentExpiry"
+	FinanceService_CreateTransaction_FullMethodName            = "/finance.FinanceService/CreateTransaction"
+	FinanceService_TopUpWallet_FullMethodName                  = "/finance.FinanceService/TopUpWallet"
+	FinanceService_TransferBalance_FullMethodName              = "/finance.FinanceService/TransferBalance"
+	FinanceService_HandleWalletWebhook_FullMethodName          = "/finance.FinanceService/HandleWalletWebhook"
+	FinanceService_GetBalance_FullMethodName                   = "/finance.FinanceService/GetBalance"
+	FinanceService_GetTransactionHistory_FullMethodName        = "/finance.FinanceService/GetTransactionHistory"
+	FinanceService_CancelPayment_FullMethodName                = "/finance.FinanceService/CancelPayment"
+	FinanceService_CreateBalanceHold_FullMethodName            = "/finance.FinanceService/CreateBalanceHold"
+	FinanceService_CaptureBalanceHold_FullMethodName           = "/finance.FinanceService/CaptureBalanceHold"
+	FinanceService_ReleaseBalanceHold_FullMethodName           = "/finance.FinanceService/ReleaseBalanceHold"
+	FinanceService_ListBalanceHolds_FullMethodName             = "/finance.FinanceService/ListBalanceHolds"
+	FinanceService_CheckPaymentExpiry_FullMethodName           = "/finance.FinanceService/CheckPaymentExpiry"
+	FinanceService_GetReceipt_FullMethodName                   = "/finance.FinanceService/GetReceipt"
+	FinanceService_GetStatement_FullMethodName                 = "/finance.FinanceService/GetStatement"
+	FinanceService_IssueDonationCertificate_FullMethodName     = "/finance.FinanceService/IssueDonationCertificate"
+	FinanceService_VerifyDonationCertificate_FullMethodName    = "/finance.FinanceService/VerifyDonationCertificate"
+	FinanceService_GetExchangeRates_FullMethodName             = "/finance.FinanceService/GetExchangeRates"
+	FinanceService_ReconcilePayments_FullMethodName            = "/finance.FinanceService/ReconcilePayments"
+	FinanceService_ListReconciliationReports_FullMethodName    = "/finance.FinanceService/ListReconciliationReports"
+	FinanceService_CreateVoucher_FullMethodName                = "/finance.FinanceService/CreateVoucher"
+	FinanceService_ListVouchers_FullMethodName                 = "/finance.FinanceService/ListVouchers"
+	FinanceService_ListTopUpReviews_FullMethodName             = "/finance.FinanceService/ListTopUpReviews"
+	FinanceService_ApproveTopUpReview_FullMethodName           = "/finance.FinanceService/ApproveTopUpReview"
+	FinanceService_RejectTopUpReview_FullMethodName            = "/finance.FinanceService/RejectTopUpReview"
+	FinanceService_RequestBalanceAdjustment_FullMethodName     = "/finance.FinanceService/RequestBalanceAdjustment"
+	FinanceService_ApproveBalanceAdjustment_FullMethodName     = "/finance.FinanceService/ApproveBalanceAdjustment"
+	FinanceService_RejectBalanceAdjustment_FullMethodName      = "/finance.FinanceService/RejectBalanceAdjustment"
+	FinanceService_ListBalanceAdjustments_FullMethodName       = "/finance.FinanceService/ListBalanceAdjustments"
+	FinanceService_CreatePartner_FullMethodName                = "/finance.FinanceService/CreatePartner"
+	FinanceService_UpdatePartner_FullMethodName                = "/finance.FinanceService/UpdatePartner"
+	FinanceService_ListPartners_FullMethodName                 = "/finance.FinanceService/ListPartners"
+	FinanceService_CalculatePayouts_FullMethodName             = "/finance.FinanceService/CalculatePayouts"
+	FinanceService_ListPayouts_FullMethodName                  = "/finance.FinanceService/ListPayouts"
+	FinanceService_UpdatePayoutStatus_FullMethodName           = "/finance.FinanceService/UpdatePayoutStatus"
+	FinanceService_GetPayoutStatement_FullMethodName           = "/finance.FinanceService/GetPayoutStatement"
+	FinanceService_CreateMatchingCampaign_FullMethodName       = "/finance.FinanceService/CreateMatchingCampaign"
+	FinanceService_ListMatchingCampaigns_FullMethodName        = "/finance.FinanceService/ListMatchingCampaigns"
+	FinanceService_UpdateMatchingCampaignStatus_FullMethodName = "/finance.FinanceService/UpdateMatchingCampaignStatus"
+	FinanceService_ListCampaignMatches_FullMethodName          = "/finance.FinanceService/ListCampaignMatches"
+	FinanceService_GetRevenueReport_FullMethodName             = "/finance.FinanceService/GetRevenueReport"
+	FinanceService_GetPendingInvoiceReport_FullMethodName      = "/finance.FinanceService/GetPendingInvoiceReport"
+	FinanceService_GetWalletLiabilityReport_FullMethodName     = "/finance.FinanceService/GetWalletLiabilityReport"
+	FinanceService_GetPaymentMethodReport_FullMethodName       = "/finance.FinanceService/GetPaymentMethodReport"
+	FinanceService_ExportJournal_FullMethodName                = "/finance.FinanceService/ExportJournal"
+	FinanceService_ListJobs_FullMethodName                     = "/finance.FinanceService/ListJobs"
+	FinanceService_TriggerJob_FullMethodName                   = "/finance.FinanceService/TriggerJob"
 )
 
 // FinanceServiceClient is the client API for FinanceService service.
@@ -34,10 +74,50 @@ const (
 type FinanceServiceClient interface {
 	CreateTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
 	TopUpWallet(ctx context.Context, in *TopUpRequest, opts ...grpc.CallOption) (*Transaction, error)
+	TransferBalance(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*Transaction, error)
 	HandleWalletWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	GetBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BalanceResponse, error)
+	GetBalance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
 	GetTransactionHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TransactionList, error)
+	CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*Transaction, error)
+	CreateBalanceHold(ctx context.Context, in *CreateBalanceHoldRequest, opts ...grpc.CallOption) (*BalanceHold, error)
+	CaptureBalanceHold(ctx context.Context, in *BalanceHoldRequest, opts ...grpc.CallOption) (*BalanceHold, error)
+	ReleaseBalanceHold(ctx context.Context, in *BalanceHoldRequest, opts ...grpc.CallOption) (*BalanceHold, error)
+	ListBalanceHolds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BalanceHoldList, error)
 	CheckPaymentExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*Document, error)
+	GetStatement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*Document, error)
+	IssueDonationCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*Document, error)
+	VerifyDonationCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*CertificateVerification, error)
+	GetExchangeRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExchangeRateList, error)
+	ReconcilePayments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReconciliationReport, error)
+	ListReconciliationReports(ctx context.Context, in *ListReconciliationReportsRequest, opts ...grpc.CallOption) (*ReconciliationReportList, error)
+	CreateVoucher(ctx context.Context, in *Voucher, opts ...grpc.CallOption) (*Voucher, error)
+	ListVouchers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VoucherList, error)
+	ListTopUpReviews(ctx context.Context, in *ListTopUpReviewsRequest, opts ...grpc.CallOption) (*TopUpReviewList, error)
+	ApproveTopUpReview(ctx context.Context, in *DecideTopUpReviewRequest, opts ...grpc.CallOption) (*TopUpReview, error)
+	RejectTopUpReview(ctx context.Context, in *DecideTopUpReviewRequest, opts ...grpc.CallOption) (*TopUpReview, error)
+	RequestBalanceAdjustment(ctx context.Context, in *BalanceAdjustmentRequest, opts ...grpc.CallOption) (*BalanceAdjustment, error)
+	ApproveBalanceAdjustment(ctx context.Context, in *DecideBalanceAdjustmentRequest, opts ...grpc.CallOption) (*BalanceAdjustment, error)
+	RejectBalanceAdjustment(ctx context.Context, in *DecideBalanceAdjustmentRequest, opts ...grpc.CallOption) (*BalanceAdjustment, error)
+	ListBalanceAdjustments(ctx context.Context, in *ListBalanceAdjustmentsRequest, opts ...grpc.CallOption) (*BalanceAdjustmentList, error)
+	CreatePartner(ctx context.Context, in *Partner, opts ...grpc.CallOption) (*Partner, error)
+	UpdatePartner(ctx context.Context, in *Partner, opts ...grpc.CallOption) (*Partner, error)
+	ListPartners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PartnerList, error)
+	CalculatePayouts(ctx context.Context, in *CalculatePayoutsRequest, opts ...grpc.CallOption) (*PayoutList, error)
+	ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...grpc.CallOption) (*PayoutList, error)
+	UpdatePayoutStatus(ctx context.Context, in *PayoutStatusRequest, opts ...grpc.CallOption) (*Payout, error)
+	GetPayoutStatement(ctx context.Context, in *PayoutStatementRequest, opts ...grpc.CallOption) (*Document, error)
+	CreateMatchingCampaign(ctx context.Context, in *MatchingCampaign, opts ...grpc.CallOption) (*MatchingCampaign, error)
+	ListMatchingCampaigns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MatchingCampaignList, error)
+	UpdateMatchingCampaignStatus(ctx context.Context, in *MatchingCampaignStatusRequest, opts ...grpc.CallOption) (*MatchingCampaign, error)
+	ListCampaignMatches(ctx context.Context, in *ListCampaignMatchesRequest, opts ...grpc.CallOption) (*CampaignMatchList, error)
+	GetRevenueReport(ctx context.Context, in *RevenueReportRequest, opts ...grpc.CallOption) (*RevenueReport, error)
+	GetPendingInvoiceReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PendingInvoiceReport, error)
+	GetWalletLiabilityReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WalletLiabilityReport, error)
+	GetPaymentMethodReport(ctx context.Context, in *PaymentMethodReportRequest, opts ...grpc.CallOption) (*PaymentMethodReport, error)
+	ExportJournal(ctx context.Context, in *JournalExportRequest, opts ...grpc.CallOption) (*Document, error)
+	ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JobList, error)
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*JobRun, error)
 }
 
 type financeServiceClient struct {
@@ -68,6 +148,16 @@ func (c *financeServiceClient) TopUpWallet(ctx context.Context, in *TopUpRequest
 	return out, nil
 }
 
+func (c *financeServiceClient) TransferBalance(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, FinanceService_TransferBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *financeServiceClient) HandleWalletWebhook(ctx context.Context, in *WebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -75,202 +165,1472 @@ func (c *financeServiceClient) HandleWalletWebhook(ctx context.Context, in *Webh
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *financeServiceClient) GetBalance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceResponse)
+	err := c.cc.Invoke(ctx, FinanceService_GetBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetTransactionHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TransactionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionList)
+	err := c.cc.Invoke(ctx, FinanceService_GetTransactionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CancelPayment(ctx context.Context, in *CancelPaymentRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, FinanceService_CancelPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CreateBalanceHold(ctx context.Context, in *CreateBalanceHoldRequest, opts ...grpc.CallOption) (*BalanceHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceHold)
+	err := c.cc.Invoke(ctx, FinanceService_CreateBalanceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CaptureBalanceHold(ctx context.Context, in *BalanceHoldRequest, opts ...grpc.CallOption) (*BalanceHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceHold)
+	err := c.cc.Invoke(ctx, FinanceService_CaptureBalanceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ReleaseBalanceHold(ctx context.Context, in *BalanceHoldRequest, opts ...grpc.CallOption) (*BalanceHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceHold)
+	err := c.cc.Invoke(ctx, FinanceService_ReleaseBalanceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListBalanceHolds(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BalanceHoldList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceHoldList)
+	err := c.cc.Invoke(ctx, FinanceService_ListBalanceHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CheckPaymentExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FinanceService_CheckPaymentExpiry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, FinanceService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetStatement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, FinanceService_GetStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) IssueDonationCertificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, FinanceService_IssueDonationCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) VerifyDonationCertificate(ctx context.Context, in *VerifyCertificateRequest, opts ...grpc.CallOption) (*CertificateVerification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificateVerification)
+	err := c.cc.Invoke(ctx, FinanceService_VerifyDonationCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetExchangeRates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExchangeRateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRateList)
+	err := c.cc.Invoke(ctx, FinanceService_GetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ReconcilePayments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReconciliationReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationReport)
+	err := c.cc.Invoke(ctx, FinanceService_ReconcilePayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListReconciliationReports(ctx context.Context, in *ListReconciliationReportsRequest, opts ...grpc.CallOption) (*ReconciliationReportList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationReportList)
+	err := c.cc.Invoke(ctx, FinanceService_ListReconciliationReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CreateVoucher(ctx context.Context, in *Voucher, opts ...grpc.CallOption) (*Voucher, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Voucher)
+	err := c.cc.Invoke(ctx, FinanceService_CreateVoucher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListVouchers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VoucherList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoucherList)
+	err := c.cc.Invoke(ctx, FinanceService_ListVouchers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListTopUpReviews(ctx context.Context, in *ListTopUpReviewsRequest, opts ...grpc.CallOption) (*TopUpReviewList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpReviewList)
+	err := c.cc.Invoke(ctx, FinanceService_ListTopUpReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ApproveTopUpReview(ctx context.Context, in *DecideTopUpReviewRequest, opts ...grpc.CallOption) (*TopUpReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpReview)
+	err := c.cc.Invoke(ctx, FinanceService_ApproveTopUpReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) RejectTopUpReview(ctx context.Context, in *DecideTopUpReviewRequest, opts ...grpc.CallOption) (*TopUpReview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpReview)
+	err := c.cc.Invoke(ctx, FinanceService_RejectTopUpReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) RequestBalanceAdjustment(ctx context.Context, in *BalanceAdjustmentRequest, opts ...grpc.CallOption) (*BalanceAdjustment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceAdjustment)
+	err := c.cc.Invoke(ctx, FinanceService_RequestBalanceAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ApproveBalanceAdjustment(ctx context.Context, in *DecideBalanceAdjustmentRequest, opts ...grpc.CallOption) (*BalanceAdjustment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceAdjustment)
+	err := c.cc.Invoke(ctx, FinanceService_ApproveBalanceAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) RejectBalanceAdjustment(ctx context.Context, in *DecideBalanceAdjustmentRequest, opts ...grpc.CallOption) (*BalanceAdjustment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceAdjustment)
+	err := c.cc.Invoke(ctx, FinanceService_RejectBalanceAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListBalanceAdjustments(ctx context.Context, in *ListBalanceAdjustmentsRequest, opts ...grpc.CallOption) (*BalanceAdjustmentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BalanceAdjustmentList)
+	err := c.cc.Invoke(ctx, FinanceService_ListBalanceAdjustments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CreatePartner(ctx context.Context, in *Partner, opts ...grpc.CallOption) (*Partner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Partner)
+	err := c.cc.Invoke(ctx, FinanceService_CreatePartner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) UpdatePartner(ctx context.Context, in *Partner, opts ...grpc.CallOption) (*Partner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Partner)
+	err := c.cc.Invoke(ctx, FinanceService_UpdatePartner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListPartners(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PartnerList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PartnerList)
+	err := c.cc.Invoke(ctx, FinanceService_ListPartners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CalculatePayouts(ctx context.Context, in *CalculatePayoutsRequest, opts ...grpc.CallOption) (*PayoutList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayoutList)
+	err := c.cc.Invoke(ctx, FinanceService_CalculatePayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...grpc.CallOption) (*PayoutList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayoutList)
+	err := c.cc.Invoke(ctx, FinanceService_ListPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) UpdatePayoutStatus(ctx context.Context, in *PayoutStatusRequest, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, FinanceService_UpdatePayoutStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetPayoutStatement(ctx context.Context, in *PayoutStatementRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, FinanceService_GetPayoutStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) CreateMatchingCampaign(ctx context.Context, in *MatchingCampaign, opts ...grpc.CallOption) (*MatchingCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchingCampaign)
+	err := c.cc.Invoke(ctx, FinanceService_CreateMatchingCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListMatchingCampaigns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MatchingCampaignList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchingCampaignList)
+	err := c.cc.Invoke(ctx, FinanceService_ListMatchingCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) UpdateMatchingCampaignStatus(ctx context.Context, in *MatchingCampaignStatusRequest, opts ...grpc.CallOption) (*MatchingCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchingCampaign)
+	err := c.cc.Invoke(ctx, FinanceService_UpdateMatchingCampaignStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListCampaignMatches(ctx context.Context, in *ListCampaignMatchesRequest, opts ...grpc.CallOption) (*CampaignMatchList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CampaignMatchList)
+	err := c.cc.Invoke(ctx, FinanceService_ListCampaignMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetRevenueReport(ctx context.Context, in *RevenueReportRequest, opts ...grpc.CallOption) (*RevenueReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevenueReport)
+	err := c.cc.Invoke(ctx, FinanceService_GetRevenueReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetPendingInvoiceReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PendingInvoiceReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PendingInvoiceReport)
+	err := c.cc.Invoke(ctx, FinanceService_GetPendingInvoiceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetWalletLiabilityReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WalletLiabilityReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletLiabilityReport)
+	err := c.cc.Invoke(ctx, FinanceService_GetWalletLiabilityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) GetPaymentMethodReport(ctx context.Context, in *PaymentMethodReportRequest, opts ...grpc.CallOption) (*PaymentMethodReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentMethodReport)
+	err := c.cc.Invoke(ctx, FinanceService_GetPaymentMethodReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ExportJournal(ctx context.Context, in *JournalExportRequest, opts ...grpc.CallOption) (*Document, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Document)
+	err := c.cc.Invoke(ctx, FinanceService_ExportJournal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JobList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobList)
+	err := c.cc.Invoke(ctx, FinanceService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeServiceClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*JobRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobRun)
+	err := c.cc.Invoke(ctx, FinanceService_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FinanceServiceServer is the server API for FinanceService service.
+// All implementations must embed UnimplementedFinanceServiceServer
+// for forward compatibility.
+type FinanceServiceServer interface {
+	CreateTransaction(context.Context, *TransactionRequest) (*Transaction, error)
+	TopUpWallet(context.Context, *TopUpRequest) (*Transaction, error)
+	TransferBalance(context.Context, *TransferRequest) (*Transaction, error)
+	HandleWalletWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error)
+	GetBalance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	GetTransactionHistory(context.Context, *emptypb.Empty) (*TransactionList, error)
+	CancelPayment(context.Context, *CancelPaymentRequest) (*Transaction, error)
+	CreateBalanceHold(context.Context, *CreateBalanceHoldRequest) (*BalanceHold, error)
+	CaptureBalanceHold(context.Context, *BalanceHoldRequest) (*BalanceHold, error)
+	ReleaseBalanceHold(context.Context, *BalanceHoldRequest) (*BalanceHold, error)
+	ListBalanceHolds(context.Context, *emptypb.Empty) (*BalanceHoldList, error)
+	CheckPaymentExpiry(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	GetReceipt(context.Context, *ReceiptRequest) (*Document, error)
+	GetStatement(context.Context, *StatementRequest) (*Document, error)
+	IssueDonationCertificate(context.Context, *CertificateRequest) (*Document, error)
+	VerifyDonationCertificate(context.Context, *VerifyCertificateRequest) (*CertificateVerification, error)
+	GetExchangeRates(context.Context, *emptypb.Empty) (*ExchangeRateList, error)
+	ReconcilePayments(context.Context, *emptypb.Empty) (*ReconciliationReport, error)
+	ListReconciliationReports(context.Context, *ListReconciliationReportsRequest) (*ReconciliationReportList, error)
+	CreateVoucher(context.Context, *Voucher) (*Voucher, error)
+	ListVouchers(context.Context, *emptypb.Empty) (*VoucherList, error)
+	ListTopUpReviews(context.Context, *ListTopUpReviewsRequest) (*TopUpReviewList, error)
+	ApproveTopUpReview(context.Context, *DecideTopUpReviewRequest) (*TopUpReview, error)
+	RejectTopUpReview(context.Context, *DecideTopUpReviewRequest) (*TopUpReview, error)
+	RequestBalanceAdjustment(context.Context, *BalanceAdjustmentRequest) (*BalanceAdjustment, error)
+	ApproveBalanceAdjustment(context.Context, *DecideBalanceAdjustmentRequest) (*BalanceAdjustment, error)
+	RejectBalanceAdjustment(context.Context, *DecideBalanceAdjustmentRequest) (*BalanceAdjustment, error)
+	ListBalanceAdjustments(context.Context, *ListBalanceAdjustmentsRequest) (*BalanceAdjustmentList, error)
+	CreatePartner(context.Context, *Partner) (*Partner, error)
+	UpdatePartner(context.Context, *Partner) (*Partner, error)
+	ListPartners(context.Context, *emptypb.Empty) (*PartnerList, error)
+	CalculatePayouts(context.Context, *CalculatePayoutsRequest) (*PayoutList, error)
+	ListPayouts(context.Context, *ListPayoutsRequest) (*PayoutList, error)
+	UpdatePayoutStatus(context.Context, *PayoutStatusRequest) (*Payout, error)
+	GetPayoutStatement(context.Context, *PayoutStatementRequest) (*Document, error)
+	CreateMatchingCampaign(context.Context, *MatchingCampaign) (*MatchingCampaign, error)
+	ListMatchingCampaigns(context.Context, *emptypb.Empty) (*MatchingCampaignList, error)
+	UpdateMatchingCampaignStatus(context.Context, *MatchingCampaignStatusRequest) (*MatchingCampaign, error)
+	ListCampaignMatches(context.Context, *ListCampaignMatchesRequest) (*CampaignMatchList, error)
+	GetRevenueReport(context.Context, *RevenueReportRequest) (*RevenueReport, error)
+	GetPendingInvoiceReport(context.Context, *emptypb.Empty) (*PendingInvoiceReport, error)
+	GetWalletLiabilityReport(context.Context, *emptypb.Empty) (*WalletLiabilityReport, error)
+	GetPaymentMethodReport(context.Context, *PaymentMethodReportRequest) (*PaymentMethodReport, error)
+	ExportJournal(context.Context, *JournalExportRequest) (*Document, error)
+	ListJobs(context.Context, *emptypb.Empty) (*JobList, error)
+	TriggerJob(context.Context, *TriggerJobRequest) (*JobRun, error)
+	mustEmbedUnimplementedFinanceServiceServer()
+}
+
+// UnimplementedFinanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFinanceServiceServer struct{}
+
+func (UnimplementedFinanceServiceServer) CreateTransaction(context.Context, *TransactionRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransaction not implemented")
+}
+func (UnimplementedFinanceServiceServer) TopUpWallet(context.Context, *TopUpRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method TopUpWallet not implemented")
+}
+func (UnimplementedFinanceServiceServer) TransferBalance(context.Context, *TransferRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferBalance not implemented")
+}
+func (UnimplementedFinanceServiceServer) HandleWalletWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method HandleWalletWebhook not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetBalance(context.Context, *BalanceRequest) (*BalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetTransactionHistory(context.Context, *emptypb.Empty) (*TransactionList, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedFinanceServiceServer) CancelPayment(context.Context, *CancelPaymentRequest) (*Transaction, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPayment not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateBalanceHold(context.Context, *CreateBalanceHoldRequest) (*BalanceHold, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBalanceHold not implemented")
+}
+func (UnimplementedFinanceServiceServer) CaptureBalanceHold(context.Context, *BalanceHoldRequest) (*BalanceHold, error) {
+	return nil, status.Error(codes.Unimplemented, "method CaptureBalanceHold not implemented")
+}
+func (UnimplementedFinanceServiceServer) ReleaseBalanceHold(context.Context, *BalanceHoldRequest) (*BalanceHold, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseBalanceHold not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListBalanceHolds(context.Context, *emptypb.Empty) (*BalanceHoldList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBalanceHolds not implemented")
+}
+func (UnimplementedFinanceServiceServer) CheckPaymentExpiry(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckPaymentExpiry not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetReceipt(context.Context, *ReceiptRequest) (*Document, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetStatement(context.Context, *StatementRequest) (*Document, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedFinanceServiceServer) IssueDonationCertificate(context.Context, *CertificateRequest) (*Document, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueDonationCertificate not implemented")
+}
+func (UnimplementedFinanceServiceServer) VerifyDonationCertificate(context.Context, *VerifyCertificateRequest) (*CertificateVerification, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyDonationCertificate not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetExchangeRates(context.Context, *emptypb.Empty) (*ExchangeRateList, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedFinanceServiceServer) ReconcilePayments(context.Context, *emptypb.Empty) (*ReconciliationReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcilePayments not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListReconciliationReports(context.Context, *ListReconciliationReportsRequest) (*ReconciliationReportList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReconciliationReports not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateVoucher(context.Context, *Voucher) (*Voucher, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVoucher not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListVouchers(context.Context, *emptypb.Empty) (*VoucherList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVouchers not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListTopUpReviews(context.Context, *ListTopUpReviewsRequest) (*TopUpReviewList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTopUpReviews not implemented")
+}
+func (UnimplementedFinanceServiceServer) ApproveTopUpReview(context.Context, *DecideTopUpReviewRequest) (*TopUpReview, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveTopUpReview not implemented")
+}
+func (UnimplementedFinanceServiceServer) RejectTopUpReview(context.Context, *DecideTopUpReviewRequest) (*TopUpReview, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectTopUpReview not implemented")
+}
+func (UnimplementedFinanceServiceServer) RequestBalanceAdjustment(context.Context, *BalanceAdjustmentRequest) (*BalanceAdjustment, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestBalanceAdjustment not implemented")
+}
+func (UnimplementedFinanceServiceServer) ApproveBalanceAdjustment(context.Context, *DecideBalanceAdjustmentRequest) (*BalanceAdjustment, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveBalanceAdjustment not implemented")
+}
+func (UnimplementedFinanceServiceServer) RejectBalanceAdjustment(context.Context, *DecideBalanceAdjustmentRequest) (*BalanceAdjustment, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectBalanceAdjustment not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListBalanceAdjustments(context.Context, *ListBalanceAdjustmentsRequest) (*BalanceAdjustmentList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBalanceAdjustments not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreatePartner(context.Context, *Partner) (*Partner, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePartner not implemented")
+}
+func (UnimplementedFinanceServiceServer) UpdatePartner(context.Context, *Partner) (*Partner, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePartner not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListPartners(context.Context, *emptypb.Empty) (*PartnerList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPartners not implemented")
+}
+func (UnimplementedFinanceServiceServer) CalculatePayouts(context.Context, *CalculatePayoutsRequest) (*PayoutList, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculatePayouts not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListPayouts(context.Context, *ListPayoutsRequest) (*PayoutList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPayouts not implemented")
+}
+func (UnimplementedFinanceServiceServer) UpdatePayoutStatus(context.Context, *PayoutStatusRequest) (*Payout, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePayoutStatus not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetPayoutStatement(context.Context, *PayoutStatementRequest) (*Document, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayoutStatement not implemented")
+}
+func (UnimplementedFinanceServiceServer) CreateMatchingCampaign(context.Context, *MatchingCampaign) (*MatchingCampaign, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMatchingCampaign not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListMatchingCampaigns(context.Context, *emptypb.Empty) (*MatchingCampaignList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMatchingCampaigns not implemented")
+}
+func (UnimplementedFinanceServiceServer) UpdateMatchingCampaignStatus(context.Context, *MatchingCampaignStatusRequest) (*MatchingCampaign, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMatchingCampaignStatus not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListCampaignMatches(context.Context, *ListCampaignMatchesRequest) (*CampaignMatchList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCampaignMatches not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetRevenueReport(context.Context, *RevenueReportRequest) (*RevenueReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRevenueReport not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetPendingInvoiceReport(context.Context, *emptypb.Empty) (*PendingInvoiceReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingInvoiceReport not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetWalletLiabilityReport(context.Context, *emptypb.Empty) (*WalletLiabilityReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWalletLiabilityReport not implemented")
+}
+func (UnimplementedFinanceServiceServer) GetPaymentMethodReport(context.Context, *PaymentMethodReportRequest) (*PaymentMethodReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPaymentMethodReport not implemented")
+}
+func (UnimplementedFinanceServiceServer) ExportJournal(context.Context, *JournalExportRequest) (*Document, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportJournal not implemented")
+}
+func (UnimplementedFinanceServiceServer) ListJobs(context.Context, *emptypb.Empty) (*JobList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedFinanceServiceServer) TriggerJob(context.Context, *TriggerJobRequest) (*JobRun, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedFinanceServiceServer) mustEmbedUnimplementedFinanceServiceServer() {}
+func (UnimplementedFinanceServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeFinanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinanceServiceServer will
+// result in compilation errors.
+type UnsafeFinanceServiceServer interface {
+	mustEmbedUnimplementedFinanceServiceServer()
+}
+
+func RegisterFinanceServiceServer(s grpc.ServiceRegistrar, srv FinanceServiceServer) {
+	// If the following call panics, it indicates UnimplementedFinanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FinanceService_ServiceDesc, srv)
+}
+
+func _FinanceService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_TopUpWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).TopUpWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_TopUpWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).TopUpWallet(ctx, req.(*TopUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_TransferBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).TransferBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_TransferBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).TransferBalance(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_HandleWalletWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).HandleWalletWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_HandleWalletWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).HandleWalletWebhook(ctx, req.(*WebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetBalance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetTransactionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetTransactionHistory(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CancelPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CancelPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CancelPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CancelPayment(ctx, req.(*CancelPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CreateBalanceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBalanceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateBalanceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateBalanceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateBalanceHold(ctx, req.(*CreateBalanceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CaptureBalanceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CaptureBalanceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CaptureBalanceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CaptureBalanceHold(ctx, req.(*BalanceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ReleaseBalanceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ReleaseBalanceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ReleaseBalanceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ReleaseBalanceHold(ctx, req.(*BalanceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ListBalanceHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListBalanceHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListBalanceHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListBalanceHolds(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CheckPaymentExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CheckPaymentExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CheckPaymentExpiry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CheckPaymentExpiry(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetReceipt(ctx, req.(*ReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetStatement(ctx, req.(*StatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_IssueDonationCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).IssueDonationCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_IssueDonationCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).IssueDonationCertificate(ctx, req.(*CertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_VerifyDonationCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).VerifyDonationCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_VerifyDonationCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).VerifyDonationCertificate(ctx, req.(*VerifyCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetExchangeRates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ReconcilePayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ReconcilePayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ReconcilePayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ReconcilePayments(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ListReconciliationReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReconciliationReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListReconciliationReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListReconciliationReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListReconciliationReports(ctx, req.(*ListReconciliationReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CreateVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Voucher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateVoucher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateVoucher(ctx, req.(*Voucher))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ListVouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListVouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListVouchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListVouchers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ListTopUpReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopUpReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListTopUpReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListTopUpReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListTopUpReviews(ctx, req.(*ListTopUpReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ApproveTopUpReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideTopUpReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ApproveTopUpReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ApproveTopUpReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ApproveTopUpReview(ctx, req.(*DecideTopUpReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_RejectTopUpReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideTopUpReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).RejectTopUpReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_RejectTopUpReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).RejectTopUpReview(ctx, req.(*DecideTopUpReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_RequestBalanceAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).RequestBalanceAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_RequestBalanceAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).RequestBalanceAdjustment(ctx, req.(*BalanceAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ApproveBalanceAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideBalanceAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ApproveBalanceAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ApproveBalanceAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ApproveBalanceAdjustment(ctx, req.(*DecideBalanceAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_RejectBalanceAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideBalanceAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).RejectBalanceAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_RejectBalanceAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).RejectBalanceAdjustment(ctx, req.(*DecideBalanceAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ListBalanceAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalanceAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListBalanceAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListBalanceAdjustments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListBalanceAdjustments(ctx, req.(*ListBalanceAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CreatePartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Partner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreatePartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreatePartner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreatePartner(ctx, req.(*Partner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_UpdatePartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Partner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdatePartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdatePartner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdatePartner(ctx, req.(*Partner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ListPartners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListPartners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListPartners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListPartners(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_CalculatePayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculatePayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CalculatePayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CalculatePayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CalculatePayouts(ctx, req.(*CalculatePayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinanceService_ListPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListPayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListPayouts(ctx, req.(*ListPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *financeServiceClient) GetBalance(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BalanceResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BalanceResponse)
-	err := c.cc.Invoke(ctx, FinanceService_GetBalance_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _FinanceService_UpdatePayoutStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdatePayoutStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdatePayoutStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdatePayoutStatus(ctx, req.(*PayoutStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *financeServiceClient) GetTransactionHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TransactionList, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TransactionList)
-	err := c.cc.Invoke(ctx, FinanceService_GetTransactionHistory_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _FinanceService_GetPayoutStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayoutStatementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetPayoutStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetPayoutStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetPayoutStatement(ctx, req.(*PayoutStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *financeServiceClient) CheckPaymentExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, FinanceService_CheckPaymentExpiry_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _FinanceService_CreateMatchingCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchingCampaign)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).CreateMatchingCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_CreateMatchingCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).CreateMatchingCampaign(ctx, req.(*MatchingCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// FinanceServiceServer is the server API for FinanceService service.
-// All implementations must embed UnimplementedFinanceServiceServer
-// for forward compatibility.
-type FinanceServiceServer interface {
-	CreateTransaction(context.Context, *TransactionRequest) (*Transaction, error)
-	TopUpWallet(context.Context, *TopUpRequest) (*Transaction, error)
-	HandleWalletWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error)
-	GetBalance(context.Context, *emptypb.Empty) (*BalanceResponse, error)
-	GetTransactionHistory(context.Context, *emptypb.Empty) (*TransactionList, error)
-	CheckPaymentExpiry(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
-	mustEmbedUnimplementedFinanceServiceServer()
+func _FinanceService_ListMatchingCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListMatchingCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListMatchingCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListMatchingCampaigns(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedFinanceServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedFinanceServiceServer struct{}
-
-func (UnimplementedFinanceServiceServer) CreateTransaction(context.Context, *TransactionRequest) (*Transaction, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTransaction not implemented")
-}
-func (UnimplementedFinanceServiceServer) TopUpWallet(context.Context, *TopUpRequest) (*Transaction, error) {
-	return nil, status.Error(codes.Unimplemented, "method TopUpWallet not implemented")
-}
-func (UnimplementedFinanceServiceServer) HandleWalletWebhook(context.Context, *WebhookRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method HandleWalletWebhook not implemented")
-}
-func (UnimplementedFinanceServiceServer) GetBalance(context.Context, *emptypb.Empty) (*BalanceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBalance not implemented")
-}
-func (UnimplementedFinanceServiceServer) GetTransactionHistory(context.Context, *emptypb.Empty) (*TransactionList, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetTransactionHistory not implemented")
-}
-func (UnimplementedFinanceServiceServer) CheckPaymentExpiry(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method CheckPaymentExpiry not implemented")
+func _FinanceService_UpdateMatchingCampaignStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchingCampaignStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).UpdateMatchingCampaignStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_UpdateMatchingCampaignStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).UpdateMatchingCampaignStatus(ctx, req.(*MatchingCampaignStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFinanceServiceServer) mustEmbedUnimplementedFinanceServiceServer() {}
-func (UnimplementedFinanceServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeFinanceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FinanceServiceServer will
-// result in compilation errors.
-type UnsafeFinanceServiceServer interface {
-	mustEmbedUnimplementedFinanceServiceServer()
+func _FinanceService_ListCampaignMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCampaignMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).ListCampaignMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_ListCampaignMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).ListCampaignMatches(ctx, req.(*ListCampaignMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFinanceServiceServer(s grpc.ServiceRegistrar, srv FinanceServiceServer) {
-	// If the following call panics, it indicates UnimplementedFinanceServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _FinanceService_GetRevenueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevenueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&FinanceService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(FinanceServiceServer).GetRevenueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinanceService_GetRevenueReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServiceServer).GetRevenueReport(ctx, req.(*RevenueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _FinanceService_GetPendingInvoiceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).CreateTransaction(ctx, in)
+		return srv.(FinanceServiceServer).GetPendingInvoiceReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_CreateTransaction_FullMethodName,
+		FullMethod: FinanceService_GetPendingInvoiceReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).CreateTransaction(ctx, req.(*TransactionRequest))
+		return srv.(FinanceServiceServer).GetPendingInvoiceReport(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_TopUpWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopUpRequest)
+func _FinanceService_GetWalletLiabilityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).TopUpWallet(ctx, in)
+		return srv.(FinanceServiceServer).GetWalletLiabilityReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_TopUpWallet_FullMethodName,
+		FullMethod: FinanceService_GetWalletLiabilityReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).TopUpWallet(ctx, req.(*TopUpRequest))
+		return srv.(FinanceServiceServer).GetWalletLiabilityReport(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_HandleWalletWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebhookRequest)
+func _FinanceService_GetPaymentMethodReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentMethodReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).HandleWalletWebhook(ctx, in)
+		return srv.(FinanceServiceServer).GetPaymentMethodReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_HandleWalletWebhook_FullMethodName,
+		FullMethod: FinanceService_GetPaymentMethodReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).HandleWalletWebhook(ctx, req.(*WebhookRequest))
+		return srv.(FinanceServiceServer).GetPaymentMethodReport(ctx, req.(*PaymentMethodReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _FinanceService_ExportJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JournalExportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetBalance(ctx, in)
+		return srv.(FinanceServiceServer).ExportJournal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetBalance_FullMethodName,
+		FullMethod: FinanceService_ExportJournal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetBalance(ctx, req.(*emptypb.Empty))
+		return srv.(FinanceServiceServer).ExportJournal(ctx, req.(*JournalExportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _FinanceService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).GetTransactionHistory(ctx, in)
+		return srv.(FinanceServiceServer).ListJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_GetTransactionHistory_FullMethodName,
+		FullMethod: FinanceService_ListJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).GetTransactionHistory(ctx, req.(*emptypb.Empty))
+		return srv.(FinanceServiceServer).ListJobs(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FinanceService_CheckPaymentExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _FinanceService_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FinanceServiceServer).CheckPaymentExpiry(ctx, in)
+		return srv.(FinanceServiceServer).TriggerJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FinanceService_CheckPaymentExpiry_FullMethodName,
+		FullMethod: FinanceService_TriggerJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FinanceServiceServer).CheckPaymentExpiry(ctx, req.(*emptypb.Empty))
+		return srv.(FinanceServiceServer).TriggerJob(ctx, req.(*TriggerJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -290,6 +1650,10 @@ var FinanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TopUpWallet",
 			Handler:    _FinanceService_TopUpWallet_Handler,
 		},
+		{
+			MethodName: "TransferBalance",
+			Handler:    _FinanceService_TransferBalance_Handler,
+		},
 		{
 			MethodName: "HandleWalletWebhook",
 			Handler:    _FinanceService_HandleWalletWebhook_Handler,
@@ -302,10 +1666,166 @@ var FinanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionHistory",
 			Handler:    _FinanceService_GetTransactionHistory_Handler,
 		},
+		{
+			MethodName: "CancelPayment",
+			Handler:    _FinanceService_CancelPayment_Handler,
+		},
+		{
+			MethodName: "CreateBalanceHold",
+			Handler:    _FinanceService_CreateBalanceHold_Handler,
+		},
+		{
+			MethodName: "CaptureBalanceHold",
+			Handler:    _FinanceService_CaptureBalanceHold_Handler,
+		},
+		{
+			MethodName: "ReleaseBalanceHold",
+			Handler:    _FinanceService_ReleaseBalanceHold_Handler,
+		},
+		{
+			MethodName: "ListBalanceHolds",
+			Handler:    _FinanceService_ListBalanceHolds_Handler,
+		},
 		{
 			MethodName: "CheckPaymentExpiry",
 			Handler:    _FinanceService_CheckPaymentExpiry_Handler,
 		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _FinanceService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _FinanceService_GetStatement_Handler,
+		},
+		{
+			MethodName: "IssueDonationCertificate",
+			Handler:    _FinanceService_IssueDonationCertificate_Handler,
+		},
+		{
+			MethodName: "VerifyDonationCertificate",
+			Handler:    _FinanceService_VerifyDonationCertificate_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _FinanceService_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "ReconcilePayments",
+			Handler:    _FinanceService_ReconcilePayments_Handler,
+		},
+		{
+			MethodName: "ListReconciliationReports",
+			Handler:    _FinanceService_ListReconciliationReports_Handler,
+		},
+		{
+			MethodName: "CreateVoucher",
+			Handler:    _FinanceService_CreateVoucher_Handler,
+		},
+		{
+			MethodName: "ListVouchers",
+			Handler:    _FinanceService_ListVouchers_Handler,
+		},
+		{
+			MethodName: "ListTopUpReviews",
+			Handler:    _FinanceService_ListTopUpReviews_Handler,
+		},
+		{
+			MethodName: "ApproveTopUpReview",
+			Handler:    _FinanceService_ApproveTopUpReview_Handler,
+		},
+		{
+			MethodName: "RejectTopUpReview",
+			Handler:    _FinanceService_RejectTopUpReview_Handler,
+		},
+		{
+			MethodName: "RequestBalanceAdjustment",
+			Handler:    _FinanceService_RequestBalanceAdjustment_Handler,
+		},
+		{
+			MethodName: "ApproveBalanceAdjustment",
+			Handler:    _FinanceService_ApproveBalanceAdjustment_Handler,
+		},
+		{
+			MethodName: "RejectBalanceAdjustment",
+			Handler:    _FinanceService_RejectBalanceAdjustment_Handler,
+		},
+		{
+			MethodName: "ListBalanceAdjustments",
+			Handler:    _FinanceService_ListBalanceAdjustments_Handler,
+		},
+		{
+			MethodName: "CreatePartner",
+			Handler:    _FinanceService_CreatePartner_Handler,
+		},
+		{
+			MethodName: "UpdatePartner",
+			Handler:    _FinanceService_UpdatePartner_Handler,
+		},
+		{
+			MethodName: "ListPartners",
+			Handler:    _FinanceService_ListPartners_Handler,
+		},
+		{
+			MethodName: "CalculatePayouts",
+			Handler:    _FinanceService_CalculatePayouts_Handler,
+		},
+		{
+			MethodName: "ListPayouts",
+			Handler:    _FinanceService_ListPayouts_Handler,
+		},
+		{
+			MethodName: "UpdatePayoutStatus",
+			Handler:    _FinanceService_UpdatePayoutStatus_Handler,
+		},
+		{
+			MethodName: "GetPayoutStatement",
+			Handler:    _FinanceService_GetPayoutStatement_Handler,
+		},
+		{
+			MethodName: "CreateMatchingCampaign",
+			Handler:    _FinanceService_CreateMatchingCampaign_Handler,
+		},
+		{
+			MethodName: "ListMatchingCampaigns",
+			Handler:    _FinanceService_ListMatchingCampaigns_Handler,
+		},
+		{
+			MethodName: "UpdateMatchingCampaignStatus",
+			Handler:    _FinanceService_UpdateMatchingCampaignStatus_Handler,
+		},
+		{
+			MethodName: "ListCampaignMatches",
+			Handler:    _FinanceService_ListCampaignMatches_Handler,
+		},
+		{
+			MethodName: "GetRevenueReport",
+			Handler:    _FinanceService_GetRevenueReport_Handler,
+		},
+		{
+			MethodName: "GetPendingInvoiceReport",
+			Handler:    _FinanceService_GetPendingInvoiceReport_Handler,
+		},
+		{
+			MethodName: "GetWalletLiabilityReport",
+			Handler:    _FinanceService_GetWalletLiabilityReport_Handler,
+		},
+		{
+			MethodName: "GetPaymentMethodReport",
+			Handler:    _FinanceService_GetPaymentMethodReport_Handler,
+		},
+		{
+			MethodName: "ExportJournal",
+			Handler:    _FinanceService_ExportJournal_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _FinanceService_ListJobs_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _FinanceService_TriggerJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/finance-service.proto",
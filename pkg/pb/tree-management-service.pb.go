@@ -67,19 +67,64 @@ func (x *IdRequest) GetId() string {
 	return ""
 }
 
+type IdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdsRequest) Reset() {
+	*x = IdsRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdsRequest) ProtoMessage() {}
+
+func (x *IdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdsRequest.ProtoReflect.Descriptor instead.
+func (*IdsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IdsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 type Species struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	CommonName      string                 `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
 	SpaceRequiredM2 float32                `protobuf:"fixed32,3,opt,name=space_required_m2,json=spaceRequiredM2,proto3" json:"space_required_m2,omitempty"`
 	Price           int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Currency        string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Species) Reset() {
 	*x = Species{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[1]
+	mi := &file_proto_tree_management_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -91,7 +136,7 @@ func (x *Species) String() string {
 func (*Species) ProtoMessage() {}
 
 func (x *Species) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[1]
+	mi := &file_proto_tree_management_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,7 +149,7 @@ func (x *Species) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Species.ProtoReflect.Descriptor instead.
 func (*Species) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{1}
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Species) GetId() string {
@@ -135,16 +180,125 @@ func (x *Species) GetPrice() int32 {
 	return 0
 }
 
+func (x *Species) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListSpeciesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	MinPrice           int32                  `protobuf:"varint,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice           int32                  `protobuf:"varint,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // 0 for no upper bound
+	Currency           string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	MaxSpaceRequiredM2 float32                `protobuf:"fixed32,6,opt,name=max_space_required_m2,json=maxSpaceRequiredM2,proto3" json:"max_space_required_m2,omitempty"` // 0 for no upper bound
+	SortBy             string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                           // common_name (default), price or space_required_m2
+	Descending         bool                   `protobuf:"varint,8,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListSpeciesRequest) Reset() {
+	*x = ListSpeciesRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpeciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpeciesRequest) ProtoMessage() {}
+
+func (x *ListSpeciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpeciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSpeciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSpeciesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSpeciesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSpeciesRequest) GetMinPrice() int32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListSpeciesRequest) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListSpeciesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListSpeciesRequest) GetMaxSpaceRequiredM2() float32 {
+	if x != nil {
+		return x.MaxSpaceRequiredM2
+	}
+	return 0
+}
+
+func (x *ListSpeciesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListSpeciesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
 type SpeciesList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Species       []*Species             `protobuf:"bytes,1,rep,name=species,proto3" json:"species,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SpeciesList) Reset() {
 	*x = SpeciesList{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[2]
+	mi := &file_proto_tree_management_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +310,7 @@ func (x *SpeciesList) String() string {
 func (*SpeciesList) ProtoMessage() {}
 
 func (x *SpeciesList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[2]
+	mi := &file_proto_tree_management_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +323,7 @@ func (x *SpeciesList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpeciesList.ProtoReflect.Descriptor instead.
 func (*SpeciesList) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{2}
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SpeciesList) GetSpecies() []*Species {
@@ -179,31 +333,940 @@ func (x *SpeciesList) GetSpecies() []*Species {
 	return nil
 }
 
+func (x *SpeciesList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SpeciesList) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
 type Plot struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	LocationName     string                 `protobuf:"bytes,2,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
 	Address          string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	AvailableSpaceM2 float32                `protobuf:"fixed32,4,opt,name=available_space_m2,json=availableSpaceM2,proto3" json:"available_space_m2,omitempty"`
+	Location         *GeoPoint              `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"` // defaults to the middle of the boundary
+	Boundary         []*GeoPoint            `protobuf:"bytes,6,rep,name=boundary,proto3" json:"boundary,omitempty"` // outline vertices in order, without repeating the first
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Plot) Reset() {
-	*x = Plot{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[3]
+func (x *Plot) Reset() {
+	*x = Plot{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plot) ProtoMessage() {}
+
+func (x *Plot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plot.ProtoReflect.Descriptor instead.
+func (*Plot) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Plot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Plot) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *Plot) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Plot) GetAvailableSpaceM2() float32 {
+	if x != nil {
+		return x.AvailableSpaceM2
+	}
+	return 0
+}
+
+func (x *Plot) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Plot) GetBoundary() []*GeoPoint {
+	if x != nil {
+		return x.Boundary
+	}
+	return nil
+}
+
+type ListPlotsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PageSize            int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken           string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Location            string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"` // matches location name or address, case-insensitive
+	MinAvailableSpaceM2 float32                `protobuf:"fixed32,4,opt,name=min_available_space_m2,json=minAvailableSpaceM2,proto3" json:"min_available_space_m2,omitempty"`
+	SortBy              string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // location_name (default) or available_space_m2
+	Descending          bool                   `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListPlotsRequest) Reset() {
+	*x = ListPlotsRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlotsRequest) ProtoMessage() {}
+
+func (x *ListPlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPlotsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlotsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPlotsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListPlotsRequest) GetMinAvailableSpaceM2() float32 {
+	if x != nil {
+		return x.MinAvailableSpaceM2
+	}
+	return 0
+}
+
+func (x *ListPlotsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListPlotsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type PlotList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plots         []*Plot                `protobuf:"bytes,1,rep,name=plots,proto3" json:"plots,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlotList) Reset() {
+	*x = PlotList{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlotList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotList) ProtoMessage() {}
+
+func (x *PlotList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotList.ProtoReflect.Descriptor instead.
+func (*PlotList) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlotList) GetPlots() []*Plot {
+	if x != nil {
+		return x.Plots
+	}
+	return nil
+}
+
+func (x *PlotList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PlotList) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type FindPlotsNearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusMeters  float64                `protobuf:"fixed64,3,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"` // at most 500 km
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPlotsNearRequest) Reset() {
+	*x = FindPlotsNearRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlotsNearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlotsNearRequest) ProtoMessage() {}
+
+func (x *FindPlotsNearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlotsNearRequest.ProtoReflect.Descriptor instead.
+func (*FindPlotsNearRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FindPlotsNearRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindPlotsNearRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FindPlotsNearRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+type FindPlotsWithinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinLatitude   float64                `protobuf:"fixed64,1,opt,name=min_latitude,json=minLatitude,proto3" json:"min_latitude,omitempty"`
+	MinLongitude  float64                `protobuf:"fixed64,2,opt,name=min_longitude,json=minLongitude,proto3" json:"min_longitude,omitempty"`
+	MaxLatitude   float64                `protobuf:"fixed64,3,opt,name=max_latitude,json=maxLatitude,proto3" json:"max_latitude,omitempty"`
+	MaxLongitude  float64                `protobuf:"fixed64,4,opt,name=max_longitude,json=maxLongitude,proto3" json:"max_longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPlotsWithinRequest) Reset() {
+	*x = FindPlotsWithinRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPlotsWithinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlotsWithinRequest) ProtoMessage() {}
+
+func (x *FindPlotsWithinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlotsWithinRequest.ProtoReflect.Descriptor instead.
+func (*FindPlotsWithinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FindPlotsWithinRequest) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *FindPlotsWithinRequest) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *FindPlotsWithinRequest) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *FindPlotsWithinRequest) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+type Tree struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SponsorId           string                 `protobuf:"bytes,2,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
+	SpeciesId           string                 `protobuf:"bytes,3,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
+	PlotId              string                 `protobuf:"bytes,4,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	CustomName          string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	CurrentHeightMeters float32                `protobuf:"fixed32,6,opt,name=current_height_meters,json=currentHeightMeters,proto3" json:"current_height_meters,omitempty"`
+	LastCareDate        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_care_date,json=lastCareDate,proto3" json:"last_care_date,omitempty"`
+	AdoptedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=adopted_at,json=adoptedAt,proto3" json:"adopted_at,omitempty"`
+	Location            *GeoPoint              `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"` // where the tree stands, inside its plot's boundary
+	Position            *GridPosition          `protobuf:"bytes,11,opt,name=position,proto3" json:"position,omitempty"` // planting row and column within the plot
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Tree) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tree) GetSponsorId() string {
+	if x != nil {
+		return x.SponsorId
+	}
+	return ""
+}
+
+func (x *Tree) GetSpeciesId() string {
+	if x != nil {
+		return x.SpeciesId
+	}
+	return ""
+}
+
+func (x *Tree) GetPlotId() string {
+	if x != nil {
+		return x.PlotId
+	}
+	return ""
+}
+
+func (x *Tree) GetCustomName() string {
+	if x != nil {
+		return x.CustomName
+	}
+	return ""
+}
+
+func (x *Tree) GetCurrentHeightMeters() float32 {
+	if x != nil {
+		return x.CurrentHeightMeters
+	}
+	return 0
+}
+
+func (x *Tree) GetLastCareDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCareDate
+	}
+	return nil
+}
+
+func (x *Tree) GetAdoptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdoptedAt
+	}
+	return nil
+}
+
+func (x *Tree) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Tree) GetPosition() *GridPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type GridPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`       // from 1
+	Column        int32                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"` // from 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GridPosition) Reset() {
+	*x = GridPosition{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GridPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GridPosition) ProtoMessage() {}
+
+func (x *GridPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GridPosition.ProtoReflect.Descriptor instead.
+func (*GridPosition) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GridPosition) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *GridPosition) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type ListTreesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SponsorId     string                 `protobuf:"bytes,3,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
+	SpeciesId     string                 `protobuf:"bytes,4,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
+	PlotId        string                 `protobuf:"bytes,5,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	AdoptedFrom   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=adopted_from,json=adoptedFrom,proto3" json:"adopted_from,omitempty"`
+	AdoptedTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=adopted_to,json=adoptedTo,proto3" json:"adopted_to,omitempty"` // exclusive
+	SortBy        string                 `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // adopted_at (default), custom_name or last_care_date
+	Descending    bool                   `protobuf:"varint,9,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTreesRequest) Reset() {
+	*x = ListTreesRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTreesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTreesRequest) ProtoMessage() {}
+
+func (x *ListTreesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTreesRequest.ProtoReflect.Descriptor instead.
+func (*ListTreesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTreesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTreesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTreesRequest) GetSponsorId() string {
+	if x != nil {
+		return x.SponsorId
+	}
+	return ""
+}
+
+func (x *ListTreesRequest) GetSpeciesId() string {
+	if x != nil {
+		return x.SpeciesId
+	}
+	return ""
+}
+
+func (x *ListTreesRequest) GetPlotId() string {
+	if x != nil {
+		return x.PlotId
+	}
+	return ""
+}
+
+func (x *ListTreesRequest) GetAdoptedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdoptedFrom
+	}
+	return nil
+}
+
+func (x *ListTreesRequest) GetAdoptedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdoptedTo
+	}
+	return nil
+}
+
+func (x *ListTreesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListTreesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type TreeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trees         []*Tree                `protobuf:"bytes,1,rep,name=trees,proto3" json:"trees,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreeList) Reset() {
+	*x = TreeList{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeList) ProtoMessage() {}
+
+func (x *TreeList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeList.ProtoReflect.Descriptor instead.
+func (*TreeList) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TreeList) GetTrees() []*Tree {
+	if x != nil {
+		return x.Trees
+	}
+	return nil
+}
+
+func (x *TreeList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *TreeList) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GiftOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RecipientEmail string                 `protobuf:"bytes,1,opt,name=recipient_email,json=recipientEmail,proto3" json:"recipient_email,omitempty"`
+	RecipientName  string                 `protobuf:"bytes,2,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GiftOptions) Reset() {
+	*x = GiftOptions{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftOptions) ProtoMessage() {}
+
+func (x *GiftOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftOptions.ProtoReflect.Descriptor instead.
+func (*GiftOptions) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GiftOptions) GetRecipientEmail() string {
+	if x != nil {
+		return x.RecipientEmail
+	}
+	return ""
+}
+
+func (x *GiftOptions) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *GiftOptions) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AdoptTreeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SpeciesId      string                 `protobuf:"bytes,1,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
+	PlotId         string                 `protobuf:"bytes,2,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	CustomName     string                 `protobuf:"bytes,3,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	Gift           *GiftOptions           `protobuf:"bytes,4,opt,name=gift,proto3" json:"gift,omitempty"`
+	VoucherCode    string                 `protobuf:"bytes,5,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	PaymentMethod  string                 `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`    // VIRTUAL_ACCOUNT, QRIS, EWALLET or CARD; empty for any
+	PaymentChannel string                 `protobuf:"bytes,7,opt,name=payment_channel,json=paymentChannel,proto3" json:"payment_channel,omitempty"` // bank or e-wallet, e.g. BCA or OVO
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdoptTreeRequest) Reset() {
+	*x = AdoptTreeRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdoptTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptTreeRequest) ProtoMessage() {}
+
+func (x *AdoptTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptTreeRequest.ProtoReflect.Descriptor instead.
+func (*AdoptTreeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AdoptTreeRequest) GetSpeciesId() string {
+	if x != nil {
+		return x.SpeciesId
+	}
+	return ""
+}
+
+func (x *AdoptTreeRequest) GetPlotId() string {
+	if x != nil {
+		return x.PlotId
+	}
+	return ""
+}
+
+func (x *AdoptTreeRequest) GetCustomName() string {
+	if x != nil {
+		return x.CustomName
+	}
+	return ""
+}
+
+func (x *AdoptTreeRequest) GetGift() *GiftOptions {
+	if x != nil {
+		return x.Gift
+	}
+	return nil
+}
+
+func (x *AdoptTreeRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+func (x *AdoptTreeRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *AdoptTreeRequest) GetPaymentChannel() string {
+	if x != nil {
+		return x.PaymentChannel
+	}
+	return ""
+}
+
+type AdoptTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	PaymentUrl    string                 `protobuf:"bytes,2,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	InvoiceId     string                 `protobuf:"bytes,3,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdoptTreeResponse) Reset() {
+	*x = AdoptTreeResponse{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Plot) String() string {
+func (x *AdoptTreeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plot) ProtoMessage() {}
+func (*AdoptTreeResponse) ProtoMessage() {}
 
-func (x *Plot) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[3]
+func (x *AdoptTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,61 +1277,54 @@ func (x *Plot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plot.ProtoReflect.Descriptor instead.
-func (*Plot) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AdoptTreeResponse.ProtoReflect.Descriptor instead.
+func (*AdoptTreeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Plot) GetId() string {
+func (x *AdoptTreeResponse) GetTreeId() string {
 	if x != nil {
-		return x.Id
+		return x.TreeId
 	}
 	return ""
 }
 
-func (x *Plot) GetLocationName() string {
+func (x *AdoptTreeResponse) GetPaymentUrl() string {
 	if x != nil {
-		return x.LocationName
+		return x.PaymentUrl
 	}
 	return ""
 }
 
-func (x *Plot) GetAddress() string {
+func (x *AdoptTreeResponse) GetInvoiceId() string {
 	if x != nil {
-		return x.Address
+		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *Plot) GetAvailableSpaceM2() float32 {
-	if x != nil {
-		return x.AvailableSpaceM2
-	}
-	return 0
-}
-
-type PlotList struct {
+type ClaimGiftRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plots         []*Plot                `protobuf:"bytes,1,rep,name=plots,proto3" json:"plots,omitempty"`
+	ClaimCode     string                 `protobuf:"bytes,1,opt,name=claim_code,json=claimCode,proto3" json:"claim_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlotList) Reset() {
-	*x = PlotList{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[4]
+func (x *ClaimGiftRequest) Reset() {
+	*x = ClaimGiftRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlotList) String() string {
+func (x *ClaimGiftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlotList) ProtoMessage() {}
+func (*ClaimGiftRequest) ProtoMessage() {}
 
-func (x *PlotList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[4]
+func (x *ClaimGiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,47 +1335,45 @@ func (x *PlotList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlotList.ProtoReflect.Descriptor instead.
-func (*PlotList) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ClaimGiftRequest.ProtoReflect.Descriptor instead.
+func (*ClaimGiftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PlotList) GetPlots() []*Plot {
+func (x *ClaimGiftRequest) GetClaimCode() string {
 	if x != nil {
-		return x.Plots
+		return x.ClaimCode
 	}
-	return nil
+	return ""
 }
 
-type Tree struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SponsorId           string                 `protobuf:"bytes,2,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
-	SpeciesId           string                 `protobuf:"bytes,3,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
-	PlotId              string                 `protobuf:"bytes,4,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
-	CustomName          string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
-	CurrentHeightMeters float32                `protobuf:"fixed32,6,opt,name=current_height_meters,json=currentHeightMeters,proto3" json:"current_height_meters,omitempty"`
-	LastCareDate        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_care_date,json=lastCareDate,proto3" json:"last_care_date,omitempty"`
-	AdoptedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=adopted_at,json=adoptedAt,proto3" json:"adopted_at,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type AdoptionIntent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SponsorId     string                 `protobuf:"bytes,2,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
+	SpeciesId     string                 `protobuf:"bytes,3,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
+	PlotId        string                 `protobuf:"bytes,4,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tree) Reset() {
-	*x = Tree{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[5]
+func (x *AdoptionIntent) Reset() {
+	*x = AdoptionIntent{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tree) String() string {
+func (x *AdoptionIntent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tree) ProtoMessage() {}
+func (*AdoptionIntent) ProtoMessage() {}
 
-func (x *Tree) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[5]
+func (x *AdoptionIntent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,89 +1384,124 @@ func (x *Tree) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
-func (*Tree) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AdoptionIntent.ProtoReflect.Descriptor instead.
+func (*AdoptionIntent) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Tree) GetId() string {
+func (x *AdoptionIntent) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Tree) GetSponsorId() string {
+func (x *AdoptionIntent) GetSponsorId() string {
 	if x != nil {
 		return x.SponsorId
 	}
 	return ""
 }
 
-func (x *Tree) GetSpeciesId() string {
+func (x *AdoptionIntent) GetSpeciesId() string {
 	if x != nil {
 		return x.SpeciesId
 	}
 	return ""
 }
 
-func (x *Tree) GetPlotId() string {
+func (x *AdoptionIntent) GetPlotId() string {
 	if x != nil {
 		return x.PlotId
 	}
 	return ""
 }
 
-func (x *Tree) GetCustomName() string {
+func (x *AdoptionIntent) GetStatus() string {
 	if x != nil {
-		return x.CustomName
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Tree) GetCurrentHeightMeters() float32 {
+func (x *AdoptionIntent) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CurrentHeightMeters
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Tree) GetLastCareDate() *timestamppb.Timestamp {
+type AdoptionIntentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intents       []*AdoptionIntent      `protobuf:"bytes,1,rep,name=intents,proto3" json:"intents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdoptionIntentList) Reset() {
+	*x = AdoptionIntentList{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdoptionIntentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptionIntentList) ProtoMessage() {}
+
+func (x *AdoptionIntentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[20]
 	if x != nil {
-		return x.LastCareDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Tree) GetAdoptedAt() *timestamppb.Timestamp {
+// Deprecated: Use AdoptionIntentList.ProtoReflect.Descriptor instead.
+func (*AdoptionIntentList) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AdoptionIntentList) GetIntents() []*AdoptionIntent {
 	if x != nil {
-		return x.AdoptedAt
+		return x.Intents
 	}
 	return nil
 }
 
-type TreeList struct {
+// MyTree is one of the caller's trees with the names of its species and
+// plot. Its height is that of the latest log, and its last care date that of
+// the latest log if more recent than planting.
+type MyTree struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Trees         []*Tree                `protobuf:"bytes,1,rep,name=trees,proto3" json:"trees,omitempty"`
+	Tree          *Tree                  `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
+	SpeciesName   string                 `protobuf:"bytes,2,opt,name=species_name,json=speciesName,proto3" json:"species_name,omitempty"`
+	PlotName      string                 `protobuf:"bytes,3,opt,name=plot_name,json=plotName,proto3" json:"plot_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TreeList) Reset() {
-	*x = TreeList{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[6]
+func (x *MyTree) Reset() {
+	*x = MyTree{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TreeList) String() string {
+func (x *MyTree) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TreeList) ProtoMessage() {}
+func (*MyTree) ProtoMessage() {}
 
-func (x *TreeList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[6]
+func (x *MyTree) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,42 +1512,57 @@ func (x *TreeList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TreeList.ProtoReflect.Descriptor instead.
-func (*TreeList) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MyTree.ProtoReflect.Descriptor instead.
+func (*MyTree) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TreeList) GetTrees() []*Tree {
+func (x *MyTree) GetTree() *Tree {
 	if x != nil {
-		return x.Trees
+		return x.Tree
 	}
 	return nil
 }
 
-type AdoptTreeRequest struct {
+func (x *MyTree) GetSpeciesName() string {
+	if x != nil {
+		return x.SpeciesName
+	}
+	return ""
+}
+
+func (x *MyTree) GetPlotName() string {
+	if x != nil {
+		return x.PlotName
+	}
+	return ""
+}
+
+type ListMyTreesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SpeciesId     string                 `protobuf:"bytes,1,opt,name=species_id,json=speciesId,proto3" json:"species_id,omitempty"`
-	PlotId        string                 `protobuf:"bytes,2,opt,name=plot_id,json=plotId,proto3" json:"plot_id,omitempty"`
-	CustomName    string                 `protobuf:"bytes,3,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy        string                 `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // adopted_at (default), custom_name or last_care_date
+	Descending    bool                   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdoptTreeRequest) Reset() {
-	*x = AdoptTreeRequest{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[7]
+func (x *ListMyTreesRequest) Reset() {
+	*x = ListMyTreesRequest{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdoptTreeRequest) String() string {
+func (x *ListMyTreesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdoptTreeRequest) ProtoMessage() {}
+func (*ListMyTreesRequest) ProtoMessage() {}
 
-func (x *AdoptTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[7]
+func (x *ListMyTreesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,56 +1573,64 @@ func (x *AdoptTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdoptTreeRequest.ProtoReflect.Descriptor instead.
-func (*AdoptTreeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListMyTreesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTreesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AdoptTreeRequest) GetSpeciesId() string {
+func (x *ListMyTreesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.SpeciesId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *AdoptTreeRequest) GetPlotId() string {
+func (x *ListMyTreesRequest) GetPageToken() string {
 	if x != nil {
-		return x.PlotId
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *AdoptTreeRequest) GetCustomName() string {
+func (x *ListMyTreesRequest) GetSortBy() string {
 	if x != nil {
-		return x.CustomName
+		return x.SortBy
 	}
 	return ""
 }
 
-type AdoptTreeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	PaymentUrl    string                 `protobuf:"bytes,2,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	InvoiceId     string                 `protobuf:"bytes,3,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListMyTreesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
 }
 
-func (x *AdoptTreeResponse) Reset() {
-	*x = AdoptTreeResponse{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[8]
+type MyTreeList struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Trees          []*MyTree              `protobuf:"bytes,1,rep,name=trees,proto3" json:"trees,omitempty"`
+	PendingIntents []*AdoptionIntent      `protobuf:"bytes,2,rep,name=pending_intents,json=pendingIntents,proto3" json:"pending_intents,omitempty"` // adoptions awaiting payment
+	NextPageToken  string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount     int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MyTreeList) Reset() {
+	*x = MyTreeList{}
+	mi := &file_proto_tree_management_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdoptTreeResponse) String() string {
+func (x *MyTreeList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdoptTreeResponse) ProtoMessage() {}
+func (*MyTreeList) ProtoMessage() {}
 
-func (x *AdoptTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[8]
+func (x *MyTreeList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tree_management_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,32 +1641,39 @@ func (x *AdoptTreeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdoptTreeResponse.ProtoReflect.Descriptor instead.
-func (*AdoptTreeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MyTreeList.ProtoReflect.Descriptor instead.
+func (*MyTreeList) Descriptor() ([]byte, []int) {
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AdoptTreeResponse) GetTreeId() string {
+func (x *MyTreeList) GetTrees() []*MyTree {
 	if x != nil {
-		return x.TreeId
+		return x.Trees
 	}
-	return ""
+	return nil
 }
 
-func (x *AdoptTreeResponse) GetPaymentUrl() string {
+func (x *MyTreeList) GetPendingIntents() []*AdoptionIntent {
 	if x != nil {
-		return x.PaymentUrl
+		return x.PendingIntents
 	}
-	return ""
+	return nil
 }
 
-func (x *AdoptTreeResponse) GetInvoiceId() string {
+func (x *MyTreeList) GetNextPageToken() string {
 	if x != nil {
-		return x.InvoiceId
+		return x.NextPageToken
 	}
 	return ""
 }
 
+func (x *MyTreeList) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type LogEntry struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -564,13 +1683,15 @@ type LogEntry struct {
 	Activity            string                 `protobuf:"bytes,5,opt,name=activity,proto3" json:"activity,omitempty"`
 	Note                string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
 	RecordedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	Location            *GeoPoint              `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	Position            *GridPosition          `protobuf:"bytes,9,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *LogEntry) Reset() {
 	*x = LogEntry{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[9]
+	mi := &file_proto_tree_management_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -582,7 +1703,7 @@ func (x *LogEntry) String() string {
 func (*LogEntry) ProtoMessage() {}
 
 func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[9]
+	mi := &file_proto_tree_management_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +1716,7 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{9}
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *LogEntry) GetId() string {
@@ -647,19 +1768,35 @@ func (x *LogEntry) GetRecordedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *LogEntry) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *LogEntry) GetPosition() *GridPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
 type CreateLogRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	AdoptedTreeId       string                 `protobuf:"bytes,1,opt,name=adopted_tree_id,json=adoptedTreeId,proto3" json:"adopted_tree_id,omitempty"`
 	CurrentHeightMeters float32                `protobuf:"fixed32,2,opt,name=current_height_meters,json=currentHeightMeters,proto3" json:"current_height_meters,omitempty"`
 	Activity            string                 `protobuf:"bytes,3,opt,name=activity,proto3" json:"activity,omitempty"`
 	Note                string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Location            *GeoPoint              `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"` // moves the tree's recorded location when set
+	Position            *GridPosition          `protobuf:"bytes,6,opt,name=position,proto3" json:"position,omitempty"` // moves the tree's recorded grid position when set
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CreateLogRequest) Reset() {
 	*x = CreateLogRequest{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[10]
+	mi := &file_proto_tree_management_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -671,7 +1808,7 @@ func (x *CreateLogRequest) String() string {
 func (*CreateLogRequest) ProtoMessage() {}
 
 func (x *CreateLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[10]
+	mi := &file_proto_tree_management_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +1821,7 @@ func (x *CreateLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLogRequest.ProtoReflect.Descriptor instead.
 func (*CreateLogRequest) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{10}
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateLogRequest) GetAdoptedTreeId() string {
@@ -715,6 +1852,20 @@ func (x *CreateLogRequest) GetNote() string {
 	return ""
 }
 
+func (x *CreateLogRequest) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateLogRequest) GetPosition() *GridPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
 type LogList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Logs          []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
@@ -724,7 +1875,7 @@ type LogList struct {
 
 func (x *LogList) Reset() {
 	*x = LogList{}
-	mi := &file_proto_tree_management_service_proto_msgTypes[11]
+	mi := &file_proto_tree_management_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -736,7 +1887,7 @@ func (x *LogList) String() string {
 func (*LogList) ProtoMessage() {}
 
 func (x *LogList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tree_management_service_proto_msgTypes[11]
+	mi := &file_proto_tree_management_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,7 +1900,7 @@ func (x *LogList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogList.ProtoReflect.Descriptor instead.
 func (*LogList) Descriptor() ([]byte, []int) {
-	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{11}
+	return file_proto_tree_management_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LogList) GetLogs() []*LogEntry {
@@ -765,23 +1916,69 @@ const file_proto_tree_management_service_proto_rawDesc = "" +
 	"\n" +
 	"#proto/tree-management-service.proto\x12\x04tree\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1b\n" +
 	"\tIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"|\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
+	"\n" +
+	"IdsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\x98\x01\n" +
 	"\aSpecies\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcommon_name\x18\x02 \x01(\tR\n" +
 	"commonName\x12*\n" +
 	"\x11space_required_m2\x18\x03 \x01(\x02R\x0fspaceRequiredM2\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x05R\x05price\"6\n" +
+	"\x05price\x18\x04 \x01(\x05R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\x92\x02\n" +
+	"\x12ListSpeciesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tmin_price\x18\x03 \x01(\x05R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x04 \x01(\x05R\bmaxPrice\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x121\n" +
+	"\x15max_space_required_m2\x18\x06 \x01(\x02R\x12maxSpaceRequiredM2\x12\x17\n" +
+	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\b \x01(\bR\n" +
+	"descending\"\x7f\n" +
 	"\vSpeciesList\x12'\n" +
-	"\aspecies\x18\x01 \x03(\v2\r.tree.SpeciesR\aspecies\"\x83\x01\n" +
+	"\aspecies\x18\x01 \x03(\v2\r.tree.SpeciesR\aspecies\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xdb\x01\n" +
 	"\x04Plot\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rlocation_name\x18\x02 \x01(\tR\flocationName\x12\x18\n" +
 	"\aaddress\x18\x03 \x01(\tR\aaddress\x12,\n" +
-	"\x12available_space_m2\x18\x04 \x01(\x02R\x10availableSpaceM2\",\n" +
+	"\x12available_space_m2\x18\x04 \x01(\x02R\x10availableSpaceM2\x12*\n" +
+	"\blocation\x18\x05 \x01(\v2\x0e.tree.GeoPointR\blocation\x12*\n" +
+	"\bboundary\x18\x06 \x03(\v2\x0e.tree.GeoPointR\bboundary\"\xd8\x01\n" +
+	"\x10ListPlotsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x123\n" +
+	"\x16min_available_space_m2\x18\x04 \x01(\x02R\x13minAvailableSpaceM2\x12\x17\n" +
+	"\asort_by\x18\x05 \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x06 \x01(\bR\n" +
+	"descending\"u\n" +
 	"\bPlotList\x12 \n" +
 	"\x05plots\x18\x01 \x03(\v2\n" +
-	".tree.PlotR\x05plots\"\xbf\x02\n" +
+	".tree.PlotR\x05plots\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"u\n" +
+	"\x14FindPlotsNearRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12#\n" +
+	"\rradius_meters\x18\x03 \x01(\x01R\fradiusMeters\"\xa8\x01\n" +
+	"\x16FindPlotsWithinRequest\x12!\n" +
+	"\fmin_latitude\x18\x01 \x01(\x01R\vminLatitude\x12#\n" +
+	"\rmin_longitude\x18\x02 \x01(\x01R\fminLongitude\x12!\n" +
+	"\fmax_latitude\x18\x03 \x01(\x01R\vmaxLatitude\x12#\n" +
+	"\rmax_longitude\x18\x04 \x01(\x01R\fmaxLongitude\"\x9b\x03\n" +
 	"\x04Tree\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -794,22 +1991,90 @@ const file_proto_tree_management_service_proto_rawDesc = "" +
 	"\x15current_height_meters\x18\x06 \x01(\x02R\x13currentHeightMeters\x12@\n" +
 	"\x0elast_care_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\flastCareDate\x129\n" +
 	"\n" +
-	"adopted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tadoptedAt\",\n" +
+	"adopted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tadoptedAt\x12*\n" +
+	"\blocation\x18\n" +
+	" \x01(\v2\x0e.tree.GeoPointR\blocation\x12.\n" +
+	"\bposition\x18\v \x01(\v2\x12.tree.GridPositionR\bposition\"8\n" +
+	"\fGridPosition\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\x05R\x06column\"\xd8\x02\n" +
+	"\x10ListTreesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"sponsor_id\x18\x03 \x01(\tR\tsponsorId\x12\x1d\n" +
+	"\n" +
+	"species_id\x18\x04 \x01(\tR\tspeciesId\x12\x17\n" +
+	"\aplot_id\x18\x05 \x01(\tR\x06plotId\x12=\n" +
+	"\fadopted_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vadoptedFrom\x129\n" +
+	"\n" +
+	"adopted_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tadoptedTo\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\t \x01(\bR\n" +
+	"descending\"u\n" +
 	"\bTreeList\x12 \n" +
 	"\x05trees\x18\x01 \x03(\v2\n" +
-	".tree.TreeR\x05trees\"k\n" +
+	".tree.TreeR\x05trees\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"w\n" +
+	"\vGiftOptions\x12'\n" +
+	"\x0frecipient_email\x18\x01 \x01(\tR\x0erecipientEmail\x12%\n" +
+	"\x0erecipient_name\x18\x02 \x01(\tR\rrecipientName\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x85\x02\n" +
 	"\x10AdoptTreeRequest\x12\x1d\n" +
 	"\n" +
 	"species_id\x18\x01 \x01(\tR\tspeciesId\x12\x17\n" +
 	"\aplot_id\x18\x02 \x01(\tR\x06plotId\x12\x1f\n" +
 	"\vcustom_name\x18\x03 \x01(\tR\n" +
-	"customName\"l\n" +
+	"customName\x12%\n" +
+	"\x04gift\x18\x04 \x01(\v2\x11.tree.GiftOptionsR\x04gift\x12!\n" +
+	"\fvoucher_code\x18\x05 \x01(\tR\vvoucherCode\x12%\n" +
+	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fpayment_channel\x18\a \x01(\tR\x0epaymentChannel\"l\n" +
 	"\x11AdoptTreeResponse\x12\x17\n" +
 	"\atree_id\x18\x01 \x01(\tR\x06treeId\x12\x1f\n" +
 	"\vpayment_url\x18\x02 \x01(\tR\n" +
 	"paymentUrl\x12\x1d\n" +
 	"\n" +
-	"invoice_id\x18\x03 \x01(\tR\tinvoiceId\"\xfe\x01\n" +
+	"invoice_id\x18\x03 \x01(\tR\tinvoiceId\"1\n" +
+	"\x10ClaimGiftRequest\x12\x1d\n" +
+	"\n" +
+	"claim_code\x18\x01 \x01(\tR\tclaimCode\"\xca\x01\n" +
+	"\x0eAdoptionIntent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"sponsor_id\x18\x02 \x01(\tR\tsponsorId\x12\x1d\n" +
+	"\n" +
+	"species_id\x18\x03 \x01(\tR\tspeciesId\x12\x17\n" +
+	"\aplot_id\x18\x04 \x01(\tR\x06plotId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"D\n" +
+	"\x12AdoptionIntentList\x12.\n" +
+	"\aintents\x18\x01 \x03(\v2\x14.tree.AdoptionIntentR\aintents\"h\n" +
+	"\x06MyTree\x12\x1e\n" +
+	"\x04tree\x18\x01 \x01(\v2\n" +
+	".tree.TreeR\x04tree\x12!\n" +
+	"\fspecies_name\x18\x02 \x01(\tR\vspeciesName\x12\x1b\n" +
+	"\tplot_name\x18\x03 \x01(\tR\bplotName\"\x89\x01\n" +
+	"\x12ListMyTreesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x17\n" +
+	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x04 \x01(\bR\n" +
+	"descending\"\xb8\x01\n" +
+	"\n" +
+	"MyTreeList\x12\"\n" +
+	"\x05trees\x18\x01 \x03(\v2\f.tree.MyTreeR\x05trees\x12=\n" +
+	"\x0fpending_intents\x18\x02 \x03(\v2\x14.tree.AdoptionIntentR\x0ependingIntents\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x03R\n" +
+	"totalCount\"\xda\x02\n" +
 	"\bLogEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
 	"\x0fadopted_tree_id\x18\x02 \x01(\tR\radoptedTreeId\x12\x19\n" +
@@ -818,22 +2083,29 @@ const file_proto_tree_management_service_proto_rawDesc = "" +
 	"\bactivity\x18\x05 \x01(\tR\bactivity\x12\x12\n" +
 	"\x04note\x18\x06 \x01(\tR\x04note\x12;\n" +
 	"\vrecorded_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"recordedAt\"\x9e\x01\n" +
+	"recordedAt\x12*\n" +
+	"\blocation\x18\b \x01(\v2\x0e.tree.GeoPointR\blocation\x12.\n" +
+	"\bposition\x18\t \x01(\v2\x12.tree.GridPositionR\bposition\"\xfa\x01\n" +
 	"\x10CreateLogRequest\x12&\n" +
 	"\x0fadopted_tree_id\x18\x01 \x01(\tR\radoptedTreeId\x122\n" +
 	"\x15current_height_meters\x18\x02 \x01(\x02R\x13currentHeightMeters\x12\x1a\n" +
 	"\bactivity\x18\x03 \x01(\tR\bactivity\x12\x12\n" +
-	"\x04note\x18\x04 \x01(\tR\x04note\"-\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12*\n" +
+	"\blocation\x18\x05 \x01(\v2\x0e.tree.GeoPointR\blocation\x12.\n" +
+	"\bposition\x18\x06 \x01(\v2\x12.tree.GridPositionR\bposition\"-\n" +
 	"\aLogList\x12\"\n" +
-	"\x04logs\x18\x01 \x03(\v2\x0e.tree.LogEntryR\x04logs2\xfa\a\n" +
-	"\vTreeService\x128\n" +
-	"\vListSpecies\x12\x16.google.protobuf.Empty\x1a\x11.tree.SpeciesList\x12,\n" +
+	"\x04logs\x18\x01 \x03(\v2\x0e.tree.LogEntryR\x04logs2\xa9\n" +
+	"\n" +
+	"\vTreeService\x12:\n" +
+	"\vListSpecies\x12\x18.tree.ListSpeciesRequest\x1a\x11.tree.SpeciesList\x12,\n" +
 	"\n" +
 	"GetSpecies\x12\x0f.tree.IdRequest\x1a\r.tree.Species\x12-\n" +
 	"\rCreateSpecies\x12\r.tree.Species\x1a\r.tree.Species\x12-\n" +
 	"\rUpdateSpecies\x12\r.tree.Species\x1a\r.tree.Species\x128\n" +
 	"\rDeleteSpecies\x12\x0f.tree.IdRequest\x1a\x16.google.protobuf.Empty\x123\n" +
-	"\tListPlots\x12\x16.google.protobuf.Empty\x1a\x0e.tree.PlotList\x12&\n" +
+	"\tListPlots\x12\x16.tree.ListPlotsRequest\x1a\x0e.tree.PlotList\x12;\n" +
+	"\rFindPlotsNear\x12\x1a.tree.FindPlotsNearRequest\x1a\x0e.tree.PlotList\x12?\n" +
+	"\x0fFindPlotsWithin\x12\x1c.tree.FindPlotsWithinRequest\x1a\x0e.tree.PlotList\x12&\n" +
 	"\aGetPlot\x12\x0f.tree.IdRequest\x1a\n" +
 	".tree.Plot\x12$\n" +
 	"\n" +
@@ -846,7 +2118,7 @@ const file_proto_tree_management_service_proto_rawDesc = "" +
 	".tree.Plot\x125\n" +
 	"\n" +
 	"DeletePlot\x12\x0f.tree.IdRequest\x1a\x16.google.protobuf.Empty\x123\n" +
-	"\tListTrees\x12\x16.google.protobuf.Empty\x1a\x0e.tree.TreeList\x12<\n" +
+	"\tListTrees\x12\x16.tree.ListTreesRequest\x1a\x0e.tree.TreeList\x12<\n" +
 	"\tAdoptTree\x12\x16.tree.AdoptTreeRequest\x1a\x17.tree.AdoptTreeResponse\x12&\n" +
 	"\aGetTree\x12\x0f.tree.IdRequest\x1a\n" +
 	".tree.Tree\x12$\n" +
@@ -855,7 +2127,11 @@ const file_proto_tree_management_service_proto_rawDesc = "" +
 	".tree.Tree\x1a\n" +
 	".tree.Tree\x125\n" +
 	"\n" +
-	"DeleteTree\x12\x0f.tree.IdRequest\x1a\x16.google.protobuf.Empty\x12-\n" +
+	"DeleteTree\x12\x0f.tree.IdRequest\x1a\x16.google.protobuf.Empty\x12/\n" +
+	"\tClaimGift\x12\x16.tree.ClaimGiftRequest\x1a\n" +
+	".tree.Tree\x12A\n" +
+	"\x13ListAdoptionIntents\x12\x10.tree.IdsRequest\x1a\x18.tree.AdoptionIntentList\x129\n" +
+	"\vListMyTrees\x12\x18.tree.ListMyTreesRequest\x1a\x10.tree.MyTreeList\x12-\n" +
 	"\vGetTreeLogs\x12\x0f.tree.IdRequest\x1a\r.tree.LogList\x123\n" +
 	"\tCreateLog\x12\x16.tree.CreateLogRequest\x1a\x0e.tree.LogEntry\x12+\n" +
 	"\tUpdateLog\x12\x0e.tree.LogEntry\x1a\x0e.tree.LogEntry\x124\n" +
@@ -874,76 +2150,117 @@ func file_proto_tree_management_service_proto_rawDescGZIP() []byte {
 	return file_proto_tree_management_service_proto_rawDescData
 }
 
-var file_proto_tree_management_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_tree_management_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_proto_tree_management_service_proto_goTypes = []any{
-	(*IdRequest)(nil),             // 0: tree.IdRequest
-	(*Species)(nil),               // 1: tree.Species
-	(*SpeciesList)(nil),           // 2: tree.SpeciesList
-	(*Plot)(nil),                  // 3: tree.Plot
-	(*PlotList)(nil),              // 4: tree.PlotList
-	(*Tree)(nil),                  // 5: tree.Tree
-	(*TreeList)(nil),              // 6: tree.TreeList
-	(*AdoptTreeRequest)(nil),      // 7: tree.AdoptTreeRequest
-	(*AdoptTreeResponse)(nil),     // 8: tree.AdoptTreeResponse
-	(*LogEntry)(nil),              // 9: tree.LogEntry
-	(*CreateLogRequest)(nil),      // 10: tree.CreateLogRequest
-	(*LogList)(nil),               // 11: tree.LogList
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+	(*IdRequest)(nil),              // 0: tree.IdRequest
+	(*IdsRequest)(nil),             // 1: tree.IdsRequest
+	(*Species)(nil),                // 2: tree.Species
+	(*ListSpeciesRequest)(nil),     // 3: tree.ListSpeciesRequest
+	(*SpeciesList)(nil),            // 4: tree.SpeciesList
+	(*GeoPoint)(nil),               // 5: tree.GeoPoint
+	(*Plot)(nil),                   // 6: tree.Plot
+	(*ListPlotsRequest)(nil),       // 7: tree.ListPlotsRequest
+	(*PlotList)(nil),               // 8: tree.PlotList
+	(*FindPlotsNearRequest)(nil),   // 9: tree.FindPlotsNearRequest
+	(*FindPlotsWithinRequest)(nil), // 10: tree.FindPlotsWithinRequest
+	(*Tree)(nil),                   // 11: tree.Tree
+	(*GridPosition)(nil),           // 12: tree.GridPosition
+	(*ListTreesRequest)(nil),       // 13: tree.ListTreesRequest
+	(*TreeList)(nil),               // 14: tree.TreeList
+	(*GiftOptions)(nil),            // 15: tree.GiftOptions
+	(*AdoptTreeRequest)(nil),       // 16: tree.AdoptTreeRequest
+	(*AdoptTreeResponse)(nil),      // 17: tree.AdoptTreeResponse
+	(*ClaimGiftRequest)(nil),       // 18: tree.ClaimGiftRequest
+	(*AdoptionIntent)(nil),         // 19: tree.AdoptionIntent
+	(*AdoptionIntentList)(nil),     // 20: tree.AdoptionIntentList
+	(*MyTree)(nil),                 // 21: tree.MyTree
+	(*ListMyTreesRequest)(nil),     // 22: tree.ListMyTreesRequest
+	(*MyTreeList)(nil),             // 23: tree.MyTreeList
+	(*LogEntry)(nil),               // 24: tree.LogEntry
+	(*CreateLogRequest)(nil),       // 25: tree.CreateLogRequest
+	(*LogList)(nil),                // 26: tree.LogList
+	(*timestamppb.Timestamp)(nil),  // 27: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 28: google.protobuf.Empty
 }
 var file_proto_tree_management_service_proto_depIdxs = []int32{
-	1,  // 0: tree.SpeciesList.species:type_name -> tree.Species
-	3,  // 1: tree.PlotList.plots:type_name -> tree.Plot
-	12, // 2: tree.Tree.last_care_date:type_name -> google.protobuf.Timestamp
-	12, // 3: tree.Tree.adopted_at:type_name -> google.protobuf.Timestamp
-	5,  // 4: tree.TreeList.trees:type_name -> tree.Tree
-	12, // 5: tree.LogEntry.recorded_at:type_name -> google.protobuf.Timestamp
-	9,  // 6: tree.LogList.logs:type_name -> tree.LogEntry
-	13, // 7: tree.TreeService.ListSpecies:input_type -> google.protobuf.Empty
-	0,  // 8: tree.TreeService.GetSpecies:input_type -> tree.IdRequest
-	1,  // 9: tree.TreeService.CreateSpecies:input_type -> tree.Species
-	1,  // 10: tree.TreeService.UpdateSpecies:input_type -> tree.Species
-	0,  // 11: tree.TreeService.DeleteSpecies:input_type -> tree.IdRequest
-	13, // 12: tree.TreeService.ListPlots:input_type -> google.protobuf.Empty
-	0,  // 13: tree.TreeService.GetPlot:input_type -> tree.IdRequest
-	3,  // 14: tree.TreeService.CreatePlot:input_type -> tree.Plot
-	3,  // 15: tree.TreeService.UpdatePlot:input_type -> tree.Plot
-	0,  // 16: tree.TreeService.DeletePlot:input_type -> tree.IdRequest
-	13, // 17: tree.TreeService.ListTrees:input_type -> google.protobuf.Empty
-	7,  // 18: tree.TreeService.AdoptTree:input_type -> tree.AdoptTreeRequest
-	0,  // 19: tree.TreeService.GetTree:input_type -> tree.IdRequest
-	5,  // 20: tree.TreeService.UpdateTree:input_type -> tree.Tree
-	0,  // 21: tree.TreeService.DeleteTree:input_type -> tree.IdRequest
-	0,  // 22: tree.TreeService.GetTreeLogs:input_type -> tree.IdRequest
-	10, // 23: tree.TreeService.CreateLog:input_type -> tree.CreateLogRequest
-	9,  // 24: tree.TreeService.UpdateLog:input_type -> tree.LogEntry
-	0,  // 25: tree.TreeService.DeleteLog:input_type -> tree.IdRequest
-	13, // 26: tree.TreeService.TriggerBiweeklyMaintenance:input_type -> google.protobuf.Empty
-	2,  // 27: tree.TreeService.ListSpecies:output_type -> tree.SpeciesList
-	1,  // 28: tree.TreeService.GetSpecies:output_type -> tree.Species
-	1,  // 29: tree.TreeService.CreateSpecies:output_type -> tree.Species
-	1,  // 30: tree.TreeService.UpdateSpecies:output_type -> tree.Species
-	13, // 31: tree.TreeService.DeleteSpecies:output_type -> google.protobuf.Empty
-	4,  // 32: tree.TreeService.ListPlots:output_type -> tree.PlotList
-	3,  // 33: tree.TreeService.GetPlot:output_type -> tree.Plot
-	3,  // 34: tree.TreeService.CreatePlot:output_type -> tree.Plot
-	3,  // 35: tree.TreeService.UpdatePlot:output_type -> tree.Plot
-	13, // 36: tree.TreeService.DeletePlot:output_type -> google.protobuf.Empty
-	6,  // 37: tree.TreeService.ListTrees:output_type -> tree.TreeList
-	8,  // 38: tree.TreeService.AdoptTree:output_type -> tree.AdoptTreeResponse
-	5,  // 39: tree.TreeService.GetTree:output_type -> tree.Tree
-	5,  // 40: tree.TreeService.UpdateTree:output_type -> tree.Tree
-	13, // 41: tree.TreeService.DeleteTree:output_type -> google.protobuf.Empty
-	11, // 42: tree.TreeService.GetTreeLogs:output_type -> tree.LogList
-	9,  // 43: tree.TreeService.CreateLog:output_type -> tree.LogEntry
-	9,  // 44: tree.TreeService.UpdateLog:output_type -> tree.LogEntry
-	13, // 45: tree.TreeService.DeleteLog:output_type -> google.protobuf.Empty
-	13, // 46: tree.TreeService.TriggerBiweeklyMaintenance:output_type -> google.protobuf.Empty
-	27, // [27:47] is the sub-list for method output_type
-	7,  // [7:27] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	2,  // 0: tree.SpeciesList.species:type_name -> tree.Species
+	5,  // 1: tree.Plot.location:type_name -> tree.GeoPoint
+	5,  // 2: tree.Plot.boundary:type_name -> tree.GeoPoint
+	6,  // 3: tree.PlotList.plots:type_name -> tree.Plot
+	27, // 4: tree.Tree.last_care_date:type_name -> google.protobuf.Timestamp
+	27, // 5: tree.Tree.adopted_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: tree.Tree.location:type_name -> tree.GeoPoint
+	12, // 7: tree.Tree.position:type_name -> tree.GridPosition
+	27, // 8: tree.ListTreesRequest.adopted_from:type_name -> google.protobuf.Timestamp
+	27, // 9: tree.ListTreesRequest.adopted_to:type_name -> google.protobuf.Timestamp
+	11, // 10: tree.TreeList.trees:type_name -> tree.Tree
+	15, // 11: tree.AdoptTreeRequest.gift:type_name -> tree.GiftOptions
+	27, // 12: tree.AdoptionIntent.created_at:type_name -> google.protobuf.Timestamp
+	19, // 13: tree.AdoptionIntentList.intents:type_name -> tree.AdoptionIntent
+	11, // 14: tree.MyTree.tree:type_name -> tree.Tree
+	21, // 15: tree.MyTreeList.trees:type_name -> tree.MyTree
+	19, // 16: tree.MyTreeList.pending_intents:type_name -> tree.AdoptionIntent
+	27, // 17: tree.LogEntry.recorded_at:type_name -> google.protobuf.Timestamp
+	5,  // 18: tree.LogEntry.location:type_name -> tree.GeoPoint
+	12, // 19: tree.LogEntry.position:type_name -> tree.GridPosition
+	5,  // 20: tree.CreateLogRequest.location:type_name -> tree.GeoPoint
+	12, // 21: tree.CreateLogRequest.position:type_name -> tree.GridPosition
+	24, // 22: tree.LogList.logs:type_name -> tree.LogEntry
+	3,  // 23: tree.TreeService.ListSpecies:input_type -> tree.ListSpeciesRequest
+	0,  // 24: tree.TreeService.GetSpecies:input_type -> tree.IdRequest
+	2,  // 25: tree.TreeService.CreateSpecies:input_type -> tree.Species
+	2,  // 26: tree.TreeService.UpdateSpecies:input_type -> tree.Species
+	0,  // 27: tree.TreeService.DeleteSpecies:input_type -> tree.IdRequest
+	7,  // 28: tree.TreeService.ListPlots:input_type -> tree.ListPlotsRequest
+	9,  // 29: tree.TreeService.FindPlotsNear:input_type -> tree.FindPlotsNearRequest
+	10, // 30: tree.TreeService.FindPlotsWithin:input_type -> tree.FindPlotsWithinRequest
+	0,  // 31: tree.TreeService.GetPlot:input_type -> tree.IdRequest
+	6,  // 32: tree.TreeService.CreatePlot:input_type -> tree.Plot
+	6,  // 33: tree.TreeService.UpdatePlot:input_type -> tree.Plot
+	0,  // 34: tree.TreeService.DeletePlot:input_type -> tree.IdRequest
+	13, // 35: tree.TreeService.ListTrees:input_type -> tree.ListTreesRequest
+	16, // 36: tree.TreeService.AdoptTree:input_type -> tree.AdoptTreeRequest
+	0,  // 37: tree.TreeService.GetTree:input_type -> tree.IdRequest
+	11, // 38: tree.TreeService.UpdateTree:input_type -> tree.Tree
+	0,  // 39: tree.TreeService.DeleteTree:input_type -> tree.IdRequest
+	18, // 40: tree.TreeService.ClaimGift:input_type -> tree.ClaimGiftRequest
+	1,  // 41: tree.TreeService.ListAdoptionIntents:input_type -> tree.IdsRequest
+	22, // 42: tree.TreeService.ListMyTrees:input_type -> tree.ListMyTreesRequest
+	0,  // 43: tree.TreeService.GetTreeLogs:input_type -> tree.IdRequest
+	25, // 44: tree.TreeService.CreateLog:input_type -> tree.CreateLogRequest
+	24, // 45: tree.TreeService.UpdateLog:input_type -> tree.LogEntry
+	0,  // 46: tree.TreeService.DeleteLog:input_type -> tree.IdRequest
+	28, // 47: tree.TreeService.TriggerBiweeklyMaintenance:input_type -> google.protobuf.Empty
+	4,  // 48: tree.TreeService.ListSpecies:output_type -> tree.SpeciesList
+	2,  // 49: tree.TreeService.GetSpecies:output_type -> tree.Species
+	2,  // 50: tree.TreeService.CreateSpecies:output_type -> tree.Species
+	2,  // 51: tree.TreeService.UpdateSpecies:output_type -> tree.Species
+	28, // 52: tree.TreeService.DeleteSpecies:output_type -> google.protobuf.Empty
+	8,  // 53: tree.TreeService.ListPlots:output_type -> tree.PlotList
+	8,  // 54: tree.TreeService.FindPlotsNear:output_type -> tree.PlotList
+	8,  // 55: tree.TreeService.FindPlotsWithin:output_type -> tree.PlotList
+	6,  // 56: tree.TreeService.GetPlot:output_type -> tree.Plot
+	6,  // 57: tree.TreeService.CreatePlot:output_type -> tree.Plot
+	6,  // 58: tree.TreeService.UpdatePlot:output_type -> tree.Plot
+	28, // 59: tree.TreeService.DeletePlot:output_type -> google.protobuf.Empty
+	14, // 60: tree.TreeService.ListTrees:output_type -> tree.TreeList
+	17, // 61: tree.TreeService.AdoptTree:output_type -> tree.AdoptTreeResponse
+	11, // 62: tree.TreeService.GetTree:output_type -> tree.Tree
+	11, // 63: tree.TreeService.UpdateTree:output_type -> tree.Tree
+	28, // 64: tree.TreeService.DeleteTree:output_type -> google.protobuf.Empty
+	11, // 65: tree.TreeService.ClaimGift:output_type -> tree.Tree
+	20, // 66: tree.TreeService.ListAdoptionIntents:output_type -> tree.AdoptionIntentList
+	23, // 67: tree.TreeService.ListMyTrees:output_type -> tree.MyTreeList
+	26, // 68: tree.TreeService.GetTreeLogs:output_type -> tree.LogList
+	24, // 69: tree.TreeService.CreateLog:output_type -> tree.LogEntry
+	24, // 70: tree.TreeService.UpdateLog:output_type -> tree.LogEntry
+	28, // 71: tree.TreeService.DeleteLog:output_type -> google.protobuf.Empty
+	28, // 72: tree.TreeService.TriggerBiweeklyMaintenance:output_type -> google.protobuf.Empty
+	48, // [48:73] is the sub-list for method output_type
+	23, // [23:48] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_tree_management_service_proto_init() }
@@ -957,7 +2274,7 @@ func file_proto_tree_management_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tree_management_service_proto_rawDesc), len(file_proto_tree_management_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
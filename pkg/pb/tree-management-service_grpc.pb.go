@@ -26,6 +26,8 @@ const (
 	TreeService_UpdateSpecies_FullMethodName              = "/tree.TreeService/UpdateSpecies"
 	TreeService_DeleteSpecies_FullMethodName              = "/tree.TreeService/DeleteSpecies"
 	TreeService_ListPlots_FullMethodName                  = "/tree.TreeService/ListPlots"
+	TreeService_FindPlotsNear_FullMethodName              = "/tree.TreeService/FindPlotsNear"
+	TreeService_FindPlotsWithin_FullMethodName            = "/tree.TreeService/FindPlotsWithin"
 	TreeService_GetPlot_FullMethodName                    = "/tree.TreeService/GetPlot"
 	TreeService_CreatePlot_FullMethodName                 = "/tree.TreeService/CreatePlot"
 	TreeService_UpdatePlot_FullMethodName                 = "/tree.TreeService/UpdatePlot"
@@ -35,6 +37,9 @@ const (
 	TreeService_GetTree_FullMethodName                    = "/tree.TreeService/GetTree"
 	TreeService_UpdateTree_FullMethodName                 = "/tree.TreeService/UpdateTree"
 	TreeService_DeleteTree_FullMethodName                 = "/tree.TreeService/DeleteTree"
+	TreeService_ClaimGift_FullMethodName                  = "/tree.TreeService/ClaimGift"
+	TreeService_ListAdoptionIntents_FullMethodName        = "/tree.TreeService/ListAdoptionIntents"
+	TreeService_ListMyTrees_FullMethodName                = "/tree.TreeService/ListMyTrees"
 	TreeService_GetTreeLogs_FullMethodName                = "/tree.TreeService/GetTreeLogs"
 	TreeService_CreateLog_FullMethodName                  = "/tree.TreeService/CreateLog"
 	TreeService_UpdateLog_FullMethodName                  = "/tree.TreeService/UpdateLog"
@@ -47,23 +52,28 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TreeServiceClient interface {
 	// Species
-	ListSpecies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SpeciesList, error)
+	ListSpecies(ctx context.Context, in *ListSpeciesRequest, opts ...grpc.CallOption) (*SpeciesList, error)
 	GetSpecies(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Species, error)
 	CreateSpecies(ctx context.Context, in *Species, opts ...grpc.CallOption) (*Species, error)
 	UpdateSpecies(ctx context.Context, in *Species, opts ...grpc.CallOption) (*Species, error)
 	DeleteSpecies(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Plots
-	ListPlots(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PlotList, error)
+	ListPlots(ctx context.Context, in *ListPlotsRequest, opts ...grpc.CallOption) (*PlotList, error)
+	FindPlotsNear(ctx context.Context, in *FindPlotsNearRequest, opts ...grpc.CallOption) (*PlotList, error)
+	FindPlotsWithin(ctx context.Context, in *FindPlotsWithinRequest, opts ...grpc.CallOption) (*PlotList, error)
 	GetPlot(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Plot, error)
 	CreatePlot(ctx context.Context, in *Plot, opts ...grpc.CallOption) (*Plot, error)
 	UpdatePlot(ctx context.Context, in *Plot, opts ...grpc.CallOption) (*Plot, error)
 	DeletePlot(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Trees
-	ListTrees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TreeList, error)
+	ListTrees(ctx context.Context, in *ListTreesRequest, opts ...grpc.CallOption) (*TreeList, error)
 	AdoptTree(ctx context.Context, in *AdoptTreeRequest, opts ...grpc.CallOption) (*AdoptTreeResponse, error)
 	GetTree(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Tree, error)
 	UpdateTree(ctx context.Context, in *Tree, opts ...grpc.CallOption) (*Tree, error)
 	DeleteTree(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ClaimGift(ctx context.Context, in *ClaimGiftRequest, opts ...grpc.CallOption) (*Tree, error)
+	ListAdoptionIntents(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*AdoptionIntentList, error)
+	ListMyTrees(ctx context.Context, in *ListMyTreesRequest, opts ...grpc.CallOption) (*MyTreeList, error)
 	// Logs
 	GetTreeLogs(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*LogList, error)
 	CreateLog(ctx context.Context, in *CreateLogRequest, opts ...grpc.CallOption) (*LogEntry, error)
@@ -81,7 +91,7 @@ func NewTreeServiceClient(cc grpc.ClientConnInterface) TreeServiceClient {
 	return &treeServiceClient{cc}
 }
 
-func (c *treeServiceClient) ListSpecies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SpeciesList, error) {
+func (c *treeServiceClient) ListSpecies(ctx context.Context, in *ListSpeciesRequest, opts ...grpc.CallOption) (*SpeciesList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SpeciesList)
 	err := c.cc.Invoke(ctx, TreeService_ListSpecies_FullMethodName, in, out, cOpts...)
@@ -131,7 +141,7 @@ func (c *treeServiceClient) DeleteSpecies(ctx context.Context, in *IdRequest, op
 	return out, nil
 }
 
-func (c *treeServiceClient) ListPlots(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PlotList, error) {
+func (c *treeServiceClient) ListPlots(ctx context.Context, in *ListPlotsRequest, opts ...grpc.CallOption) (*PlotList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PlotList)
 	err := c.cc.Invoke(ctx, TreeService_ListPlots_FullMethodName, in, out, cOpts...)
@@ -141,6 +151,26 @@ func (c *treeServiceClient) ListPlots(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *treeServiceClient) FindPlotsNear(ctx context.Context, in *FindPlotsNearRequest, opts ...grpc.CallOption) (*PlotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlotList)
+	err := c.cc.Invoke(ctx, TreeService_FindPlotsNear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) FindPlotsWithin(ctx context.Context, in *FindPlotsWithinRequest, opts ...grpc.CallOption) (*PlotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlotList)
+	err := c.cc.Invoke(ctx, TreeService_FindPlotsWithin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *treeServiceClient) GetPlot(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*Plot, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Plot)
@@ -181,7 +211,7 @@ func (c *treeServiceClient) DeletePlot(ctx context.Context, in *IdRequest, opts
 	return out, nil
 }
 
-func (c *treeServiceClient) ListTrees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TreeList, error) {
+func (c *treeServiceClient) ListTrees(ctx context.Context, in *ListTreesRequest, opts ...grpc.CallOption) (*TreeList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TreeList)
 	err := c.cc.Invoke(ctx, TreeService_ListTrees_FullMethodName, in, out, cOpts...)
@@ -231,6 +261,36 @@ func (c *treeServiceClient) DeleteTree(ctx context.Context, in *IdRequest, opts
 	return out, nil
 }
 
+func (c *treeServiceClient) ClaimGift(ctx context.Context, in *ClaimGiftRequest, opts ...grpc.CallOption) (*Tree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tree)
+	err := c.cc.Invoke(ctx, TreeService_ClaimGift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) ListAdoptionIntents(ctx context.Context, in *IdsRequest, opts ...grpc.CallOption) (*AdoptionIntentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdoptionIntentList)
+	err := c.cc.Invoke(ctx, TreeService_ListAdoptionIntents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) ListMyTrees(ctx context.Context, in *ListMyTreesRequest, opts ...grpc.CallOption) (*MyTreeList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MyTreeList)
+	err := c.cc.Invoke(ctx, TreeService_ListMyTrees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *treeServiceClient) GetTreeLogs(ctx context.Context, in *IdRequest, opts ...grpc.CallOption) (*LogList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LogList)
@@ -286,23 +346,28 @@ func (c *treeServiceClient) TriggerBiweeklyMaintenance(ctx context.Context, in *
 // for forward compatibility.
 type TreeServiceServer interface {
 	// Species
-	ListSpecies(context.Context, *emptypb.Empty) (*SpeciesList, error)
+	ListSpecies(context.Context, *ListSpeciesRequest) (*SpeciesList, error)
 	GetSpecies(context.Context, *IdRequest) (*Species, error)
 	CreateSpecies(context.Context, *Species) (*Species, error)
 	UpdateSpecies(context.Context, *Species) (*Species, error)
 	DeleteSpecies(context.Context, *IdRequest) (*emptypb.Empty, error)
 	// Plots
-	ListPlots(context.Context, *emptypb.Empty) (*PlotList, error)
+	ListPlots(context.Context, *ListPlotsRequest) (*PlotList, error)
+	FindPlotsNear(context.Context, *FindPlotsNearRequest) (*PlotList, error)
+	FindPlotsWithin(context.Context, *FindPlotsWithinRequest) (*PlotList, error)
 	GetPlot(context.Context, *IdRequest) (*Plot, error)
 	CreatePlot(context.Context, *Plot) (*Plot, error)
 	UpdatePlot(context.Context, *Plot) (*Plot, error)
 	DeletePlot(context.Context, *IdRequest) (*emptypb.Empty, error)
 	// Trees
-	ListTrees(context.Context, *emptypb.Empty) (*TreeList, error)
+	ListTrees(context.Context, *ListTreesRequest) (*TreeList, error)
 	AdoptTree(context.Context, *AdoptTreeRequest) (*AdoptTreeResponse, error)
 	GetTree(context.Context, *IdRequest) (*Tree, error)
 	UpdateTree(context.Context, *Tree) (*Tree, error)
 	DeleteTree(context.Context, *IdRequest) (*emptypb.Empty, error)
+	ClaimGift(context.Context, *ClaimGiftRequest) (*Tree, error)
+	ListAdoptionIntents(context.Context, *IdsRequest) (*AdoptionIntentList, error)
+	ListMyTrees(context.Context, *ListMyTreesRequest) (*MyTreeList, error)
 	// Logs
 	GetTreeLogs(context.Context, *IdRequest) (*LogList, error)
 	CreateLog(context.Context, *CreateLogRequest) (*LogEntry, error)
@@ -320,7 +385,7 @@ type TreeServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedTreeServiceServer struct{}
 
-func (UnimplementedTreeServiceServer) ListSpecies(context.Context, *emptypb.Empty) (*SpeciesList, error) {
+func (UnimplementedTreeServiceServer) ListSpecies(context.Context, *ListSpeciesRequest) (*SpeciesList, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSpecies not implemented")
 }
 func (UnimplementedTreeServiceServer) GetSpecies(context.Context, *IdRequest) (*Species, error) {
@@ -335,9 +400,15 @@ func (UnimplementedTreeServiceServer) UpdateSpecies(context.Context, *Species) (
 func (UnimplementedTreeServiceServer) DeleteSpecies(context.Context, *IdRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteSpecies not implemented")
 }
-func (UnimplementedTreeServiceServer) ListPlots(context.Context, *emptypb.Empty) (*PlotList, error) {
+func (UnimplementedTreeServiceServer) ListPlots(context.Context, *ListPlotsRequest) (*PlotList, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPlots not implemented")
 }
+func (UnimplementedTreeServiceServer) FindPlotsNear(context.Context, *FindPlotsNearRequest) (*PlotList, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindPlotsNear not implemented")
+}
+func (UnimplementedTreeServiceServer) FindPlotsWithin(context.Context, *FindPlotsWithinRequest) (*PlotList, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindPlotsWithin not implemented")
+}
 func (UnimplementedTreeServiceServer) GetPlot(context.Context, *IdRequest) (*Plot, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPlot not implemented")
 }
@@ -350,7 +421,7 @@ func (UnimplementedTreeServiceServer) UpdatePlot(context.Context, *Plot) (*Plot,
 func (UnimplementedTreeServiceServer) DeletePlot(context.Context, *IdRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePlot not implemented")
 }
-func (UnimplementedTreeServiceServer) ListTrees(context.Context, *emptypb.Empty) (*TreeList, error) {
+func (UnimplementedTreeServiceServer) ListTrees(context.Context, *ListTreesRequest) (*TreeList, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTrees not implemented")
 }
 func (UnimplementedTreeServiceServer) AdoptTree(context.Context, *AdoptTreeRequest) (*AdoptTreeResponse, error) {
@@ -365,6 +436,15 @@ func (UnimplementedTreeServiceServer) UpdateTree(context.Context, *Tree) (*Tree,
 func (UnimplementedTreeServiceServer) DeleteTree(context.Context, *IdRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTree not implemented")
 }
+func (UnimplementedTreeServiceServer) ClaimGift(context.Context, *ClaimGiftRequest) (*Tree, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimGift not implemented")
+}
+func (UnimplementedTreeServiceServer) ListAdoptionIntents(context.Context, *IdsRequest) (*AdoptionIntentList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAdoptionIntents not implemented")
+}
+func (UnimplementedTreeServiceServer) ListMyTrees(context.Context, *ListMyTreesRequest) (*MyTreeList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyTrees not implemented")
+}
 func (UnimplementedTreeServiceServer) GetTreeLogs(context.Context, *IdRequest) (*LogList, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTreeLogs not implemented")
 }
@@ -402,7 +482,7 @@ func RegisterTreeServiceServer(s grpc.ServiceRegistrar, srv TreeServiceServer) {
 }
 
 func _TreeService_ListSpecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListSpeciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -414,7 +494,7 @@ func _TreeService_ListSpecies_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: TreeService_ListSpecies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TreeServiceServer).ListSpecies(ctx, req.(*emptypb.Empty))
+		return srv.(TreeServiceServer).ListSpecies(ctx, req.(*ListSpeciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -492,7 +572,7 @@ func _TreeService_DeleteSpecies_Handler(srv interface{}, ctx context.Context, de
 }
 
 func _TreeService_ListPlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListPlotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -504,7 +584,43 @@ func _TreeService_ListPlots_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: TreeService_ListPlots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TreeServiceServer).ListPlots(ctx, req.(*emptypb.Empty))
+		return srv.(TreeServiceServer).ListPlots(ctx, req.(*ListPlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_FindPlotsNear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlotsNearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).FindPlotsNear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TreeService_FindPlotsNear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).FindPlotsNear(ctx, req.(*FindPlotsNearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_FindPlotsWithin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlotsWithinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).FindPlotsWithin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TreeService_FindPlotsWithin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).FindPlotsWithin(ctx, req.(*FindPlotsWithinRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -582,7 +698,7 @@ func _TreeService_DeletePlot_Handler(srv interface{}, ctx context.Context, dec f
 }
 
 func _TreeService_ListTrees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListTreesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -594,7 +710,7 @@ func _TreeService_ListTrees_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: TreeService_ListTrees_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TreeServiceServer).ListTrees(ctx, req.(*emptypb.Empty))
+		return srv.(TreeServiceServer).ListTrees(ctx, req.(*ListTreesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -671,6 +787,60 @@ func _TreeService_DeleteTree_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TreeService_ClaimGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).ClaimGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TreeService_ClaimGift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).ClaimGift(ctx, req.(*ClaimGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_ListAdoptionIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).ListAdoptionIntents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TreeService_ListAdoptionIntents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).ListAdoptionIntents(ctx, req.(*IdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_ListMyTrees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTreesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).ListMyTrees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TreeService_ListMyTrees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).ListMyTrees(ctx, req.(*ListMyTreesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TreeService_GetTreeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IdRequest)
 	if err := dec(in); err != nil {
@@ -792,6 +962,14 @@ var TreeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPlots",
 			Handler:    _TreeService_ListPlots_Handler,
 		},
+		{
+			MethodName: "FindPlotsNear",
+			Handler:    _TreeService_FindPlotsNear_Handler,
+		},
+		{
+			MethodName: "FindPlotsWithin",
+			Handler:    _TreeService_FindPlotsWithin_Handler,
+		},
 		{
 			MethodName: "GetPlot",
 			Handler:    _TreeService_GetPlot_Handler,
@@ -828,6 +1006,18 @@ var TreeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTree",
 			Handler:    _TreeService_DeleteTree_Handler,
 		},
+		{
+			MethodName: "ClaimGift",
+			Handler:    _TreeService_ClaimGift_Handler,
+		},
+		{
+			MethodName: "ListAdoptionIntents",
+			Handler:    _TreeService_ListAdoptionIntents_Handler,
+		},
+		{
+			MethodName: "ListMyTrees",
+			Handler:    _TreeService_ListMyTrees_Handler,
+		},
 		{
 			MethodName: "GetTreeLogs",
 			Handler:    _TreeService_GetTreeLogs_Handler,
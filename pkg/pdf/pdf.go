@@ -0,0 +1,163 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth    = 595.28 // A4 in points
+	pageHeight   = 841.89
+	marginLeft   = 50.0
+	marginTop    = 60.0
+	marginBottom = 60.0
+
+	headingSize = 16.0
+	textSize    = 10.0
+	lineGap     = 1.5
+)
+
+type span struct {
+	x, y float64
+	size float64
+	bold bool
+	text string
+}
+
+// Document is a minimal text-only PDF writer. It only supports the standard
+// Helvetica fonts, which every PDF reader ships, so no font data is embedded.
+type Document struct {
+	title string
+	pages [][]span
+	y     float64
+}
+
+func New(title string) *Document {
+	d := &Document{title: title}
+	d.AddPage()
+	return d
+}
+
+func (d *Document) AddPage() {
+	d.pages = append(d.pages, nil)
+	d.y = pageHeight - marginTop
+}
+
+func (d *Document) Heading(text string) {
+	d.write(marginLeft, headingSize, true, text)
+	d.advance(headingSize)
+}
+
+func (d *Document) Text(text string) {
+	d.write(marginLeft, textSize, false, text)
+	d.advance(textSize)
+}
+
+func (d *Document) Bold(text string) {
+	d.write(marginLeft, textSize, true, text)
+	d.advance(textSize)
+}
+
+// Row writes cells on a single line, each starting at the given x offset
+// relative to the left margin.
+func (d *Document) Row(offsets []float64, cells ...string) {
+	for i, cell := range cells {
+		x := marginLeft
+		if i < len(offsets) {
+			x += offsets[i]
+		}
+		d.write(x, textSize, false, cell)
+	}
+	d.advance(textSize)
+}
+
+func (d *Document) Blank() {
+	d.advance(textSize)
+}
+
+func (d *Document) write(x, size float64, bold bool, text string) {
+	if d.y-size < marginBottom {
+		d.AddPage()
+	}
+	last := len(d.pages) - 1
+	d.pages[last] = append(d.pages[last], span{x: x, y: d.y - size, size: size, bold: bold, text: text})
+}
+
+func (d *Document) advance(size float64) {
+	d.y -= size * lineGap
+	if d.y < marginBottom {
+		d.AddPage()
+	}
+}
+
+// Bytes renders the document. Object layout: 1 catalog, 2 page tree,
+// 3 regular font, 4 bold font, 5 info, then a page and content stream pair
+// per page.
+func (d *Document) Bytes() []byte {
+	var objects []string
+	pageCount := len(d.pages)
+
+	kids := make([]string, pageCount)
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+i*2)
+	}
+
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), pageCount),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (ReForest) >>", escape(d.title)),
+	)
+
+	for i, spans := range d.pages {
+		var content bytes.Buffer
+		for _, s := range spans {
+			font := "F1"
+			if s.bold {
+				font = "F2"
+			}
+			fmt.Fprintf(&content, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, s.size, s.x, s.y, escape(s.text))
+		}
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, 7+i*2),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// escape makes text safe for a PDF literal string. Characters outside
+// printable ASCII are replaced since the standard fonts use WinAnsiEncoding.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestDocument_Bytes_ValidStructure(t *testing.T) {
+	doc := New("Receipt")
+	doc.Heading("ReForest Receipt")
+	doc.Text("Amount: 10000")
+	doc.Row([]float64{0, 200}, "Type", "ADOPT")
+
+	out := doc.Bytes()
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) {
+		t.Fatalf("missing PDF header")
+	}
+	if !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("missing EOF marker")
+	}
+	if !bytes.Contains(out, []byte("(ReForest Receipt) Tj")) {
+		t.Fatalf("heading text not rendered")
+	}
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if m == nil {
+		t.Fatalf("startxref not found")
+	}
+	off, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(out[off:], []byte("xref\n")) {
+		t.Fatalf("startxref does not point at xref table")
+	}
+}
+
+func TestDocument_Bytes_XrefOffsets(t *testing.T) {
+	doc := New("Offsets")
+	doc.Text("hello")
+	out := doc.Bytes()
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out, -1)
+	if len(entries) == 0 {
+		t.Fatalf("no xref entries")
+	}
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		want := []byte(fmt.Sprintf("%d 0 obj", i+1))
+		if !bytes.HasPrefix(out[off:], want) {
+			t.Fatalf("xref entry %d points at wrong offset", i+1)
+		}
+	}
+}
+
+func TestDocument_Pagination(t *testing.T) {
+	doc := New("Long")
+	for i := 0; i < 200; i++ {
+		doc.Text(fmt.Sprintf("line %d", i))
+	}
+	if len(doc.pages) < 2 {
+		t.Fatalf("expected content to span multiple pages, got %d", len(doc.pages))
+	}
+	if !bytes.Contains(doc.Bytes(), []byte(fmt.Sprintf("/Count %d", len(doc.pages)))) {
+		t.Fatalf("page count not written")
+	}
+}
+
+func TestEscape(t *testing.T) {
+	got := escape(`a(b)c\d é`)
+	want := `a\(b\)c\\d ?`
+	if got != want {
+		t.Fatalf("escape() = %q, want %q", got, want)
+	}
+}
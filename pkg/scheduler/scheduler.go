@@ -0,0 +1,271 @@
+// Package scheduler runs recurring jobs on cron schedules across replicas.
+//
+// Every replica runs the same schedules. Before a run, a replica takes a
+// lock named after the job, so while one replica runs a job the others skip
+// it, and each scheduled activation is recorded once in the run history, so
+// a replica whose clock lags cannot run it again after the lock is released.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Run statuses.
+const (
+	StatusRunning   = "RUNNING"
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+)
+
+// Run triggers.
+const (
+	TriggerSchedule = "SCHEDULE"
+	TriggerManual   = "MANUAL"
+)
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobRunning is returned by Trigger when the job is already running,
+	// here or on another replica.
+	ErrJobRunning = errors.New("job is already running")
+)
+
+// Run is one execution of a job.
+type Run struct {
+	ID          string
+	Job         string
+	Trigger     string
+	Status      string
+	ScheduledAt time.Time
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	Duration    time.Duration
+	Error       string
+}
+
+// Store persists the run history.
+type Store interface {
+	// StartRun records a run as RUNNING and sets its ID. It returns false,
+	// and records nothing, when the job already has a run for
+	// run.ScheduledAt.
+	StartRun(ctx context.Context, run *Run) (bool, error)
+	FinishRun(ctx context.Context, run *Run) error
+	// ListRuns returns the latest runs of a job, newest first.
+	ListRuns(ctx context.Context, job string, limit int) ([]Run, error)
+}
+
+// Locker provides locks shared by all replicas.
+type Locker interface {
+	// TryLock takes the lock named key without waiting. It returns false if
+	// another holder has it, otherwise a func that releases it.
+	TryLock(ctx context.Context, key string) (unlock func(), ok bool, err error)
+}
+
+// Job is a unit of recurring work. An empty Spec, or "off", registers the
+// job without a schedule so it only runs when triggered.
+type Job struct {
+	Name        string
+	Description string
+	Spec        string
+	// Timeout bounds a single run. Zero means no limit.
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// JobStatus describes a registered job for listing.
+type JobStatus struct {
+	Job
+	Enabled    bool
+	NextRunAt  time.Time
+	RecentRuns []Run
+}
+
+type registeredJob struct {
+	Job
+	schedule Schedule
+}
+
+type Scheduler struct {
+	store  Store
+	locker Locker
+	now    func() time.Time
+
+	mu   sync.RWMutex
+	jobs map[string]*registeredJob
+}
+
+func New(store Store, locker Locker) *Scheduler {
+	return &Scheduler{
+		store:  store,
+		locker: locker,
+		now:    time.Now,
+		jobs:   make(map[string]*registeredJob),
+	}
+}
+
+// Register adds a job. It must be called before Start.
+func (s *Scheduler) Register(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return errors.New("job needs a name and a run func")
+	}
+
+	rj := &registeredJob{Job: job}
+	if spec := strings.TrimSpace(job.Spec); spec != "" && !strings.EqualFold(spec, "off") {
+		sched, err := Parse(spec)
+		if err != nil {
+			return fmt.Errorf("job %s: %w", job.Name, err)
+		}
+		rj.schedule = sched
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.jobs[job.Name]; exists {
+		return fmt.Errorf("job %s is already registered", job.Name)
+	}
+	s.jobs[job.Name] = rj
+	return nil
+}
+
+// Start runs every scheduled job in its own goroutine until ctx is
+// cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, job := range s.jobs {
+		if job.schedule == nil {
+			log.Printf("Job %s has no schedule, it only runs when triggered", job.Name)
+			continue
+		}
+		go s.loop(ctx, job)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job *registeredJob) {
+	for {
+		next := job.schedule.Next(s.now())
+		if next.IsZero() {
+			log.Printf("WARN: job %s schedule %q never fires again", job.Name, job.Spec)
+			return
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if _, err := s.run(ctx, job, TriggerSchedule, next); err != nil && !errors.Is(err, ErrJobRunning) {
+			log.Printf("Error running job %s: %v", job.Name, err)
+		}
+	}
+}
+
+// Trigger runs a job now, outside its schedule, and returns the finished
+// run. The run is not cancelled with ctx, only by the job's timeout.
+func (s *Scheduler) Trigger(ctx context.Context, name string) (*Run, error) {
+	s.mu.RLock()
+	job, ok := s.jobs[name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrJobNotFound, name)
+	}
+	return s.run(context.WithoutCancel(ctx), job, TriggerManual, s.now())
+}
+
+// run executes job under its lock and records the outcome. A run whose
+// activation was already recorded is skipped and returns a nil Run. The
+// job's own failure is recorded on the Run rather than returned.
+func (s *Scheduler) run(ctx context.Context, job *registeredJob, trigger string, scheduledAt time.Time) (*Run, error) {
+	unlock, ok, err := s.locker.TryLock(ctx, "scheduler:"+job.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take job lock: %w", err)
+	}
+	if !ok {
+		return nil, ErrJobRunning
+	}
+	defer unlock()
+
+	run := &Run{
+		Job:         job.Name,
+		Trigger:     trigger,
+		Status:      StatusRunning,
+		ScheduledAt: scheduledAt,
+		StartedAt:   s.now(),
+	}
+	started, err := s.store.StartRun(ctx, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record job run: %w", err)
+	}
+	if !started {
+		return nil, nil
+	}
+
+	runCtx, cancel := ctx, context.CancelFunc(func() {})
+	if job.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, job.Timeout)
+	}
+	jobErr := safeRun(runCtx, job.Run)
+	cancel()
+
+	run.FinishedAt = s.now()
+	run.Duration = run.FinishedAt.Sub(run.StartedAt)
+	run.Status = StatusSucceeded
+	if jobErr != nil {
+		run.Status = StatusFailed
+		run.Error = jobErr.Error()
+		log.Printf("Job %s failed after %s: %v", job.Name, run.Duration, jobErr)
+	}
+	// The job has finished either way, so its outcome is recorded even if
+	// the caller's context is gone by now.
+	if err := s.store.FinishRun(context.WithoutCancel(ctx), run); err != nil {
+		return run, fmt.Errorf("failed to record job result: %w", err)
+	}
+	return run, nil
+}
+
+func safeRun(ctx context.Context, fn func(context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
+
+// Jobs lists the registered jobs by name with their next activation and up
+// to recent of their latest runs.
+func (s *Scheduler) Jobs(ctx context.Context, recent int) ([]JobStatus, error) {
+	s.mu.RLock()
+	jobs := make([]*registeredJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, job)
+	}
+	s.mu.RUnlock()
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+
+	now := s.now()
+	statuses := make([]JobStatus, len(jobs))
+	for i, job := range jobs {
+		statuses[i] = JobStatus{Job: job.Job, Enabled: job.schedule != nil}
+		if job.schedule != nil {
+			statuses[i].NextRunAt = job.schedule.Next(now)
+		}
+		if recent > 0 {
+			runs, err := s.store.ListRuns(ctx, job.Name, recent)
+			if err != nil {
+				return nil, err
+			}
+			statuses[i].RecentRuns = runs
+		}
+	}
+	return statuses, nil
+}
@@ -0,0 +1,183 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type memoryStore struct {
+	runs []*Run
+}
+
+func (s *memoryStore) StartRun(ctx context.Context, run *Run) (bool, error) {
+	for _, r := range s.runs {
+		if r.Job == run.Job && r.ScheduledAt.Equal(run.ScheduledAt) {
+			return false, nil
+		}
+	}
+	run.ID = run.Job + "-" + run.ScheduledAt.Format(time.RFC3339Nano)
+	copied := *run
+	s.runs = append(s.runs, &copied)
+	return true, nil
+}
+
+func (s *memoryStore) FinishRun(ctx context.Context, run *Run) error {
+	for _, r := range s.runs {
+		if r.ID == run.ID {
+			*r = *run
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) ListRuns(ctx context.Context, job string, limit int) ([]Run, error) {
+	var runs []Run
+	for i := len(s.runs) - 1; i >= 0 && len(runs) < limit; i-- {
+		if s.runs[i].Job == job {
+			runs = append(runs, *s.runs[i])
+		}
+	}
+	return runs, nil
+}
+
+type memoryLocker struct {
+	held     map[string]bool
+	unlocked int
+}
+
+func (l *memoryLocker) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	if l.held[key] {
+		return nil, false, nil
+	}
+	l.held[key] = true
+	return func() { delete(l.held, key); l.unlocked++ }, true, nil
+}
+
+func newTestScheduler() (*Scheduler, *memoryStore, *memoryLocker) {
+	store, locker := &memoryStore{}, &memoryLocker{held: map[string]bool{}}
+	s := New(store, locker)
+	clock := time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC)
+	s.now = func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	}
+	return s, store, locker
+}
+
+func TestScheduler_RunRecordsHistory(t *testing.T) {
+	s, store, locker := newTestScheduler()
+	calls := 0
+	if err := s.Register(Job{Name: "expiry", Spec: "*/5 * * * *", Run: func(ctx context.Context) error {
+		calls++
+		return nil
+	}}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := s.Register(Job{Name: "reconcile", Spec: "@hourly", Run: func(ctx context.Context) error {
+		return errors.New("provider down")
+	}}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	due := time.Date(2026, 3, 14, 10, 5, 0, 0, time.UTC)
+	run, err := s.run(context.Background(), s.jobs["expiry"], TriggerSchedule, due)
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if run.Status != StatusSucceeded || run.Duration != time.Second || run.Trigger != TriggerSchedule || !run.ScheduledAt.Equal(due) {
+		t.Fatalf("unexpected run: %+v", run)
+	}
+	if locker.unlocked != 1 {
+		t.Fatalf("lock should be released after the run")
+	}
+
+	// Another replica reaching the same activation later does nothing.
+	if run, err := s.run(context.Background(), s.jobs["expiry"], TriggerSchedule, due); err != nil || run != nil || calls != 1 {
+		t.Fatalf("activation should only run once, got run %+v err %v calls %d", run, err, calls)
+	}
+
+	failed, err := s.run(context.Background(), s.jobs["reconcile"], TriggerSchedule, due)
+	if err != nil {
+		t.Fatalf("a failing job is recorded, not returned: %v", err)
+	}
+	if failed.Status != StatusFailed || failed.Error != "provider down" {
+		t.Fatalf("unexpected failed run: %+v", failed)
+	}
+	if got := store.runs[1]; got.Status != StatusFailed || got.FinishedAt.IsZero() {
+		t.Fatalf("failure should be persisted, got %+v", got)
+	}
+}
+
+func TestScheduler_Trigger(t *testing.T) {
+	s, store, locker := newTestScheduler()
+	s.Register(Job{Name: "expiry", Run: func(ctx context.Context) error { panic("boom") }})
+
+	run, err := s.Trigger(context.Background(), "expiry")
+	if err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	if run.Trigger != TriggerManual || run.Status != StatusFailed || run.Error != "panic: boom" {
+		t.Fatalf("unexpected run: %+v", run)
+	}
+	if len(store.runs) != 1 {
+		t.Fatalf("manual run should be recorded")
+	}
+
+	if _, err := s.Trigger(context.Background(), "missing"); !errors.Is(err, ErrJobNotFound) {
+		t.Fatalf("expected ErrJobNotFound, got %v", err)
+	}
+
+	locker.held["scheduler:expiry"] = true
+	if _, err := s.Trigger(context.Background(), "expiry"); !errors.Is(err, ErrJobRunning) {
+		t.Fatalf("expected ErrJobRunning while another replica holds the lock, got %v", err)
+	}
+}
+
+func TestScheduler_TimeoutCancelsRun(t *testing.T) {
+	s, _, _ := newTestScheduler()
+	s.Register(Job{Name: "slow", Timeout: 10 * time.Millisecond, Run: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+
+	run, err := s.Trigger(context.Background(), "slow")
+	if err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	if run.Status != StatusFailed || run.Error != context.DeadlineExceeded.Error() {
+		t.Fatalf("expected the run to time out, got %+v", run)
+	}
+}
+
+func TestScheduler_Register(t *testing.T) {
+	s, _, _ := newTestScheduler()
+	noop := func(ctx context.Context) error { return nil }
+
+	if err := s.Register(Job{Name: "expiry", Spec: "61 * * * *", Run: noop}); err == nil {
+		t.Fatalf("invalid spec should be rejected")
+	}
+	if err := s.Register(Job{Name: "expiry", Spec: "off", Run: noop}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := s.Register(Job{Name: "expiry", Run: noop}); err == nil {
+		t.Fatalf("duplicate name should be rejected")
+	}
+	s.Register(Job{Name: "reconcile", Spec: "@hourly", Run: noop})
+	s.Trigger(context.Background(), "expiry")
+
+	jobs, err := s.Jobs(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Jobs() error = %v", err)
+	}
+	if len(jobs) != 2 || jobs[0].Name != "expiry" || jobs[1].Name != "reconcile" {
+		t.Fatalf("jobs should be listed by name, got %+v", jobs)
+	}
+	if jobs[0].Enabled || !jobs[0].NextRunAt.IsZero() || len(jobs[0].RecentRuns) != 1 {
+		t.Fatalf("unexpected status for unscheduled job: %+v", jobs[0])
+	}
+	if !jobs[1].Enabled || jobs[1].NextRunAt.Minute() != 0 || jobs[1].NextRunAt.IsZero() {
+		t.Fatalf("unexpected status for hourly job: %+v", jobs[1])
+	}
+}